@@ -44,7 +44,7 @@ func (b *Beads) DetachMoleculeWithAudit(pinnedBeadID string, opts DetachOptions)
 	}
 
 	// Get current attachment info for audit
-	attachment := ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	if attachment == nil {
 		return issue, nil // Nothing to detach
 	}
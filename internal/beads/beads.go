@@ -508,6 +508,9 @@ type ListOptions struct {
 	Limit      int    // Max results (0 = unlimited, overrides bd default of 50)
 	Ephemeral  bool   // Search wisps table (ephemeral issues) instead of issues table
 	Rig        string // filter merge-request descriptions by rig before hydration
+	// DescContains filters by case-insensitive description substring. Pushed
+	// down to bd (--desc-contains) when supported, else applied client-side.
+	DescContains string
 }
 
 // CreateOptions specifies options for creating an issue.
@@ -582,6 +585,8 @@ func NewIsolated(workDir string) *Beads {
 // a specific Dolt server port. Init() passes --server-port to bd init, and all
 // commands get GT_DOLT_PORT in their environment. This prevents tests from
 // creating databases on the production Dolt server (port 3307).
+// Construction does no I/O; the per-test cost is Init, which creates the
+// rig's database and so cannot be shared between tests without sharing data.
 func NewIsolatedWithPort(workDir string, serverPort int) *Beads {
 	return &Beads{workDir: workDir, isolated: true, serverPort: serverPort}
 }
@@ -592,6 +597,19 @@ func NewWithBeadsDir(workDir, beadsDir string) *Beads {
 	return &Beads{workDir: workDir, beadsDir: beadsDir}
 }
 
+// Shutdown releases resources owned by this Beads wrapper. Long-lived
+// callers such as the daemon should call it when they stop using a wrapper;
+// short-lived commands may skip it. Shutdown is safe to call more than once.
+// (Close and Release are issue operations, hence the name.)
+//
+// The wrapper currently owns nothing: operations run as bd subprocesses that
+// exit on their own. An in-process store set via NewWithStore or SetStore
+// belongs to the caller, may be shared with wrappers derived from this one
+// (ForAgentBead), and is not closed here.
+func (b *Beads) Shutdown() error {
+	return nil
+}
+
 // ForAgentBead returns a Beads wrapper suitable for operating on agent beads.
 //
 // Agent beads (labeled gt:agent) live in the TOWN database, but their IDs
@@ -1068,6 +1086,37 @@ func (b *Beads) List(opts ListOptions) ([]*Issue, error) {
 }
 
 func (b *Beads) listIssues(opts ListOptions) ([]*Issue, error) {
+	out, err := b.run(listIssuesArgs(opts)...)
+	if err != nil && opts.DescContains != "" && strings.Contains(err.Error(), "unknown flag: --desc-contains") {
+		// Older bd can't filter descriptions server-side. List without the
+		// filter (and without the limit, which must apply after filtering)
+		// and narrow the results below.
+		fallback := opts
+		fallback.DescContains = ""
+		fallback.Limit = 0
+		out, err = b.run(listIssuesArgs(fallback)...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// bd list --json may return plain text (e.g., "No issues found.") instead
+	// of an empty JSON array when there are no results. Handle gracefully.
+	if len(out) == 0 || !isJSONBytes(out) {
+		return nil, nil
+	}
+
+	var issues []*Issue
+	if err := json.Unmarshal(out, &issues); err != nil {
+		return nil, fmt.Errorf("parsing bd list output: %w", err)
+	}
+
+	issues = filterIssuesByDescription(issues, opts.DescContains)
+	return limitIssues(issues, opts.Limit), nil
+}
+
+// listIssuesArgs builds the bd list arguments for opts.
+func listIssuesArgs(opts ListOptions) []string {
 	args := []string{"list", "--json"}
 
 	if opts.Status != "" {
@@ -1098,24 +1147,34 @@ func (b *Beads) listIssues(opts ListOptions) ([]*Issue, error) {
 		// Override bd's default limit of 50 to avoid silent truncation
 		args = append(args, "--limit=0")
 	}
-
-	out, err := b.run(args...)
-	if err != nil {
-		return nil, err
+	if opts.DescContains != "" {
+		args = append(args, "--desc-contains="+opts.DescContains)
 	}
+	return args
+}
 
-	// bd list --json may return plain text (e.g., "No issues found.") instead
-	// of an empty JSON array when there are no results. Handle gracefully.
-	if len(out) == 0 || !isJSONBytes(out) {
-		return nil, nil
+// filterIssuesByDescription keeps issues whose description contains substr,
+// case-insensitively (matching bd's --desc-contains). Empty substr keeps all.
+func filterIssuesByDescription(issues []*Issue, substr string) []*Issue {
+	if substr == "" || len(issues) == 0 {
+		return issues
 	}
-
-	var issues []*Issue
-	if err := json.Unmarshal(out, &issues); err != nil {
-		return nil, fmt.Errorf("parsing bd list output: %w", err)
+	needle := strings.ToLower(substr)
+	filtered := make([]*Issue, 0, len(issues))
+	for _, issue := range issues {
+		if issue != nil && strings.Contains(strings.ToLower(issue.Description), needle) {
+			filtered = append(filtered, issue)
+		}
 	}
+	return filtered
+}
 
-	return issues, nil
+// limitIssues truncates issues to limit entries (0 = unlimited).
+func limitIssues(issues []*Issue, limit int) []*Issue {
+	if limit > 0 && len(issues) > limit {
+		return issues[:limit]
+	}
+	return issues
 }
 
 // ListIssueStatuses returns durable issues matching any of the supplied
@@ -1308,6 +1367,16 @@ func (b *Beads) ListMergeRequests(opts ListOptions) ([]*Issue, error) {
 		labelFilter = fmt.Sprintf("l.label = '%s'", strings.ReplaceAll(opts.Label, "'", "''"))
 	}
 
+	// LOCATE rather than LIKE so branch names containing % or _ match literally.
+	descFilter := ""
+	if opts.DescContains != "" {
+		descFilter = fmt.Sprintf(" AND LOCATE(LOWER('%s'), LOWER(w.description)) > 0", sqlEscapeString(opts.DescContains))
+	}
+	limitClause := ""
+	if opts.Limit > 0 {
+		limitClause = fmt.Sprintf(" LIMIT %d", opts.Limit)
+	}
+
 	query := fmt.Sprintf(
 		"SELECT w.id, w.title, w.description, w.status, w.priority, w.assignee, "+
 			"w.created_at, w.updated_at, w.created_by, "+
@@ -1315,9 +1384,9 @@ func (b *Beads) ListMergeRequests(opts ListOptions) ([]*Issue, error) {
 			"FROM wisps w "+
 			"JOIN wisp_labels l ON w.id = l.issue_id "+
 			"LEFT JOIN wisp_labels al ON w.id = al.issue_id "+
-			"WHERE %s AND %s "+
-			"GROUP BY w.id, w.title, w.description, w.status, w.priority, w.assignee, w.created_at, w.updated_at, w.created_by",
-		labelFilter, statusFilter)
+			"WHERE %s AND %s%s "+
+			"GROUP BY w.id, w.title, w.description, w.status, w.priority, w.assignee, w.created_at, w.updated_at, w.created_by%s",
+		labelFilter, statusFilter, descFilter, limitClause)
 
 	sqlOut, sqlErr := b.run("sql", "--json", query)
 	if sqlErr == nil && len(sqlOut) > 0 && isJSONBytes(sqlOut) {
@@ -1359,16 +1428,23 @@ func (b *Beads) ListMergeRequests(opts ListOptions) ([]*Issue, error) {
 	}
 
 	issueResults = filterMergeRequestsByRig(issueResults, opts.Rig)
+	issueResults = limitIssues(issueResults, opts.Limit)
 	return b.hydrateMergeRequestDetails(issueResults)
 }
 
+// sqlEscapeString escapes s for use inside a single-quoted SQL string literal.
+func sqlEscapeString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, "'", "''")
+}
+
 func filterMergeRequestsByRig(issues []*Issue, rigName string) []*Issue {
 	if rigName == "" || len(issues) == 0 {
 		return issues
 	}
 	filtered := make([]*Issue, 0, len(issues))
 	for _, issue := range issues {
-		fields := ParseMRFields(issue)
+		fields := issue.MRFields()
 		if fields != nil && fields.Rig != "" && !strings.EqualFold(fields.Rig, rigName) {
 			continue
 		}
@@ -1654,6 +1730,45 @@ func (b *Beads) ShowMultiple(ids []string) (map[string]*Issue, error) {
 	return b.showMultipleLocal(ids)
 }
 
+// ShowMany fetches multiple issues by ID in as few bd invocations as possible.
+// Unlike ShowMultiple, a missing ID is never an error: it is simply absent
+// from the returned map. bd show fails the whole batch when any ID is unknown,
+// so on ErrNotFound the remaining IDs are fetched individually.
+func (b *Beads) ShowMany(ids []string) (map[string]*Issue, error) {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+
+	result, err := b.ShowMultiple(unique)
+	if err == nil || !errors.Is(err, ErrNotFound) {
+		return result, err
+	}
+
+	if result == nil {
+		result = make(map[string]*Issue, len(unique))
+	}
+	for _, id := range unique {
+		if _, ok := result[id]; ok {
+			continue
+		}
+		issue, showErr := b.Show(id)
+		if showErr != nil {
+			if errors.Is(showErr, ErrNotFound) {
+				continue
+			}
+			return result, showErr
+		}
+		result[id] = issue
+	}
+	return result, nil
+}
+
 func (b *Beads) showMultipleLocal(ids []string) (map[string]*Issue, error) {
 	if len(ids) == 0 {
 		return make(map[string]*Issue), nil
@@ -27,15 +27,16 @@ func (b *Beads) FindMRForBranchAny(branch string) (*Issue, error) {
 // Returns nil if no MR matches both branch and SHA. Callers should create a
 // new MR in that case and supersede old MRs for the same source issue.
 func (b *Beads) FindMRForBranchAndSHA(branch, commitSHA string) (*Issue, error) {
+	branchPrefix := "branch: " + branch + "\n"
 	issues, err := b.ListMergeRequests(ListOptions{
-		Status: "all",
-		Label:  "gt:merge-request",
+		Status:       "all",
+		Label:        "gt:merge-request",
+		DescContains: branchPrefix,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	branchPrefix := "branch: " + branch + "\n"
 	for _, issue := range issues {
 		if issue.Status == "closed" {
 			continue
@@ -46,7 +47,7 @@ func (b *Beads) FindMRForBranchAndSHA(branch, commitSHA string) (*Issue, error)
 		// Branch matches — check commit SHA.
 		// If the MR has no commit_sha field (legacy), fall back to branch-only
 		// match for backward compatibility.
-		fields := ParseMRFields(issue)
+		fields := issue.MRFields()
 		if fields != nil && fields.CommitSHA != "" && commitSHA != "" {
 			if fields.CommitSHA != commitSHA {
 				// Same branch but different SHA — this is a stale MR.
@@ -63,14 +64,17 @@ func (b *Beads) FindMRForBranchAndSHA(branch, commitSHA string) (*Issue, error)
 // findMRForBranch searches the wisps table (Dolt) for a merge-request
 // bead matching the given branch.
 // Uses status=all which includes all issue statuses with full descriptions.
+// The branch filter is pushed down to bd so large towns don't load every MR;
+// the prefix check below still enforces the exact match.
 // Ephemeral=true routes to the wisps table where MR beads live (GH#2446).
 // When skipClosed is true, closed beads are excluded (for open-MR checks).
 func (b *Beads) findMRForBranch(branch string, skipClosed bool) (*Issue, error) {
 	branchPrefix := "branch: " + branch + "\n"
 
 	issues, err := b.ListMergeRequests(ListOptions{
-		Status: "all",
-		Label:  "gt:merge-request",
+		Status:       "all",
+		Label:        "gt:merge-request",
+		DescContains: branchPrefix,
 	})
 	if err != nil {
 		return nil, err
@@ -444,3 +444,186 @@ esac
 	}
 	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
 }
+
+// installListFilterBDStub installs a bd stub whose list subcommand logs its
+// args to MOCK_BD_LOG and returns three issues, two of which mention
+// "needle". When supportsDescContains is false it rejects --desc-contains the
+// way older bd releases do.
+func installListFilterBDStub(t *testing.T, supportsDescContains bool) string {
+	t.Helper()
+	ResetBdAllowStaleCacheForTest()
+	t.Cleanup(ResetBdAllowStaleCacheForTest)
+
+	binDir := t.TempDir()
+	logPath := filepath.Join(binDir, "bd.log")
+	reject := ""
+	if !supportsDescContains {
+		reject = `
+    case "$*" in
+      *--desc-contains=*) echo 'Error: unknown flag: --desc-contains' >&2; exit 1 ;;
+    esac`
+	}
+	script := `#!/bin/sh
+if [ "${1:-}" = "--allow-stale" ]; then
+  if [ "${2:-}" = "version" ]; then
+    echo "Error: unknown flag: --allow-stale" >&2
+    exit 0
+  fi
+  shift
+fi
+case "${1:-}" in
+  list)
+    echo "$*" >> "$MOCK_BD_LOG"` + reject + `
+    printf '%s\n' '[{"id":"gt-a","title":"a","description":"has needle","status":"open"},{"id":"gt-b","title":"b","description":"no match","status":"open"},{"id":"gt-c","title":"c","description":"HAS NEEDLE TOO","status":"open"}]'
+    exit 0
+    ;;
+  *)
+    printf '%s\n' '[]'
+    exit 0
+    ;;
+esac
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("MOCK_BD_LOG", logPath)
+	return logPath
+}
+
+func TestListPushesDownLimitAndDescContains(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses Unix shell script mock for bd")
+	}
+	logPath := installListFilterBDStub(t, true)
+
+	b := New(t.TempDir())
+	if _, err := b.List(ListOptions{Status: "all", Priority: -1, Limit: 2, DescContains: "needle"}); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("read bd log: %v", err)
+	}
+	log := string(data)
+	for _, want := range []string{"--limit=2", "--desc-contains=needle"} {
+		if !strings.Contains(log, want) {
+			t.Errorf("bd list args missing %q: %s", want, log)
+		}
+	}
+}
+
+func TestListDescContainsFallsBackClientSide(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses Unix shell script mock for bd")
+	}
+
+	tests := []struct {
+		name  string
+		limit int
+		want  []string
+	}{
+		{name: "unlimited", limit: 0, want: []string{"gt-a", "gt-c"}},
+		{name: "limit applies after filtering", limit: 1, want: []string{"gt-a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logPath := installListFilterBDStub(t, false)
+
+			b := New(t.TempDir())
+			issues, err := b.List(ListOptions{Status: "all", Priority: -1, Limit: tt.limit, DescContains: "needle"})
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			var got []string
+			for _, issue := range issues {
+				got = append(got, issue.ID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("List() = %v, want %v", got, tt.want)
+			}
+
+			data, err := os.ReadFile(logPath)
+			if err != nil {
+				t.Fatalf("read bd log: %v", err)
+			}
+			lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+			if len(lines) != 2 {
+				t.Fatalf("bd list calls = %d, want 2 (pushdown + fallback):\n%s", len(lines), data)
+			}
+			if retry := lines[1]; strings.Contains(retry, "--desc-contains") || !strings.Contains(retry, "--limit=0") {
+				t.Errorf("fallback args = %q, want no --desc-contains and --limit=0", retry)
+			}
+		})
+	}
+}
+
+func TestListMergeRequestsRespectsLimit(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses Unix shell script mock for bd")
+	}
+	ResetBdAllowStaleCacheForTest()
+	t.Cleanup(ResetBdAllowStaleCacheForTest)
+
+	binDir := t.TempDir()
+	logPath := filepath.Join(binDir, "bd.log")
+	// The sql stub ignores LIMIT and returns three wisps; show only knows the
+	// first two, so hydrating the third would fail.
+	script := `#!/bin/sh
+if [ "${1:-}" = "--allow-stale" ]; then
+  if [ "${2:-}" = "version" ]; then
+    echo "Error: unknown flag: --allow-stale" >&2
+    exit 0
+  fi
+  shift
+fi
+case "${1:-}" in
+  list)
+    printf '%s\n' '[]'
+    exit 0
+    ;;
+  sql)
+    echo "$*" >> "$MOCK_BD_LOG"
+    printf '%s\n' '[{"id":"gt-mr1","title":"m1","description":"branch: b\n","status":"open","labels_csv":"gt:merge-request"},{"id":"gt-mr2","title":"m2","description":"branch: b\n","status":"open","labels_csv":"gt:merge-request"},{"id":"gt-mr3","title":"m3","description":"branch: b\n","status":"open","labels_csv":"gt:merge-request"}]'
+    exit 0
+    ;;
+  show)
+    case "$*" in
+      *gt-mr3*) echo 'gt-mr3 should not be hydrated' >&2; exit 7 ;;
+    esac
+    printf '%s\n' '[{"id":"gt-mr1","title":"m1","description":"branch: b\n","status":"open"},{"id":"gt-mr2","title":"m2","description":"branch: b\n","status":"open"}]'
+    exit 0
+    ;;
+  *)
+    printf '%s\n' '[]'
+    exit 0
+    ;;
+esac
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("MOCK_BD_LOG", logPath)
+
+	b := New(t.TempDir())
+	issues, err := b.ListMergeRequests(ListOptions{Label: "gt:merge-request", Status: "open", Priority: -1, Limit: 2, DescContains: "branch: b"})
+	if err != nil {
+		t.Fatalf("ListMergeRequests() error = %v", err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("ListMergeRequests() returned %d issues, want 2", len(issues))
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("read bd log: %v", err)
+	}
+	query := string(data)
+	for _, want := range []string{"LIMIT 2", "LOCATE(LOWER('branch: b'), LOWER(w.description))"} {
+		if !strings.Contains(query, want) {
+			t.Errorf("wisps query missing %q: %s", want, query)
+		}
+	}
+}
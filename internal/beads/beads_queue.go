@@ -155,6 +155,15 @@ func ParseQueueFields(description string) *QueueFields {
 	return fields
 }
 
+// QueueFields returns the issue's queue fields (see ParseQueueFields).
+// Returns nil for a nil issue.
+func (i *Issue) QueueFields() *QueueFields {
+	if i == nil {
+		return nil
+	}
+	return ParseQueueFields(i.Description)
+}
+
 // QueueBeadID returns the queue bead ID for a given queue name.
 // Format: hq-q-<name> for town-level queues, gt-q-<name> for rig-level queues.
 func QueueBeadID(name string, isTownLevel bool) string {
@@ -217,7 +226,7 @@ func (b *Beads) GetQueueBead(id string) (*Issue, *QueueFields, error) {
 		return nil, nil, fmt.Errorf("issue %s is not a queue bead (missing gt:queue label)", id)
 	}
 
-	fields := ParseQueueFields(issue.Description)
+	fields := issue.QueueFields()
 	return issue, fields, nil
 }
 
@@ -317,7 +326,7 @@ func (b *Beads) LookupQueueByName(name string) (*Issue, *QueueFields, error) {
 	}
 
 	for _, issue := range queues {
-		fields := ParseQueueFields(issue.Description)
+		fields := issue.QueueFields()
 		if fields.Name == name {
 			return issue, fields, nil
 		}
@@ -378,7 +387,7 @@ func (b *Beads) FindEligibleQueues(identity string) ([]*Issue, []*QueueFields, e
 	var eligibleFields []*QueueFields
 
 	for _, issue := range queues {
-		fields := ParseQueueFields(issue.Description)
+		fields := issue.QueueFields()
 
 		// Skip inactive queues
 		if fields.Status != QueueStatusActive {
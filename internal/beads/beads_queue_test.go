@@ -202,6 +202,22 @@ func TestFormatQueueDescription(t *testing.T) {
 	}
 }
 
+func TestIssueQueueFields(t *testing.T) {
+	issue := &Issue{Description: "Work queue.\n\nname: build-queue\nclaim_pattern: gastown/polecats/*\nmax_concurrency: 3\n"}
+	fields := issue.QueueFields()
+	if fields == nil {
+		t.Fatal("QueueFields() = nil, want fields")
+	}
+	if fields.Name != "build-queue" || fields.ClaimPattern != "gastown/polecats/*" || fields.MaxConcurrency != 3 {
+		t.Errorf("QueueFields() = %+v", fields)
+	}
+
+	var nilIssue *Issue
+	if got := nilIssue.QueueFields(); got != nil {
+		t.Errorf("nil issue QueueFields() = %+v, want nil", got)
+	}
+}
+
 func TestParseQueueFields(t *testing.T) {
 	tests := []struct {
 		name        string
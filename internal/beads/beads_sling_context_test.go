@@ -18,11 +18,13 @@ func TestFormatParseSlingContextRoundTrip(t *testing.T) {
 		Merge:            "direct",
 		Convoy:           "hq-cv-test",
 		BaseBranch:       "develop",
+		TargetBranch:     "release/v2",
 		NoMerge:          true,
 		ReviewOnly:       true,
 		Account:          "acme",
 		Agent:            "gemini",
 		HookRawBead:      true,
+		NoBoot:           true,
 		Owned:            true,
 		Mode:             "ralph",
 		DispatchFailures: 2,
@@ -66,6 +68,9 @@ func TestFormatParseSlingContextRoundTrip(t *testing.T) {
 	if parsed.BaseBranch != original.BaseBranch {
 		t.Errorf("BaseBranch: got %q, want %q", parsed.BaseBranch, original.BaseBranch)
 	}
+	if parsed.TargetBranch != original.TargetBranch {
+		t.Errorf("TargetBranch: got %q, want %q", parsed.TargetBranch, original.TargetBranch)
+	}
 	if parsed.NoMerge != original.NoMerge {
 		t.Errorf("NoMerge: got %v, want %v", parsed.NoMerge, original.NoMerge)
 	}
@@ -81,6 +86,9 @@ func TestFormatParseSlingContextRoundTrip(t *testing.T) {
 	if parsed.HookRawBead != original.HookRawBead {
 		t.Errorf("HookRawBead: got %v, want %v", parsed.HookRawBead, original.HookRawBead)
 	}
+	if parsed.NoBoot != original.NoBoot {
+		t.Errorf("NoBoot: got %v, want %v", parsed.NoBoot, original.NoBoot)
+	}
 	if parsed.Owned != original.Owned {
 		t.Errorf("Owned: got %v, want %v", parsed.Owned, original.Owned)
 	}
@@ -110,6 +110,12 @@ func ParseAttachmentFields(issue *Issue) *AttachmentFields {
 	return fields
 }
 
+// Attachment returns the issue's attachment fields (see ParseAttachmentFields).
+// Safe to call on a nil issue.
+func (i *Issue) Attachment() *AttachmentFields {
+	return ParseAttachmentFields(i)
+}
+
 // FormatAttachmentFields formats AttachmentFields as a string suitable for an issue description.
 // Only non-empty fields are included.
 func FormatAttachmentFields(fields *AttachmentFields) string {
@@ -258,6 +264,17 @@ func SetAttachmentFields(issue *Issue, fields *AttachmentFields) string {
 	return formatted + "\n\n" + strings.Join(otherLines, "\n")
 }
 
+// Convoy merge strategies, stored in the Merge: convoy field and propagated
+// to attachment fields as MergeStrategy.
+const (
+	MergeStrategyDirect = "direct" // Push directly to the target branch, skip the merge queue
+	MergeStrategyMR     = "mr"     // Submit to the refinery merge queue
+	MergeStrategyLocal  = "local"  // Keep work on the branch; no push or MR
+
+	// DefaultMergeStrategy applies when a convoy has no Merge: field.
+	DefaultMergeStrategy = MergeStrategyMR
+)
+
 // ConvoyFields holds the structured fields for a convoy bead.
 // These fields are stored as key: value lines in the issue description.
 type ConvoyFields struct {
@@ -332,6 +349,12 @@ func ParseConvoyFields(issue *Issue) *ConvoyFields {
 	return fields
 }
 
+// ConvoyFields returns the issue's convoy fields (see ParseConvoyFields).
+// Safe to call on a nil issue.
+func (i *Issue) ConvoyFields() *ConvoyFields {
+	return ParseConvoyFields(i)
+}
+
 // NotificationAddresses returns deduplicated mail notification addresses from convoy fields.
 // Includes Owner, Notify, and all Watchers addresses.
 func (f *ConvoyFields) NotificationAddresses() []string {
@@ -748,6 +771,12 @@ func ParseMRFields(issue *Issue) *MRFields {
 	return fields
 }
 
+// MRFields returns the issue's merge-request fields (see ParseMRFields).
+// Safe to call on a nil issue.
+func (i *Issue) MRFields() *MRFields {
+	return ParseMRFields(i)
+}
+
 // parseIntField parses an integer from a string, returning 0 on error.
 func parseIntField(s string) (int, error) {
 	var n int
@@ -5,6 +5,59 @@ import (
 	"testing"
 )
 
+// --- Issue field accessors ---
+
+func TestIssueAttachment(t *testing.T) {
+	issue := &Issue{Description: "Some prose.\n\nattached_molecule: gt-wisp-abc\nattached_formula: mol-polecat-work\n"}
+	fields := issue.Attachment()
+	if fields == nil {
+		t.Fatal("Attachment() = nil, want fields")
+	}
+	if fields.AttachedMolecule != "gt-wisp-abc" || fields.AttachedFormula != "mol-polecat-work" {
+		t.Errorf("Attachment() = %+v", fields)
+	}
+
+	var nilIssue *Issue
+	if got := nilIssue.Attachment(); got != nil {
+		t.Errorf("nil issue Attachment() = %+v, want nil", got)
+	}
+	if got := (&Issue{Description: "just prose"}).Attachment(); got != nil {
+		t.Errorf("Attachment() without fields = %+v, want nil", got)
+	}
+}
+
+func TestIssueMRFields(t *testing.T) {
+	issue := &Issue{Description: "branch: polecat/nux/gt-123\nsource_issue: gt-123\ntarget: main\n"}
+	fields := issue.MRFields()
+	if fields == nil {
+		t.Fatal("MRFields() = nil, want fields")
+	}
+	if fields.Branch != "polecat/nux/gt-123" || fields.SourceIssue != "gt-123" || fields.Target != "main" {
+		t.Errorf("MRFields() = %+v", fields)
+	}
+
+	var nilIssue *Issue
+	if got := nilIssue.MRFields(); got != nil {
+		t.Errorf("nil issue MRFields() = %+v, want nil", got)
+	}
+}
+
+func TestIssueConvoyFields(t *testing.T) {
+	issue := &Issue{Description: "Convoy for release.\nmerge: direct\n"}
+	fields := issue.ConvoyFields()
+	if fields == nil {
+		t.Fatal("ConvoyFields() = nil, want fields")
+	}
+	if fields.Merge != MergeStrategyDirect {
+		t.Errorf("ConvoyFields().Merge = %q, want %q", fields.Merge, MergeStrategyDirect)
+	}
+
+	var nilIssue *Issue
+	if got := nilIssue.ConvoyFields(); got != nil {
+		t.Errorf("nil issue ConvoyFields() = %+v, want nil", got)
+	}
+}
+
 // --- parseIntField (not covered in beads_test.go) ---
 
 func TestParseIntField(t *testing.T) {
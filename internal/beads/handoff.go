@@ -284,7 +284,7 @@ func (b *Beads) DetachMolecule(pinnedBeadID string) (*Issue, error) {
 	}
 
 	// Check if there's anything to detach
-	if ParseAttachmentFields(issue) == nil {
+	if issue.Attachment() == nil {
 		return issue, nil // Nothing to detach
 	}
 
@@ -308,7 +308,7 @@ func (b *Beads) GetAttachment(pinnedBeadID string) (*AttachmentFields, error) {
 		return nil, err
 	}
 
-	return ParseAttachmentFields(issue), nil
+	return issue.Attachment(), nil
 }
 
 // currentTimestamp returns the current time in ISO 8601 format.
@@ -58,6 +58,49 @@ func LoadRoutes(beadsDir string) ([]Route, error) {
 	return routes, scanner.Err()
 }
 
+// ReadRoutes loads routes like LoadRoutes, but also drops duplicate prefixes so
+// the result always passes ValidateRoutes. The first route for a prefix wins
+// (matching bd's resolution order); later duplicates are reported on stderr.
+func ReadRoutes(beadsDir string) ([]Route, error) {
+	routes, err := LoadRoutes(beadsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]string, len(routes))
+	deduped := routes[:0]
+	for _, r := range routes {
+		if first, dup := seen[r.Prefix]; dup {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring duplicate route %s -> %s in %s (keeping %s)\n",
+				r.Prefix, r.Path, filepath.Join(beadsDir, RoutesFileName), first)
+			continue
+		}
+		seen[r.Prefix] = r.Path
+		deduped = append(deduped, r)
+	}
+	return deduped, nil
+}
+
+// ValidateRoutes checks that every route has a prefix and path and that no
+// prefix appears twice. A duplicate prefix makes cross-rig resolution depend
+// on file order, so it is rejected rather than silently written.
+func ValidateRoutes(routes []Route) error {
+	seen := make(map[string]string, len(routes))
+	for i, r := range routes {
+		if strings.TrimSpace(r.Prefix) == "" {
+			return fmt.Errorf("route %d: empty prefix", i+1)
+		}
+		if strings.TrimSpace(r.Path) == "" {
+			return fmt.Errorf("route %d (%s): empty path", i+1, r.Prefix)
+		}
+		if first, dup := seen[r.Prefix]; dup {
+			return fmt.Errorf("duplicate route prefix %s (paths %s and %s)", r.Prefix, first, r.Path)
+		}
+		seen[r.Prefix] = r.Path
+	}
+	return nil
+}
+
 // AppendRoute appends a route to routes.jsonl in the town's beads directory.
 // If the prefix already exists, it updates the path.
 func AppendRoute(townRoot string, route Route) error {
@@ -69,7 +112,7 @@ func AppendRoute(townRoot string, route Route) error {
 // If the prefix already exists, it updates the path.
 func AppendRouteToDir(beadsDir string, route Route) error {
 	// Load existing routes
-	routes, err := LoadRoutes(beadsDir)
+	routes, err := ReadRoutes(beadsDir)
 	if err != nil {
 		return fmt.Errorf("loading routes: %w", err)
 	}
@@ -97,7 +140,7 @@ func RemoveRoute(townRoot string, prefix string) error {
 	beadsDir := filepath.Join(townRoot, ".beads")
 
 	// Load existing routes
-	routes, err := LoadRoutes(beadsDir)
+	routes, err := ReadRoutes(beadsDir)
 	if err != nil {
 		return fmt.Errorf("loading routes: %w", err)
 	}
@@ -115,7 +158,12 @@ func RemoveRoute(townRoot string, prefix string) error {
 }
 
 // WriteRoutes writes routes to routes.jsonl, overwriting existing content.
+// Routes are checked with ValidateRoutes first; nothing is written on error.
 func WriteRoutes(beadsDir string, routes []Route) error {
+	if err := ValidateRoutes(routes); err != nil {
+		return fmt.Errorf("invalid routes: %w", err)
+	}
+
 	// Ensure beads directory exists
 	if err := os.MkdirAll(beadsDir, 0755); err != nil {
 		return fmt.Errorf("creating beads directory: %w", err)
@@ -728,3 +728,82 @@ func TestValidateRigPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteRoutes_RoundTrip(t *testing.T) {
+	beadsDir := t.TempDir()
+	routes := []Route{
+		{Prefix: "hq-", Path: "."},
+		{Prefix: "hq-cv-", Path: "."},
+		{Prefix: "gt-", Path: "gastown/mayor/rig"},
+	}
+	if err := WriteRoutes(beadsDir, routes); err != nil {
+		t.Fatalf("WriteRoutes: %v", err)
+	}
+
+	got, err := ReadRoutes(beadsDir)
+	if err != nil {
+		t.Fatalf("ReadRoutes: %v", err)
+	}
+	if len(got) != len(routes) {
+		t.Fatalf("ReadRoutes returned %d routes, want %d", len(got), len(routes))
+	}
+	for i := range routes {
+		if got[i] != routes[i] {
+			t.Errorf("route %d = %+v, want %+v", i, got[i], routes[i])
+		}
+	}
+}
+
+func TestWriteRoutes_RejectsInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		routes  []Route
+		wantErr string
+	}{
+		{"duplicate prefix", []Route{{Prefix: "gt-", Path: "gastown/mayor/rig"}, {Prefix: "gt-", Path: "other/mayor/rig"}}, "duplicate route prefix gt-"},
+		{"empty prefix", []Route{{Prefix: "", Path: "."}}, "empty prefix"},
+		{"empty path", []Route{{Prefix: "gt-", Path: " "}}, "empty path"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beadsDir := t.TempDir()
+			err := WriteRoutes(beadsDir, tt.routes)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("WriteRoutes() error = %v, want %q", err, tt.wantErr)
+			}
+			if _, statErr := os.Stat(filepath.Join(beadsDir, RoutesFileName)); !os.IsNotExist(statErr) {
+				t.Error("routes file should not be written when validation fails")
+			}
+		})
+	}
+}
+
+func TestReadRoutes_DropsDuplicatesKeepingFirst(t *testing.T) {
+	beadsDir := t.TempDir()
+	content := `{"prefix":"gt-","path":"gastown/mayor/rig"}
+{"prefix":"hq-","path":"."}
+{"prefix":"gt-","path":"stale/mayor/rig"}
+`
+	if err := os.WriteFile(filepath.Join(beadsDir, RoutesFileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ReadRoutes(beadsDir)
+	if err != nil {
+		t.Fatalf("ReadRoutes: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("ReadRoutes returned %d routes, want 2: %+v", len(got), got)
+	}
+	if got[0].Path != "gastown/mayor/rig" {
+		t.Errorf("first gt- route should win, got %s", got[0].Path)
+	}
+
+	// Appending to a file with duplicates heals it rather than failing validation.
+	if err := AppendRouteToDir(beadsDir, Route{Prefix: "bd-", Path: "beads/mayor/rig"}); err != nil {
+		t.Fatalf("AppendRouteToDir: %v", err)
+	}
+	if conflicts, _ := FindConflictingPrefixes(beadsDir); len(conflicts) != 0 {
+		t.Errorf("expected duplicates removed after rewrite, got %v", conflicts)
+	}
+}
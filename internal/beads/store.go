@@ -235,6 +235,10 @@ func issueFilterFromListOpts(opts ListOptions) beadsdk.IssueFilter {
 		f.NoAssignee = true
 	}
 
+	if opts.DescContains != "" {
+		f.DescriptionContains = opts.DescContains
+	}
+
 	if opts.Ephemeral {
 		eph := true
 		f.Ephemeral = &eph
@@ -433,6 +433,45 @@ func TestStoreShowMultiple(t *testing.T) {
 	}
 }
 
+func TestStoreShowManyMatchesShow(t *testing.T) {
+	store := newMockStorage()
+	b := newTestBeads(store)
+
+	store.CreateIssue(context.Background(), &beadsdk.Issue{Title: "one"}, "test")
+	store.CreateIssue(context.Background(), &beadsdk.Issue{Title: "two"}, "test")
+	store.CreateIssue(context.Background(), &beadsdk.Issue{Title: "three"}, "test")
+
+	ids := []string{"test-1", "test-3", "test-missing", "test-1"}
+	result, err := b.ShowMany(ids)
+	if err != nil {
+		t.Fatalf("ShowMany: %v", err)
+	}
+
+	for _, id := range ids {
+		want, showErr := b.Show(id)
+		got, ok := result[id]
+		if errors.Is(showErr, ErrNotFound) {
+			if ok {
+				t.Errorf("ShowMany returned %s, but Show reports not found", id)
+			}
+			continue
+		}
+		if showErr != nil {
+			t.Fatalf("Show(%s): %v", id, showErr)
+		}
+		if !ok {
+			t.Errorf("ShowMany missing %s", id)
+			continue
+		}
+		if got.ID != want.ID || got.Title != want.Title || got.Status != want.Status {
+			t.Errorf("ShowMany[%s] = %+v, Show = %+v", id, got, want)
+		}
+	}
+	if len(result) != 2 {
+		t.Errorf("expected 2 results, got %d", len(result))
+	}
+}
+
 func TestStoreShowMultipleEmpty(t *testing.T) {
 	store := newMockStorage()
 	b := newTestBeads(store)
@@ -671,6 +710,44 @@ func TestStoreUpdateRemoveLabels(t *testing.T) {
 	}
 }
 
+func TestStoreUpdateLabelsIncremental(t *testing.T) {
+	store := newMockStorage()
+	b := newTestBeads(store)
+
+	store.CreateIssue(context.Background(), &beadsdk.Issue{Title: "x"}, "test")
+	store.labels["test-1"] = []string{"gt:agent", "owner:mayor"}
+
+	// Adding a label must not clobber the existing ones.
+	if err := b.Update("test-1", UpdateOptions{AddLabels: []string{"stale"}}); err != nil {
+		t.Fatalf("Update add label: %v", err)
+	}
+	want := []string{"gt:agent", "owner:mayor", "stale"}
+	if got := store.labels["test-1"]; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("after add: got %v, want %v", got, want)
+	}
+
+	// Removing one label must leave the rest intact.
+	if err := b.Update("test-1", UpdateOptions{RemoveLabels: []string{"owner:mayor"}}); err != nil {
+		t.Fatalf("Update remove label: %v", err)
+	}
+	want = []string{"gt:agent", "stale"}
+	if got := store.labels["test-1"]; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("after remove: got %v, want %v", got, want)
+	}
+
+	// Add and remove in a single update.
+	if err := b.Update("test-1", UpdateOptions{
+		AddLabels:    []string{"owner:witness"},
+		RemoveLabels: []string{"stale"},
+	}); err != nil {
+		t.Fatalf("Update add+remove: %v", err)
+	}
+	want = []string{"gt:agent", "owner:witness"}
+	if got := store.labels["test-1"]; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("after add+remove: got %v, want %v", got, want)
+	}
+}
+
 func TestStoreUpdateRemoveLabelsError(t *testing.T) {
 	store := newMockStorage()
 	store.removeLabelErr = errors.New("label remove failed")
@@ -918,3 +995,30 @@ func containsSubstr(s, substr string) bool {
 	}
 	return false
 }
+
+// closeCountingStorage records Close calls on a mockStorage.
+type closeCountingStorage struct {
+	*mockStorage
+	closes int
+}
+
+func (s *closeCountingStorage) Close() error {
+	s.closes++
+	return nil
+}
+
+// TestBeadsShutdown verifies Shutdown is idempotent and leaves a caller-owned
+// store open.
+func TestBeadsShutdown(t *testing.T) {
+	store := &closeCountingStorage{mockStorage: newMockStorage()}
+	for _, b := range []*Beads{New(t.TempDir()), NewWithStore(t.TempDir(), store)} {
+		for i := 0; i < 2; i++ {
+			if err := b.Shutdown(); err != nil {
+				t.Fatalf("Shutdown #%d: %v", i+1, err)
+			}
+		}
+	}
+	if store.closes != 0 {
+		t.Errorf("store closed %d time(s), want 0 (caller owns it)", store.closes)
+	}
+}
@@ -0,0 +1,112 @@
+package beads
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/deps"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// MinBdVersion is the minimum bd release whose behavior Gas Town relies on.
+// It tracks deps.MinBeadsVersion so install checks and runtime checks agree.
+const MinBdVersion = deps.MinBeadsVersion
+
+// KnownBuggyBdVersions maps bd releases with known data-loss or correctness
+// bugs to a short description of the problem. Doctor calls these out
+// explicitly so users know why an upgrade matters.
+var KnownBuggyBdVersions = map[string]string{
+	"0.47.2": "database writes don't commit",
+}
+
+// bdVersionRe matches the semver in `bd --version` output, e.g.
+// "bd version 0.57.0 (dev: main@abc123)".
+var bdVersionRe = regexp.MustCompile(`(\d+\.\d+\.\d+)`)
+
+// bdVersion caches the parsed bd version, keyed by resolved bd path so a bd
+// swapped on PATH (tests, upgrades) is re-probed.
+var (
+	bdVersionMu     sync.Mutex
+	bdVersionPath   string
+	bdVersionResult string
+	// bdVersionProbeTimeout bounds the version probe so a wedged bd cannot
+	// hang doctor.
+	bdVersionProbeTimeout = 10 * time.Second
+)
+
+// ResetBdVersionCacheForTest clears the cached bd version.
+// It exists for tests that swap bd binaries on PATH within a single process.
+func ResetBdVersionCacheForTest() {
+	bdVersionMu.Lock()
+	bdVersionPath = ""
+	bdVersionResult = ""
+	bdVersionMu.Unlock()
+}
+
+// BdVersion returns the installed bd version as "X.Y.Z".
+// The result is cached per bd path.
+func BdVersion() (string, error) {
+	bdPath, err := exec.LookPath("bd")
+	if err != nil {
+		return "", ErrNotInstalled
+	}
+
+	bdVersionMu.Lock()
+	if bdVersionPath == bdPath {
+		v := bdVersionResult
+		bdVersionMu.Unlock()
+		return v, nil
+	}
+	bdVersionMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), bdVersionProbeTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, bdPath, "--version") //nolint:gosec // G204: bd is a trusted internal tool
+	util.SetProcessGroup(cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("running bd --version: %w", err)
+	}
+
+	version := parseBdVersion(string(out))
+	if version == "" {
+		return "", fmt.Errorf("could not parse bd version from %q", string(out))
+	}
+
+	bdVersionMu.Lock()
+	bdVersionPath = bdPath
+	bdVersionResult = version
+	bdVersionMu.Unlock()
+	return version, nil
+}
+
+// CheckVersion reports whether the installed bd is at least min.
+// actual is the parsed installed version; err is non-nil when bd is missing
+// or its version cannot be determined.
+func CheckVersion(min string) (ok bool, actual string, err error) {
+	actual, err = BdVersion()
+	if err != nil {
+		return false, "", err
+	}
+	return deps.CompareVersions(actual, min) >= 0, actual, nil
+}
+
+// KnownBdVersionIssue returns the known bug description for version, or ""
+// if the version has no recorded issues.
+func KnownBdVersionIssue(version string) string {
+	return KnownBuggyBdVersions[version]
+}
+
+// parseBdVersion extracts "X.Y.Z" from bd version output.
+func parseBdVersion(output string) string {
+	m := bdVersionRe.FindStringSubmatch(output)
+	if len(m) < 2 {
+		return ""
+	}
+	return m[1]
+}
@@ -0,0 +1,69 @@
+package beads
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestParseBdVersion(t *testing.T) {
+	tests := []struct {
+		output string
+		want   string
+	}{
+		{"bd version 0.57.0", "0.57.0"},
+		{"bd version 0.47.2 (dev: main@abc123)\n", "0.47.2"},
+		{"1.0.5", "1.0.5"},
+		{"bd version dev", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := parseBdVersion(tt.output); got != tt.want {
+			t.Errorf("parseBdVersion(%q) = %q, want %q", tt.output, got, tt.want)
+		}
+	}
+}
+
+func TestCheckVersion_FakeBd(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script fake bd not supported on Windows")
+	}
+	dir := t.TempDir()
+	script := "#!/bin/sh\necho 'bd version 0.47.2 (dev)'\n"
+	if err := os.WriteFile(filepath.Join(dir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir)
+	ResetBdVersionCacheForTest()
+	t.Cleanup(ResetBdVersionCacheForTest)
+
+	ok, actual, err := CheckVersion("0.57.0")
+	if err != nil {
+		t.Fatalf("CheckVersion: %v", err)
+	}
+	if ok {
+		t.Error("expected 0.47.2 to be below 0.57.0")
+	}
+	if actual != "0.47.2" {
+		t.Errorf("actual = %q, want 0.47.2", actual)
+	}
+	if KnownBdVersionIssue(actual) == "" {
+		t.Error("expected 0.47.2 to be flagged as known-buggy")
+	}
+
+	ok, _, err = CheckVersion("0.47.0")
+	if err != nil || !ok {
+		t.Errorf("CheckVersion(0.47.0) = %v, %v; want true, nil", ok, err)
+	}
+}
+
+func TestCheckVersion_NotInstalled(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	ResetBdVersionCacheForTest()
+	t.Cleanup(ResetBdVersionCacheForTest)
+
+	if _, _, err := CheckVersion(MinBdVersion); err == nil {
+		t.Error("expected error when bd is not installed")
+	}
+}
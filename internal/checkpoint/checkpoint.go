@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/atomicfile"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/util"
 )
@@ -19,6 +20,16 @@ import (
 // Filename is the checkpoint file name within the polecat directory.
 const Filename = ".polecat-checkpoint.json"
 
+// WriteDebounce is the window in which Write skips rewriting a checkpoint
+// whose recoverable state is unchanged. Frequent step updates would otherwise
+// rewrite the file just to bump its timestamp.
+const WriteDebounce = 5 * time.Second
+
+// MaxModifiedFiles caps how many modified files a checkpoint records, so a
+// worktree with a huge dirty set can't bloat the file. The overflow count is
+// kept in ModifiedFilesOmitted.
+const MaxModifiedFiles = 200
+
 // Checkpoint represents a session recovery checkpoint.
 type Checkpoint struct {
 	// MoleculeID is the current molecule being worked.
@@ -33,6 +44,9 @@ type Checkpoint struct {
 	// ModifiedFiles lists files modified since the last commit.
 	ModifiedFiles []string `json:"modified_files,omitempty"`
 
+	// ModifiedFilesOmitted counts modified files dropped by the MaxModifiedFiles cap.
+	ModifiedFilesOmitted int `json:"modified_files_omitted,omitempty"`
+
 	// LastCommit is the SHA of the last commit.
 	LastCommit string `json:"last_commit,omitempty"`
 
@@ -78,7 +92,10 @@ func Read(polecatDir string) (*Checkpoint, error) {
 	return &cp, nil
 }
 
-// Write saves a checkpoint to the polecat directory.
+// Write saves a checkpoint to the polecat directory, atomically replacing any
+// previous one (temp file + rename), so a crash mid-write never leaves a
+// truncated checkpoint. Writes are skipped when the existing checkpoint holds
+// the same state and is younger than WriteDebounce.
 func Write(polecatDir string, cp *Checkpoint) error {
 	// Set timestamp if not already set
 	if cp.Timestamp.IsZero() {
@@ -93,19 +110,51 @@ func Write(polecatDir string, cp *Checkpoint) error {
 		}
 	}
 
+	if len(cp.ModifiedFiles) > MaxModifiedFiles {
+		cp.ModifiedFilesOmitted += len(cp.ModifiedFiles) - MaxModifiedFiles
+		cp.ModifiedFiles = cp.ModifiedFiles[:MaxModifiedFiles]
+	}
+
+	if prev, err := Read(polecatDir); err == nil && prev != nil && prev.sameState(cp) {
+		if since := cp.Timestamp.Sub(prev.Timestamp); since >= 0 && since < WriteDebounce {
+			return nil
+		}
+	}
+
 	data, err := json.MarshalIndent(cp, "", "  ")
 	if err != nil {
 		return fmt.Errorf("marshaling checkpoint: %w", err)
 	}
 
-	path := Path(polecatDir)
-	if err := os.WriteFile(path, data, 0600); err != nil {
+	if err := atomicfile.WriteFile(Path(polecatDir), data, 0600); err != nil {
 		return fmt.Errorf("writing checkpoint: %w", err)
 	}
 
 	return nil
 }
 
+// sameState reports whether two checkpoints carry the same recoverable state,
+// ignoring when and by which session they were written.
+func (cp *Checkpoint) sameState(other *Checkpoint) bool {
+	if cp.MoleculeID != other.MoleculeID ||
+		cp.CurrentStep != other.CurrentStep ||
+		cp.StepTitle != other.StepTitle ||
+		cp.LastCommit != other.LastCommit ||
+		cp.Branch != other.Branch ||
+		cp.HookedBead != other.HookedBead ||
+		cp.Notes != other.Notes ||
+		cp.ModifiedFilesOmitted != other.ModifiedFilesOmitted ||
+		len(cp.ModifiedFiles) != len(other.ModifiedFiles) {
+		return false
+	}
+	for i := range cp.ModifiedFiles {
+		if cp.ModifiedFiles[i] != other.ModifiedFiles[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // Remove deletes the checkpoint file.
 func Remove(polecatDir string) error {
 	path := Path(polecatDir)
@@ -115,6 +164,20 @@ func Remove(polecatDir string) error {
 	return nil
 }
 
+// Clear removes a completed checkpoint along with any temp files left by an
+// interrupted Write. Call it after a clean handoff so the next session's
+// crash-recovery detection doesn't fire on finished work.
+func Clear(polecatDir string) error {
+	if err := Remove(polecatDir); err != nil {
+		return err
+	}
+	tmps, _ := filepath.Glob(Path(polecatDir) + ".tmp.*")
+	for _, tmp := range tmps {
+		_ = os.Remove(tmp)
+	}
+	return nil
+}
+
 // Capture creates a checkpoint by capturing current git and work state.
 func Capture(polecatDir string) (*Checkpoint, error) {
 	cp := &Checkpoint{
@@ -206,8 +269,8 @@ func (cp *Checkpoint) Summary() string {
 		parts = append(parts, fmt.Sprintf("hooked: %s", cp.HookedBead))
 	}
 
-	if len(cp.ModifiedFiles) > 0 {
-		parts = append(parts, fmt.Sprintf("%d modified files", len(cp.ModifiedFiles)))
+	if n := len(cp.ModifiedFiles) + cp.ModifiedFilesOmitted; n > 0 {
+		parts = append(parts, fmt.Sprintf("%d modified files", n))
 	}
 
 	if cp.Branch != "" {
@@ -2,6 +2,7 @@ package checkpoint
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -165,6 +166,149 @@ func TestRemove(t *testing.T) {
 	}
 }
 
+func TestWriteReplacesAtomically(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	first := &Checkpoint{
+		MoleculeID:    "mol-1",
+		ModifiedFiles: []string{"a.go", "b.go", "c.go"},
+		Notes:         "first checkpoint with a much longer note than the second",
+	}
+	if err := Write(tmpDir, first); err != nil {
+		t.Fatalf("Write first: %v", err)
+	}
+
+	second := &Checkpoint{MoleculeID: "mol-2", Notes: "second"}
+	if err := Write(tmpDir, second); err != nil {
+		t.Fatalf("Write second: %v", err)
+	}
+
+	// The file must hold exactly the second checkpoint: no leftover bytes
+	// from the longer first write and no accumulated history.
+	data, err := os.ReadFile(Path(tmpDir))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	var loaded Checkpoint
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		t.Fatalf("checkpoint is not a single JSON document: %v\n%s", err, data)
+	}
+	if loaded.MoleculeID != "mol-2" || loaded.Notes != "second" || len(loaded.ModifiedFiles) != 0 {
+		t.Errorf("loaded = %+v, want only the second checkpoint", loaded)
+	}
+
+	// No temp files left behind after a successful write.
+	tmps, _ := filepath.Glob(Path(tmpDir) + ".tmp.*")
+	if len(tmps) != 0 {
+		t.Errorf("temp files left behind: %v", tmps)
+	}
+}
+
+func TestWriteDebouncesUnchangedState(t *testing.T) {
+	tmpDir := t.TempDir()
+	ts := time.Now().Truncate(time.Second)
+
+	if err := Write(tmpDir, &Checkpoint{MoleculeID: "mol-1", Timestamp: ts}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	// Same state inside the window: skipped, timestamp unchanged.
+	if err := Write(tmpDir, &Checkpoint{MoleculeID: "mol-1", Timestamp: ts.Add(time.Second)}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	loaded, err := Read(tmpDir)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if !loaded.Timestamp.Equal(ts) {
+		t.Errorf("Timestamp = %v, want %v (debounced write should be skipped)", loaded.Timestamp, ts)
+	}
+
+	// Changed state inside the window: written.
+	if err := Write(tmpDir, &Checkpoint{MoleculeID: "mol-1", CurrentStep: "step-2", Timestamp: ts.Add(2 * time.Second)}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	loaded, err = Read(tmpDir)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if loaded.CurrentStep != "step-2" {
+		t.Errorf("CurrentStep = %q, want %q", loaded.CurrentStep, "step-2")
+	}
+
+	// Same state after the window: written.
+	later := ts.Add(2*time.Second + WriteDebounce)
+	if err := Write(tmpDir, &Checkpoint{MoleculeID: "mol-1", CurrentStep: "step-2", Timestamp: later}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	loaded, err = Read(tmpDir)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if !loaded.Timestamp.Equal(later) {
+		t.Errorf("Timestamp = %v, want %v", loaded.Timestamp, later)
+	}
+}
+
+func TestWriteCapsModifiedFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := make([]string, MaxModifiedFiles+25)
+	for i := range files {
+		files[i] = fmt.Sprintf("pkg/file%d.go", i)
+	}
+	if err := Write(tmpDir, &Checkpoint{ModifiedFiles: files}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	loaded, err := Read(tmpDir)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if len(loaded.ModifiedFiles) != MaxModifiedFiles {
+		t.Errorf("len(ModifiedFiles) = %d, want %d", len(loaded.ModifiedFiles), MaxModifiedFiles)
+	}
+	if loaded.ModifiedFilesOmitted != 25 {
+		t.Errorf("ModifiedFilesOmitted = %d, want 25", loaded.ModifiedFilesOmitted)
+	}
+	if got, want := loaded.Summary(), "225 modified files"; got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+}
+
+func TestClear(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	if err := Write(tmpDir, &Checkpoint{Notes: "finished work"}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	// Simulate a temp file left by an interrupted write.
+	stray := Path(tmpDir) + ".tmp.12345"
+	if err := os.WriteFile(stray, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Clear(tmpDir); err != nil {
+		t.Fatalf("Clear: %v", err)
+	}
+
+	cp, err := Read(tmpDir)
+	if err != nil {
+		t.Fatalf("Read after Clear: %v", err)
+	}
+	if cp != nil {
+		t.Errorf("Read after Clear = %+v, want nil", cp)
+	}
+	if _, err := os.Stat(stray); !os.IsNotExist(err) {
+		t.Errorf("stray temp file should be removed by Clear")
+	}
+
+	// Clear with nothing to clear should not error
+	if err := Clear(tmpDir); err != nil {
+		t.Fatalf("Clear non-existent: %v", err)
+	}
+}
+
 func TestCapture(t *testing.T) {
 	// Use current directory (should be a git repo)
 	cwd, err := os.Getwd()
@@ -247,7 +247,11 @@ func getAllSocketSessions(includePolecats bool) []socketGroup {
 	var groups []socketGroup
 
 	// Town socket: GT agent sessions
-	townTmux := tmux.NewTmuxWithSocket(townSocket) // explicit socket avoids default-socket ambiguity
+	townTmuxSocket := townSocket
+	if townTmuxSocket == "" {
+		townTmuxSocket = "default" // no town socket configured: town runs on tmux's default server
+	}
+	townTmux := tmux.NewTmuxWithSocket(townTmuxSocket) // explicit socket avoids default-socket ambiguity
 	if sessions, err := townTmux.ListSessions(); err == nil && len(sessions) > 0 {
 		agents := filterAndSortSessions(sessions, includePolecats)
 		for _, a := range agents {
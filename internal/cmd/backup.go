@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	backupVerifyThreshold float64
+	backupVerifyJSON      bool
+)
+
+var backupCmd = &cobra.Command{
+	Use:     "backup",
+	GroupID: GroupServices,
+	Short:   "Inspect the JSONL git backup",
+	RunE:    requireSubcommand,
+}
+
+var backupVerifyCmd = &cobra.Command{
+	Use:   "verify [repo]",
+	Short: "Check the JSONL backup repo for count spikes and test pollution",
+	Long: `Run the jsonl_git_backup patrol's verification checks on demand.
+
+For each database's issues.jsonl in the backup git repo, the exported
+(working tree) record count is compared to the last commit. A change larger
+than the threshold is reported as a spike, exactly as the patrol would
+report it before halting an export (growth gets twice the tolerance of a
+drop). The number of records the test-pollution filter would remove is
+also shown.
+
+The repo defaults to the patrol's git_repo (~/.dolt-archive/git), and the
+threshold to its spike_threshold. Nothing is modified.
+
+Exits non-zero when any spike exceeds the threshold.
+
+Examples:
+  gt backup verify
+  gt backup verify ~/.dolt-archive/git --threshold=0.2
+  gt backup verify --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runBackupVerify,
+}
+
+func init() {
+	backupVerifyCmd.Flags().Float64Var(&backupVerifyThreshold, "threshold", 0, "Fractional change that counts as a spike (default: patrol spike_threshold)")
+	backupVerifyCmd.Flags().BoolVar(&backupVerifyJSON, "json", false, "Output as JSON")
+
+	backupCmd.AddCommand(backupVerifyCmd)
+	rootCmd.AddCommand(backupCmd)
+}
+
+func runBackupVerify(cmd *cobra.Command, args []string) error {
+	// Outside a town the patrol defaults still apply.
+	var config *daemon.JsonlGitBackupConfig
+	if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
+		if pc := daemon.LoadPatrolConfig(townRoot); pc != nil && pc.Patrols != nil {
+			config = pc.Patrols.JsonlGitBackup
+		}
+	}
+
+	gitRepo := ""
+	if len(args) > 0 {
+		gitRepo = args[0]
+	} else {
+		repo, err := daemon.JsonlBackupGitRepo(config)
+		if err != nil {
+			return err
+		}
+		gitRepo = repo
+	}
+
+	threshold := backupVerifyThreshold
+	if !cmd.Flags().Changed("threshold") {
+		threshold = daemon.JsonlBackupSpikeThreshold(config)
+	}
+
+	var databases []string
+	if config != nil {
+		databases = config.Databases
+	}
+
+	result, err := daemon.VerifyJsonlBackup(gitRepo, databases, threshold)
+	if err != nil {
+		return err
+	}
+
+	if backupVerifyJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+	} else {
+		printBackupVerify(os.Stdout, result)
+	}
+
+	if result.Spikes() > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+func printBackupVerify(w io.Writer, r *daemon.JsonlBackupVerifyResult) {
+	fmt.Fprintf(w, "%s %s (threshold %.0f%%)\n\n", style.Bold.Render("Backup:"), r.GitRepo, r.Threshold*100)
+
+	if len(r.Databases) == 0 {
+		fmt.Fprintf(w, "  %s\n", style.Dim.Render("No exported databases found."))
+		return
+	}
+
+	for _, db := range r.Databases {
+		icon := style.SuccessPrefix
+		if db.Spike {
+			icon = style.ErrorPrefix
+		}
+		change := fmt.Sprintf("%d → %d", db.Previous, db.Current)
+		if db.Previous == 0 {
+			change = fmt.Sprintf("%d (first export)", db.Current)
+		}
+		fmt.Fprintf(w, "  %s %s: %s", icon, db.DB, change)
+		if db.Spike {
+			fmt.Fprintf(w, " %s", style.Error.Render(fmt.Sprintf("[spike %.1f%%]", db.Delta*100)))
+		}
+		if db.Pollution > 0 {
+			fmt.Fprintf(w, " %s", style.Warning.Render(fmt.Sprintf("[%d test-pollution record(s)]", db.Pollution)))
+		}
+		fmt.Fprintln(w)
+	}
+
+	if r.SpikeReport != "" {
+		fmt.Fprintf(w, "\n%s\n", r.SpikeReport)
+	}
+}
@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -29,6 +32,41 @@ type bdCmd struct {
 	gtRoot     string
 	beadsDir   string
 	routing    bool
+
+	retries      int
+	retryBackoff time.Duration
+	timeout      time.Duration
+}
+
+// ErrBdTimeout is wrapped into the error returned when a bd command exceeds
+// its deadline and is killed, so callers can tell a wedged bd or Dolt server
+// apart from a command that ran and failed.
+var ErrBdTimeout = errors.New("bd command timed out")
+
+// Default retry policy for bd calls known to hit transient Dolt failures
+// (convoy creation and dependency adds).
+const (
+	bdTransientRetries = 2
+	bdTransientBackoff = 500 * time.Millisecond
+
+	// bdMutationTimeout bounds each attempt of those calls so a wedged Dolt
+	// server cannot stall sling or convoy operations for the full retry budget.
+	bdMutationTimeout = 20 * time.Second
+)
+
+// bdRetrySleep is the sleep used between retry attempts. Overridden in tests.
+var bdRetrySleep = time.Sleep
+
+// bdTransientErrorPatterns are bd/Dolt failure messages that indicate a
+// transient connection problem rather than a logical error. Matching is
+// case-insensitive against the error and captured stderr.
+var bdTransientErrorPatterns = []string{
+	"database is closed", // bd 0.47.2 auto-flush race
+	"connection reset",
+	"connection refused",
+	"broken pipe",
+	"bad connection",
+	"i/o timeout",
 }
 
 // BdCmd creates a new bd command builder with the given arguments.
@@ -63,6 +101,25 @@ func (b *bdCmd) AllowStale() *bdCmd {
 	return b
 }
 
+// WithRetry retries the command up to n more times when it fails with a
+// recognized transient error (see bdTransientErrorPatterns). The wait before
+// each retry starts at backoff and doubles. Logical failures such as
+// "not found" or validation errors are never retried.
+func (b *bdCmd) WithRetry(n int, backoff time.Duration) *bdCmd {
+	b.retries = n
+	b.retryBackoff = backoff
+	return b
+}
+
+// WithTimeout bounds the command's run time. When the deadline passes the
+// subprocess (and its process group) is killed and the returned error wraps
+// ErrBdTimeout. Without it, GT_BD_TIMEOUT_SEC or constants.BdCommandTimeout
+// applies. With WithRetry, the timeout applies to each attempt.
+func (b *bdCmd) WithTimeout(d time.Duration) *bdCmd {
+	b.timeout = d
+	return b
+}
+
 // WithGTRoot adds GT_ROOT=root to the environment.
 // This is required for bd to find town-level formulas and configuration.
 func (b *bdCmd) WithGTRoot(root string) *bdCmd {
@@ -184,6 +241,14 @@ func resolveBdCmdTimeout() time.Duration {
 	return constants.BdCommandTimeout
 }
 
+// resolveTimeout returns the deadline for one run of this command.
+func (b *bdCmd) resolveTimeout() time.Duration {
+	if b.timeout > 0 {
+		return b.timeout
+	}
+	return resolveBdCmdTimeout()
+}
+
 func (b *bdCmd) buildContextCommand(ctx context.Context) *exec.Cmd {
 	args := b.resolvedArgs()
 	cmd := exec.CommandContext(ctx, "bd", args...)
@@ -200,7 +265,7 @@ func (b *bdCmd) wrapTimeout(err error, deadline time.Duration) error {
 		return nil
 	}
 	if strings.Contains(err.Error(), context.DeadlineExceeded.Error()) {
-		return fmt.Errorf("%s timed out after %v: %w", b.argsDesc(), deadline, err)
+		return fmt.Errorf("%s: %w after %v: %w", b.argsDesc(), ErrBdTimeout, deadline, err)
 	}
 	return err
 }
@@ -210,7 +275,7 @@ func (b *bdCmd) wrapCommandError(ctx context.Context, err error, deadline time.D
 		return nil
 	}
 	if ctx.Err() == context.DeadlineExceeded {
-		return fmt.Errorf("%s timed out after %v: %w", b.argsDesc(), deadline, err)
+		return fmt.Errorf("%s: %w after %v: %w", b.argsDesc(), ErrBdTimeout, deadline, err)
 	}
 	return b.wrapTimeout(err, deadline)
 }
@@ -253,13 +318,78 @@ func (b *bdCmd) resolvedArgs() []string {
 	return filtered
 }
 
+// isTransientBdError reports whether a failed bd invocation looks transient,
+// based on the error text and any captured output.
+func isTransientBdError(err error, output []byte) bool {
+	if err == nil || errors.Is(err, ErrBdTimeout) {
+		return false
+	}
+	msg := strings.ToLower(err.Error() + "\n" + string(output))
+	for _, pattern := range bdTransientErrorPatterns {
+		if strings.Contains(msg, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// withRetry runs attempt, retrying on transient failures as configured by
+// WithRetry. attempt receives a writer that must receive the command's
+// stderr so transient patterns reported there can be recognized.
+func (b *bdCmd) withRetry(attempt func(stderr io.Writer) ([]byte, error)) ([]byte, error) {
+	if b.retries <= 0 {
+		return attempt(nil)
+	}
+
+	// Stdin is consumed by each run, so buffer it for replay.
+	var stdin []byte
+	if b.stdin != nil {
+		data, err := io.ReadAll(b.stdin)
+		if err != nil {
+			return nil, fmt.Errorf("reading stdin for %s: %w", b.argsDesc(), err)
+		}
+		stdin = data
+	}
+
+	backoff := b.retryBackoff
+	for i := 0; ; i++ {
+		if stdin != nil {
+			b.stdin = bytes.NewReader(stdin)
+		}
+		var captured bytes.Buffer
+		out, err := attempt(&captured)
+		if err == nil || i >= b.retries || !isTransientBdError(err, append(captured.Bytes(), out...)) {
+			return out, err
+		}
+		bdRetrySleep(backoff)
+		backoff *= 2
+	}
+}
+
+// teeStderr returns the stderr writer for a single attempt, copying output
+// to the retry capture buffer when one is provided.
+func (b *bdCmd) teeStderr(capture io.Writer) io.Writer {
+	if capture == nil {
+		return b.stderr
+	}
+	if b.stderr == nil {
+		return capture
+	}
+	return io.MultiWriter(b.stderr, capture)
+}
+
 // Run builds and runs the command, returning any error.
 // This is a convenience method equivalent to Build().Run().
 func (b *bdCmd) Run() error {
-	deadline := resolveBdCmdTimeout()
-	ctx, cancel := context.WithTimeout(context.Background(), deadline)
-	defer cancel()
-	return b.wrapCommandError(ctx, b.buildContextCommand(ctx).Run(), deadline)
+	_, err := b.withRetry(func(stderr io.Writer) ([]byte, error) {
+		deadline := b.resolveTimeout()
+		ctx, cancel := context.WithTimeout(context.Background(), deadline)
+		defer cancel()
+		cmd := b.buildContextCommand(ctx)
+		cmd.Stderr = b.teeStderr(stderr)
+		return nil, b.wrapCommandError(ctx, cmd.Run(), deadline)
+	})
+	return err
 }
 
 // Output builds and runs the command, returning stdout and any error.
@@ -267,26 +397,72 @@ func (b *bdCmd) Run() error {
 // Note: Output() captures stdout but Stderr must still be configured
 // separately if you want to capture stderr instead of it going to os.Stderr.
 func (b *bdCmd) Output() ([]byte, error) {
-	deadline := resolveBdCmdTimeout()
-	ctx, cancel := context.WithTimeout(context.Background(), deadline)
-	defer cancel()
-	out, err := b.buildContextCommand(ctx).Output()
-	return out, b.wrapCommandError(ctx, err, deadline)
+	return b.withRetry(func(stderr io.Writer) ([]byte, error) {
+		deadline := b.resolveTimeout()
+		ctx, cancel := context.WithTimeout(context.Background(), deadline)
+		defer cancel()
+		cmd := b.buildContextCommand(ctx)
+		cmd.Stderr = b.teeStderr(stderr)
+		out, err := cmd.Output()
+		return out, b.wrapCommandError(ctx, err, deadline)
+	})
+}
+
+// bdJSONOutputLimit caps how much raw output a bdJSONError quotes.
+const bdJSONOutputLimit = 200
+
+// bdJSONError reports bd output that ran successfully but could not be
+// decoded as JSON. It quotes the command and the start of the raw output.
+type bdJSONError struct {
+	desc   string
+	output []byte
+	err    error
+}
+
+func (e *bdJSONError) Error() string {
+	out := strings.TrimSpace(string(e.output))
+	if len(out) > bdJSONOutputLimit {
+		out = out[:bdJSONOutputLimit] + "..."
+	}
+	return fmt.Sprintf("parsing %s output: %v (output: %q)", e.desc, e.err, out)
+}
+
+func (e *bdJSONError) Unwrap() error { return e.err }
+
+// BdJSON runs b, captures stdout, and decodes it as JSON into T.
+// Command failures are returned as-is; decode failures are returned as a
+// *bdJSONError naming the command and quoting the raw output.
+//
+// Example:
+//
+//	issues, err := BdJSON[[]beads.Issue](BdCmd("show", id, "--json").Dir(dir))
+func BdJSON[T any](b *bdCmd) (T, error) {
+	var v T
+	out, err := b.Output()
+	if err != nil {
+		return v, err
+	}
+	if err := json.Unmarshal(out, &v); err != nil {
+		return v, &bdJSONError{desc: b.argsDesc(), output: out, err: err}
+	}
+	return v, nil
 }
 
 // CombinedOutput builds and runs the command, returning combined stdout+stderr.
 // This overrides the configured Stderr writer to capture both streams.
 // Useful for including command output in error messages.
 func (b *bdCmd) CombinedOutput() ([]byte, error) {
-	deadline := resolveBdCmdTimeout()
-	ctx, cancel := context.WithTimeout(context.Background(), deadline)
-	defer cancel()
-	args := b.resolvedArgs()
-	cmd := exec.CommandContext(ctx, "bd", args...)
-	util.SetProcessGroup(cmd)
-	cmd.Dir = b.dir
-	cmd.Env = b.buildEnv()
-	cmd.Stdin = b.stdin
-	out, err := cmd.CombinedOutput()
-	return out, b.wrapCommandError(ctx, err, deadline)
+	return b.withRetry(func(io.Writer) ([]byte, error) {
+		deadline := b.resolveTimeout()
+		ctx, cancel := context.WithTimeout(context.Background(), deadline)
+		defer cancel()
+		args := b.resolvedArgs()
+		cmd := exec.CommandContext(ctx, "bd", args...)
+		util.SetProcessGroup(cmd)
+		cmd.Dir = b.dir
+		cmd.Env = b.buildEnv()
+		cmd.Stdin = b.stdin
+		out, err := cmd.CombinedOutput()
+		return out, b.wrapCommandError(ctx, err, deadline)
+	})
 }
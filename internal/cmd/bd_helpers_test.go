@@ -2,9 +2,12 @@ package cmd
 
 import (
 	"bytes"
+	"errors"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 	"time"
@@ -223,6 +226,43 @@ timeout /t 5 /nobreak >NUL
 	}
 }
 
+func TestBdCmd_WithTimeoutKillsProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows - shell stubs")
+	}
+	binDir := t.TempDir()
+	survivor := filepath.Join(binDir, "survived")
+	writeBDStub(t, binDir, `#!/usr/bin/env sh
+(sleep 1; echo alive > "`+survivor+`") &
+wait
+`, "")
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("GT_BD_TIMEOUT_SEC", "30")
+
+	start := time.Now()
+	err := BdCmd("dep", "add", "a", "b").
+		WithRetry(bdTransientRetries, bdTransientBackoff).
+		WithTimeout(200 * time.Millisecond).
+		Run()
+	elapsed := time.Since(start)
+	if elapsed < 200*time.Millisecond {
+		t.Fatalf("Run returned after %v, before the 200ms deadline", elapsed)
+	}
+	if elapsed > 900*time.Millisecond {
+		t.Fatalf("Run took %v, want the 200ms WithTimeout to override GT_BD_TIMEOUT_SEC without retrying", elapsed)
+	}
+	if !errors.Is(err, ErrBdTimeout) {
+		t.Fatalf("error = %v, want ErrBdTimeout", err)
+	}
+
+	// The stub's process group must have been killed before it could
+	// write the survivor file.
+	time.Sleep(1500 * time.Millisecond)
+	if _, statErr := os.Stat(survivor); statErr == nil {
+		t.Fatal("bd subprocess survived the timeout")
+	}
+}
+
 func TestBdCmd_CombinedOutputDoesNotPreSetStderr(t *testing.T) {
 	binDir := t.TempDir()
 	writeBDStub(t, binDir, `#!/usr/bin/env sh
@@ -247,6 +287,106 @@ echo stderr:%* 1>&2
 	}
 }
 
+func TestBdCmd_WithRetryRecoversFromTransientError(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows - shell stubs")
+	}
+	binDir := t.TempDir()
+	countFile := filepath.Join(binDir, "count")
+	writeBDStub(t, binDir, `#!/usr/bin/env sh
+n=$(cat "`+countFile+`" 2>/dev/null || echo 0)
+n=$((n+1))
+echo "$n" > "`+countFile+`"
+if [ "$n" -eq 1 ]; then
+  echo "Error: sql: database is closed" >&2
+  exit 1
+fi
+echo ok
+`, "")
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	var sleeps []time.Duration
+	oldSleep := bdRetrySleep
+	bdRetrySleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+	t.Cleanup(func() { bdRetrySleep = oldSleep })
+
+	out, err := BdCmd("dep", "add", "a", "b").Stderr(io.Discard).WithRetry(3, 10*time.Millisecond).Output()
+	if err != nil {
+		t.Fatalf("Output: %v", err)
+	}
+	if strings.TrimSpace(string(out)) != "ok" {
+		t.Errorf("output = %q, want ok", out)
+	}
+	if len(sleeps) != 1 || sleeps[0] != 10*time.Millisecond {
+		t.Errorf("sleeps = %v, want one 10ms backoff", sleeps)
+	}
+}
+
+func TestBdCmd_WithRetrySkipsLogicalErrors(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows - shell stubs")
+	}
+	binDir := t.TempDir()
+	countFile := filepath.Join(binDir, "count")
+	writeBDStub(t, binDir, `#!/usr/bin/env sh
+echo x >> "`+countFile+`"
+echo "Error: issue not found" >&2
+exit 1
+`, "")
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	oldSleep := bdRetrySleep
+	bdRetrySleep = func(time.Duration) {}
+	t.Cleanup(func() { bdRetrySleep = oldSleep })
+
+	out, err := BdCmd("show", "gt-missing").WithRetry(3, time.Millisecond).CombinedOutput()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(string(out), "not found") {
+		t.Errorf("output = %q, want not found", out)
+	}
+	data, _ := os.ReadFile(countFile)
+	if calls := strings.Count(string(data), "x"); calls != 1 {
+		t.Errorf("bd invoked %d times, want 1", calls)
+	}
+}
+
+func TestBdJSON(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows - shell stubs")
+	}
+	binDir := t.TempDir()
+	writeBDStub(t, binDir, `#!/usr/bin/env sh
+case "$2" in
+  good) echo '[{"id":"gt-1","status":"open"}]' ;;
+  *) echo 'Warning: not json' ;;
+esac
+`, "")
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	type row struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+	}
+	rows, err := BdJSON[[]row](BdCmd("show", "good", "--json"))
+	if err != nil {
+		t.Fatalf("BdJSON: %v", err)
+	}
+	if len(rows) != 1 || rows[0].ID != "gt-1" || rows[0].Status != "open" {
+		t.Errorf("rows = %+v, want one open gt-1", rows)
+	}
+
+	_, err = BdJSON[[]row](BdCmd("show", "bad", "--json"))
+	var parseErr *bdJSONError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("error = %v, want *bdJSONError", err)
+	}
+	if !strings.Contains(err.Error(), "bd show") || !strings.Contains(err.Error(), "Warning: not json") {
+		t.Errorf("error = %q, want command and raw output", err)
+	}
+}
+
 func TestBdCmd_Chaining(t *testing.T) {
 	// Test that all builder methods return the receiver for chaining
 	bdc := BdCmd("test")
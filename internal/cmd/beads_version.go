@@ -2,9 +2,11 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/deps"
 )
 
@@ -18,17 +20,17 @@ var (
 // The check is performed only once per process execution.
 func CheckBeadsVersion() error {
 	versionCheckOnce.Do(func() {
-		status, version := deps.CheckBeads()
-		switch status {
-		case deps.BeadsOK:
-			cachedVersionCheckResult = nil
-		case deps.BeadsUnknown:
-			cachedVersionCheckResult = fmt.Errorf("beads (bd) version could not be determined\n\nTry reinstalling: go install %s", deps.BeadsInstallPath)
-		case deps.BeadsNotFound:
+		ok, version, err := beads.CheckVersion(beads.MinBdVersion)
+		switch {
+		case errors.Is(err, beads.ErrNotInstalled):
 			cachedVersionCheckResult = fmt.Errorf("beads (bd) not found in PATH\n\nInstall with: go install %s", deps.BeadsInstallPath)
-		case deps.BeadsTooOld:
+		case err != nil:
+			cachedVersionCheckResult = fmt.Errorf("beads (bd) version could not be determined\n\nTry reinstalling: go install %s", deps.BeadsInstallPath)
+		case !ok:
 			cachedVersionCheckResult = fmt.Errorf("beads %s is required, but %s is installed\n\nUpgrade: go install %s",
-				deps.MinBeadsVersion, version, deps.BeadsInstallPath)
+				beads.MinBdVersion, version, deps.BeadsInstallPath)
+		default:
+			cachedVersionCheckResult = nil
 		}
 	})
 	return cachedVersionCheckResult
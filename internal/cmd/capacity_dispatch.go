@@ -224,7 +224,7 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 			if result != nil && result.PolecatName != "" {
 				polecatNames[b.ID] = result.PolecatName
 			}
-			if b.TargetRig != "" {
+			if wakeRigAfterDispatch(b) {
 				successfulRigs[b.TargetRig] = true
 			}
 			_ = events.LogFeed(events.TypeSchedulerDispatch, actor,
@@ -279,7 +279,8 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 		return 0, fmt.Errorf("scheduler dispatch invariant violation: plan had %d dispatchable bead(s) but no dispatch result", len(dispatchPlan.Plan.ToDispatch))
 	}
 
-	// Wake rig agents for each unique rig that had successful dispatches.
+	// Wake rig agents for each unique rig that had successful dispatches,
+	// skipping beads that were scheduled with --no-boot.
 	for rig := range successfulRigs {
 		wakeRigAgents(rig)
 	}
@@ -603,14 +604,7 @@ func assessScheduledContexts(townRoot string) ([]scheduledContextAssessment, err
 		return nil, nil
 	}
 
-	sort.Slice(candidates, func(i, j int) bool {
-		fi := candidates[i].fields
-		fj := candidates[j].fields
-		if fi.EnqueuedAt != fj.EnqueuedAt {
-			return fi.EnqueuedAt < fj.EnqueuedAt
-		}
-		return candidates[i].context.issue.ID < candidates[j].context.issue.ID
-	})
+	sortScheduledContextCandidates(candidates)
 
 	workBeadInfo := batchFetchBeadInfoByIDs(townRoot, workBeadIDs)
 	blockedWorkIDs, blockedUnknownIDs, blockedErr := listBlockedWorkBeadIDStates(townRoot, workBeadIDs)
@@ -636,6 +630,20 @@ func assessScheduledContexts(townRoot string) ([]scheduledContextAssessment, err
 	return assessments, blockedErr
 }
 
+// sortScheduledContextCandidates puts candidates in dispatch order using the
+// same comparator as `gt scheduler list`. Duplicate contexts for one work bead
+// with the same enqueued_at fall back to context ID so the kept one is stable.
+func sortScheduledContextCandidates(candidates []scheduledContextAssessment) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		fi := candidates[i].fields
+		fj := candidates[j].fields
+		if fi.WorkBeadID == fj.WorkBeadID && fi.EnqueuedAt == fj.EnqueuedAt {
+			return candidates[i].context.issue.ID < candidates[j].context.issue.ID
+		}
+		return scheduledEnqueueLess(fi.EnqueuedAt, fi.WorkBeadID, fj.EnqueuedAt, fj.WorkBeadID)
+	})
+}
+
 // getReadySlingContexts queries for sling context beads whose work beads are ready.
 // This is a pure query — no destructive side effects. Call cleanupStaleContexts()
 // before this function to handle invalid/stale contexts.
@@ -679,6 +687,16 @@ func readySlingContextsFromAssessments(assessments []scheduledContextAssessment)
 	return result
 }
 
+// wakeRigAfterDispatch reports whether a successful dispatch of b should wake
+// its rig's agents at the end of the cycle. Beads scheduled with --no-boot
+// carry no_boot in their sling context and leave the rig as it is.
+func wakeRigAfterDispatch(b capacity.PendingBead) bool {
+	if b.TargetRig == "" {
+		return false
+	}
+	return b.Context == nil || !b.Context.NoBoot
+}
+
 // dispatchSingleBead dispatches one scheduled bead via executeSling.
 // Context fields are already parsed (from PendingBead.Context).
 // Returns the SlingResult (including PolecatName) on success.
@@ -705,6 +723,7 @@ func dispatchSingleBead(b capacity.PendingBead, townRoot, _ string) (*SlingResul
 		Merge:            dp.Merge,
 		BaseBranch:       dp.BaseBranch,
 		ResumeBranch:     dp.ResumeBranch,
+		TargetBranch:     dp.TargetBranch,
 		NoMerge:          dp.NoMerge,
 		ReviewOnly:       dp.ReviewOnly,
 		Account:          dp.Account,
@@ -53,6 +53,26 @@ func TestShouldFireCrossRigEscalation_KeyedByRigAndPrefix(t *testing.T) {
 	}
 }
 
+func TestWakeRigAfterDispatch(t *testing.T) {
+	tests := []struct {
+		name string
+		bead capacity.PendingBead
+		want bool
+	}{
+		{"no context", capacity.PendingBead{TargetRig: "gastown"}, true},
+		{"boot", capacity.PendingBead{TargetRig: "gastown", Context: &capacity.SlingContextFields{}}, true},
+		{"no boot", capacity.PendingBead{TargetRig: "gastown", Context: &capacity.SlingContextFields{NoBoot: true}}, false},
+		{"no rig", capacity.PendingBead{Context: &capacity.SlingContextFields{}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wakeRigAfterDispatch(tt.bead); got != tt.want {
+				t.Errorf("wakeRigAfterDispatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDispatchSingleBeadRawReviewOnlyHookFailureClearsMetadata(t *testing.T) {
 	townRoot, _, descPath := setupMutableBDRawSlingTest(t, "Keep this body.")
 
@@ -210,8 +210,8 @@ func runCheckpointClear(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("getting current directory: %w", err)
 	}
 
-	if err := checkpoint.Remove(cwd); err != nil {
-		return fmt.Errorf("removing checkpoint: %w", err)
+	if err := checkpoint.Clear(cwd); err != nil {
+		return fmt.Errorf("clearing checkpoint: %w", err)
 	}
 
 	fmt.Printf("%s Checkpoint cleared\n", style.Bold.Render("✓"))
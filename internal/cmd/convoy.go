@@ -6,6 +6,7 @@ import (
 	"crypto/rand"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -13,6 +14,8 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -91,11 +94,13 @@ var (
 	convoyCloseReason  string
 	convoyCloseNotify  string
 	convoyCloseForce   bool
+	convoyCloseCascade bool
 	convoyCheckDryRun  bool
 	convoyLandForce    bool
 	convoyLandKeep     bool
 	convoyLandDryRun   bool
 	convoyFromEpic     string
+	convoyAddForce     bool
 )
 
 const (
@@ -112,24 +117,37 @@ const (
 	trackedStatusUnknown = "unknown"
 )
 
+// knownConvoyStatuses lists the convoy lifecycle statuses in display order.
+var knownConvoyStatuses = []string{
+	convoyStatusOpen,
+	convoyStatusClosed,
+	convoyStatusStagedReady,
+	convoyStatusStagedWarnings,
+}
+
+// KnownConvoyStatuses returns the valid convoy statuses, for help text and
+// prompts. The slice is a copy.
+func KnownConvoyStatuses() []string {
+	return slices.Clone(knownConvoyStatuses)
+}
+
+// NormalizeConvoyStatus trims and lower-cases status and reports whether the
+// result is one of KnownConvoyStatuses.
+func NormalizeConvoyStatus(status string) (string, bool) {
+	normalized := normalizeConvoyStatus(status)
+	return normalized, slices.Contains(knownConvoyStatuses, normalized)
+}
+
 func normalizeConvoyStatus(status string) string {
 	return strings.ToLower(strings.TrimSpace(status))
 }
 
 func ensureKnownConvoyStatus(status string) error {
-	switch normalizeConvoyStatus(status) {
-	case convoyStatusOpen, convoyStatusClosed, convoyStatusStagedReady, convoyStatusStagedWarnings:
-		return nil
-	default:
-		return fmt.Errorf(
-			"unsupported convoy status %q (expected %q, %q, %q, or %q)",
-			status,
-			convoyStatusOpen,
-			convoyStatusClosed,
-			convoyStatusStagedReady,
-			convoyStatusStagedWarnings,
-		)
+	if _, ok := NormalizeConvoyStatus(status); !ok {
+		return fmt.Errorf("unsupported convoy status %q (expected one of: %s)",
+			status, strings.Join(knownConvoyStatuses, ", "))
 	}
+	return nil
 }
 
 // isStagedStatus reports whether the given normalized status is a staged status.
@@ -212,6 +230,7 @@ COMMANDS:
   close     Close a convoy (verifies all items done, or use --force)
   land      Land an owned convoy (cleanup worktrees, close convoy)
   status    Show convoy progress, tracked issues, and active workers
+  tree      Show tracked beads and molecule steps as a tree
   list      List convoys (the dashboard view)
   watch     Subscribe to convoy completion notifications
   unwatch   Unsubscribe from convoy completion notifications`,
@@ -285,9 +304,15 @@ var convoyAddCmd = &cobra.Command{
 
 If the convoy is closed, it will be automatically reopened.
 
+Each issue's prefix is checked against routes.jsonl. If an issue routes to a
+rig that none of the convoy's existing tracked issues belong to, the add is
+refused, since cross-rig tracking can silently fail. Use --force to add the
+issue anyway (a warning is still printed).
+
 Examples:
   gt convoy add hq-cv-abc gt-new-issue
-  gt convoy add hq-cv-abc gt-issue1 gt-issue2 gt-issue3`,
+  gt convoy add hq-cv-abc gt-issue1 gt-issue2 gt-issue3
+  gt convoy add hq-cv-abc bd-other-rig --force`,
 	Args:         cobra.MinimumNArgs(2),
 	SilenceUsage: true,
 	RunE:         runConvoyAdd,
@@ -344,12 +369,17 @@ var convoyCloseCmd = &cobra.Command{
 By default, verifies that all tracked issues are closed before allowing the
 close. Use --force to close regardless of tracked issue status.
 
+Use --cascade when abandoning a whole batch: the convoy and every open bead
+it tracks are closed with the given reason. Beads also tracked by another
+open convoy are skipped with a warning.
+
 The close is idempotent - closing an already-closed convoy is a no-op.
 
 Examples:
   gt convoy close hq-cv-abc                           # Close (all items must be done)
   gt convoy close hq-cv-abc --force                   # Force close abandoned convoy
   gt convoy close hq-cv-abc --reason="no longer needed" --force
+  gt convoy close hq-cv-abc --cascade --reason="batch abandoned"
   gt convoy close hq-cv-xyz --notify mayor/`,
 	Args:         cobra.ExactArgs(1),
 	SilenceUsage: true,
@@ -399,7 +429,7 @@ func init() {
 
 	// List flags
 	convoyListCmd.Flags().BoolVar(&convoyListJSON, "json", false, "Output as JSON")
-	convoyListCmd.Flags().StringVar(&convoyListStatus, "status", "", "Filter by status (open, closed)")
+	convoyListCmd.Flags().StringVar(&convoyListStatus, "status", "", "Filter by status ("+strings.Join(KnownConvoyStatuses(), ", ")+")")
 	convoyListCmd.Flags().BoolVar(&convoyListAll, "all", false, "Show all convoys (open and closed)")
 	convoyListCmd.Flags().BoolVar(&convoyListTree, "tree", false, "Show convoy + child status tree")
 
@@ -416,6 +446,10 @@ func init() {
 	convoyCloseCmd.Flags().StringVar(&convoyCloseReason, "reason", "", "Reason for closing the convoy")
 	convoyCloseCmd.Flags().StringVar(&convoyCloseNotify, "notify", "", "Agent to notify on close (e.g., mayor/)")
 	convoyCloseCmd.Flags().BoolVarP(&convoyCloseForce, "force", "f", false, "Close even if tracked issues are still open")
+	convoyCloseCmd.Flags().BoolVar(&convoyCloseCascade, "cascade", false, "Also close every open bead the convoy tracks (skips beads tracked by other open convoys)")
+
+	// Add flags
+	convoyAddCmd.Flags().BoolVarP(&convoyAddForce, "force", "f", false, "Add issues even if they route to a different rig than the convoy's tracked issues")
 
 	// Land flags
 	convoyLandCmd.Flags().BoolVarP(&convoyLandForce, "force", "f", false, "Land even if tracked issues are not all closed")
@@ -734,10 +768,7 @@ func collectEpicChildren(epicID string) ([]string, error) {
 func runConvoyCreate(cmd *cobra.Command, args []string) error {
 	// Validate --merge flag if provided
 	if convoyMerge != "" {
-		switch convoyMerge {
-		case "direct", "mr", "local":
-			// Valid
-		default:
+		if err := validateConvoyMergeStrategy(convoyMerge); err != nil {
 			return fmt.Errorf("invalid --merge value %q: must be direct, mr, or local", convoyMerge)
 		}
 	}
@@ -848,6 +879,7 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 	var stderr bytes.Buffer
 	if err := BdCmd(createArgs...).
 		WithAutoCommit().
+		WithRetry(bdTransientRetries, bdTransientBackoff).WithTimeout(bdMutationTimeout).
 		Dir(townBeads).
 		Stderr(&stderr).
 		Run(); err != nil {
@@ -914,24 +946,22 @@ func runConvoyAdd(cmd *cobra.Command, args []string) error {
 	}
 
 	// Validate convoy exists and get its status
-	showOut, err := BdCmd("show", convoyID, "--json").
-		Dir(townBeads).
-		Stderr(io.Discard).
-		Output()
-	if err != nil {
-		return fmt.Errorf("convoy '%s' not found", convoyID)
-	}
-
-	var convoys []struct {
+	convoys, err := BdJSON[[]struct {
 		ID          string   `json:"id"`
 		Title       string   `json:"title"`
 		Status      string   `json:"status"`
 		Type        string   `json:"issue_type"`
 		Description string   `json:"description"`
 		Labels      []string `json:"labels"`
-	}
-	if err := json.Unmarshal(showOut, &convoys); err != nil {
-		return fmt.Errorf("parsing convoy data: %w", err)
+	}](BdCmd("show", convoyID, "--json").
+		Dir(townBeads).
+		Stderr(io.Discard))
+	if err != nil {
+		var parseErr *bdJSONError
+		if errors.As(err, &parseErr) {
+			return fmt.Errorf("parsing convoy data: %w", err)
+		}
+		return fmt.Errorf("convoy '%s' not found", convoyID)
 	}
 
 	if len(convoys) == 0 {
@@ -948,29 +978,31 @@ func runConvoyAdd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("convoy '%s' has invalid lifecycle state: %w", convoyID, err)
 	}
 
+	// Check that the new issues route to the same rig(s) as the issues the
+	// convoy already tracks. Lookup failures skip the check rather than
+	// blocking the add — the tracking relation itself is still validated.
+	if trackedIDs, err := convoyTrackedIDs(townBeads, convoyID); err == nil {
+		if mismatches := findConvoyRigMismatches(townBeads, trackedIDs, issuesToAdd); len(mismatches) > 0 {
+			if !convoyAddForce {
+				for _, m := range mismatches {
+					style.PrintWarning("%s", m)
+				}
+				return fmt.Errorf("%d issue(s) route to a rig not tracked by convoy %s (use --force to add anyway)", len(mismatches), convoyID)
+			}
+			for _, m := range mismatches {
+				style.PrintWarning("%s (adding anyway: --force)", m)
+			}
+		}
+	}
+
 	// If convoy is closed, reopen it
 	reopened := false
 	if normalizeConvoyStatus(convoy.Status) == convoyStatusClosed {
-		// closed→open is always valid; ensureKnownConvoyStatus above guarantees
-		// the current status is known, so no additional transition check needed.
-		if err := BdCmd("update", convoyID, "--status=open").
-			Dir(townBeads).
-			WithAutoCommit().
-			Run(); err != nil {
-			return fmt.Errorf("couldn't reopen convoy: %w", err)
-		}
-		if fields := beads.ParseConvoyFields(&beads.Issue{Description: convoy.Description}); fields != nil && fields.CompletionNotifiedAt != "" {
-			fields.CompletionNotifiedAt = ""
-			newDesc := beads.SetConvoyFields(&beads.Issue{Description: convoy.Description}, fields)
-			if err := BdCmd("update", convoyID, "--description="+newDesc).
-				Dir(townBeads).
-				WithAutoCommit().
-				Run(); err != nil {
-				return fmt.Errorf("couldn't clear convoy completion notification state: %w", err)
-			}
+		if err := validateConvoyStatusTransition(convoy.Status, convoyStatusOpen); err != nil {
+			return fmt.Errorf("can't reopen convoy '%s': %w", convoyID, err)
 		}
-		if err := persistTownBeadsJSONL(townBeads); err != nil {
-			return fmt.Errorf("couldn't persist reopened convoy to JSONL: %w", err)
+		if err := reopenConvoy(townBeads, convoyID, convoy.Description); err != nil {
+			return err
 		}
 		reopened = true
 		fmt.Printf("%s Reopened convoy %s\n", style.Bold.Render("↺"), convoyID)
@@ -998,6 +1030,71 @@ func runConvoyAdd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// convoyRigMismatch describes an issue whose prefix routes to a rig that none
+// of the convoy's existing tracked issues belong to.
+type convoyRigMismatch struct {
+	IssueID     string
+	Rig         string
+	TrackedRigs []string
+}
+
+func (m convoyRigMismatch) String() string {
+	return fmt.Sprintf("%s routes to rig %q, but convoy tracks issues in %s",
+		m.IssueID, m.Rig, strings.Join(m.TrackedRigs, ", "))
+}
+
+// convoyTrackedIDs returns the IDs of issues tracked by a convoy without
+// fetching their details. Uses the same fallback chain as getTrackedIssues.
+func convoyTrackedIDs(townBeads, convoyID string) ([]string, error) {
+	ids, err := bdDepListRawIDs(townBeads, convoyID, "down", "tracks")
+	if err != nil {
+		ids, err = bdDepListTracked(townBeads, convoyID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(ids) == 0 {
+		return bdShowTrackedDeps(townBeads, convoyID)
+	}
+	return ids, nil
+}
+
+// findConvoyRigMismatches reports issues in newIDs whose prefix routes to a
+// rig not shared by any of trackedIDs. Town-level and unroutable prefixes
+// are ignored on both sides, and a convoy with no rig-routed tracked issues
+// accepts anything.
+func findConvoyRigMismatches(townRoot string, trackedIDs, newIDs []string) []convoyRigMismatch {
+	trackedRigs := make(map[string]bool)
+	for _, id := range trackedIDs {
+		if rig := beads.GetRigNameForPrefix(townRoot, beads.ExtractPrefix(id)); rig != "" {
+			trackedRigs[rig] = true
+		}
+	}
+	if len(trackedRigs) == 0 {
+		return nil
+	}
+
+	rigList := make([]string, 0, len(trackedRigs))
+	for rig := range trackedRigs {
+		rigList = append(rigList, rig)
+	}
+	sort.Strings(rigList)
+
+	var mismatches []convoyRigMismatch
+	for _, id := range newIDs {
+		rig := beads.GetRigNameForPrefix(townRoot, beads.ExtractPrefix(id))
+		if rig == "" || trackedRigs[rig] {
+			continue
+		}
+		mismatches = append(mismatches, convoyRigMismatch{
+			IssueID:     id,
+			Rig:         rig,
+			TrackedRigs: rigList,
+		})
+	}
+	return mismatches
+}
+
 func runConvoyCheck(cmd *cobra.Command, args []string) error {
 	townBeads, err := getTownBeadsDir()
 	if err != nil {
@@ -1198,7 +1295,7 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 		style.PrintWarning("couldn't verify tracked issues: %v", err)
 	}
 
-	if len(tracked) > 0 && !convoyCloseForce {
+	if len(tracked) > 0 && !convoyCloseForce && !convoyCloseCascade {
 		var openIssues []trackedIssueInfo
 		for _, t := range tracked {
 			if t.Status != "closed" && t.Status != "tombstone" {
@@ -1225,11 +1322,23 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 	if reason == "" {
 		if convoyCloseForce {
 			reason = "Force closed"
+		} else if convoyCloseCascade {
+			reason = "Cascade closed with convoy " + convoyID
 		} else {
 			reason = "All tracked issues completed"
 		}
 	}
 
+	// Check for beads shared with other open convoys before closing anything,
+	// so --cascade never closes a bead we couldn't prove is unshared.
+	var sharedWith map[string]string
+	if convoyCloseCascade && len(tracked) > 0 {
+		sharedWith, err = otherOpenConvoyTracking(townBeads, convoyID, openTrackedIDs(tracked))
+		if err != nil {
+			return fmt.Errorf("--cascade: couldn't check whether tracked beads are shared with other convoys: %w", err)
+		}
+	}
+
 	// Close the convoy
 	closeArgs := []string{"close", convoyID, "-r", reason}
 	if err := runTownMutationAndExport(townBeads, closeArgs...); err != nil {
@@ -1241,6 +1350,10 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 		fmt.Printf("  Reason: %s\n", convoyCloseReason)
 	}
 
+	if convoyCloseCascade && len(tracked) > 0 {
+		tracked = cascadeCloseTracked(reason, tracked, sharedWith)
+	}
+
 	// Report cleanup summary
 	if len(tracked) > 0 {
 		closedCount := 0
@@ -1276,6 +1389,114 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// cascadeSkip records a tracked bead left open by --cascade because another
+// open convoy still tracks it.
+type cascadeSkip struct {
+	ID       string
+	ConvoyID string
+}
+
+// planConvoyCascade splits a convoy's tracked beads into those --cascade should
+// close and those it must skip. sharedWith maps bead ID to another open convoy
+// that also tracks it. Already-closed beads are neither closed nor skipped.
+func planConvoyCascade(tracked []trackedIssueInfo, sharedWith map[string]string) ([]trackedIssueInfo, []cascadeSkip) {
+	var toClose []trackedIssueInfo
+	var skipped []cascadeSkip
+	for _, t := range tracked {
+		if t.Status == "closed" || t.Status == "tombstone" {
+			continue
+		}
+		if other, ok := sharedWith[t.ID]; ok {
+			skipped = append(skipped, cascadeSkip{ID: t.ID, ConvoyID: other})
+			continue
+		}
+		toClose = append(toClose, t)
+	}
+	return toClose, skipped
+}
+
+// otherOpenConvoyTracking maps each of beadIDs to another open convoy (not
+// convoyID) that tracks it. Beads tracked only by convoyID are absent. It
+// fails if any open convoy's tracked beads can't be listed, since a bead
+// missing from the map is treated as safe to close.
+func otherOpenConvoyTracking(townBeads, convoyID string, beadIDs []string) (map[string]string, error) {
+	shared := make(map[string]string)
+	if len(beadIDs) == 0 {
+		return shared, nil
+	}
+	want := make(map[string]bool, len(beadIDs))
+	for _, id := range beadIDs {
+		want[id] = true
+	}
+
+	convoys, err := listConvoyIssues(townBeads, "open", false)
+	if err != nil {
+		return nil, fmt.Errorf("listing open convoys: %w", err)
+	}
+	for _, c := range convoys {
+		if c.ID == convoyID {
+			continue
+		}
+		ids, err := bdDepListRawIDs(townBeads, c.ID, "down", "tracks")
+		if err != nil {
+			return nil, fmt.Errorf("listing beads tracked by %s: %w", c.ID, err)
+		}
+		for _, id := range ids {
+			if want[id] {
+				if _, seen := shared[id]; !seen {
+					shared[id] = c.ID
+				}
+			}
+		}
+	}
+	return shared, nil
+}
+
+// openTrackedIDs returns the IDs of tracked beads that are not yet closed.
+func openTrackedIDs(tracked []trackedIssueInfo) []string {
+	ids := make([]string, 0, len(tracked))
+	for _, t := range tracked {
+		if t.Status != "closed" && t.Status != "tombstone" {
+			ids = append(ids, t.ID)
+		}
+	}
+	return ids
+}
+
+// cascadeCloseTracked closes every open bead tracked by a convoy, skipping
+// beads in sharedWith (see otherOpenConvoyTracking), and reports each close.
+// It returns tracked with statuses updated for the beads it closed.
+func cascadeCloseTracked(reason string, tracked []trackedIssueInfo, sharedWith map[string]string) []trackedIssueInfo {
+	toClose, skipped := planConvoyCascade(tracked, sharedWith)
+
+	for _, s := range skipped {
+		style.PrintWarning("skipping %s: also tracked by open convoy %s", s.ID, s.ConvoyID)
+	}
+
+	client := convoyIssueClient()
+	if client == nil {
+		style.PrintWarning("couldn't resolve town beads; tracked beads left open")
+		return tracked
+	}
+
+	closed := make(map[string]bool, len(toClose))
+	for _, t := range toClose {
+		if err := client.CloseWithReason(reason, t.ID); err != nil {
+			style.PrintWarning("couldn't close %s: %v", t.ID, err)
+			continue
+		}
+		closed[t.ID] = true
+		fmt.Printf("  %s Closed %s: %s\n", style.Success.Render("✓"), t.ID, t.Title)
+	}
+
+	for i := range tracked {
+		if closed[tracked[i].ID] {
+			tracked[i].Status = "closed"
+		}
+	}
+	return tracked
+}
+
 func convoyNotifyFrom(convoyID string) string {
 	return "convoy/" + convoyID
 }
@@ -2156,6 +2377,14 @@ func runConvoyList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if convoyListStatus != "" {
+		status, ok := NormalizeConvoyStatus(convoyListStatus)
+		if !ok {
+			return ensureKnownConvoyStatus(convoyListStatus)
+		}
+		convoyListStatus = status
+	}
+
 	convoys, err := listConvoyIssues(townBeads, convoyListStatus, convoyListAll)
 	if err != nil {
 		return fmt.Errorf("listing convoys: %w", err)
@@ -2287,7 +2516,7 @@ func printConvoyTree(townBeads string, convoys []convoyListIssue) error {
 }
 
 // hasLabel checks if a label exists in a list of labels.
-func hasLabel(labels []string, target string) bool { //nolint:unparam // target is always "gt:owned" today but the API is intentionally general
+func hasLabel(labels []string, target string) bool {
 	for _, l := range labels {
 		if l == target {
 			return true
@@ -2784,12 +3013,13 @@ func getWorkersForIssues(issueIDs []string) map[string]*workerInfo {
 	}
 
 	// Query all rigs in parallel using bd list
+	type agentRow struct {
+		ID           string `json:"id"`
+		HookBead     string `json:"hook_bead"`
+		LastActivity string `json:"last_activity"`
+	}
 	type rigResult struct {
-		agents []struct {
-			ID           string `json:"id"`
-			HookBead     string `json:"hook_bead"`
-			LastActivity string `json:"last_activity"`
-		}
+		agents []agentRow
 	}
 
 	resultChan := make(chan rigResult, len(beadsDirs))
@@ -2800,22 +3030,15 @@ func getWorkersForIssues(issueIDs []string) map[string]*workerInfo {
 		go func(workDir string) {
 			defer wg.Done()
 
-			out, err := BdCmd("list", "--label=gt:agent", "--status=open", "--json", "--limit=0", "--flat").
+			agents, err := BdJSON[[]agentRow](BdCmd("list", "--label=gt:agent", "--status=open", "--json", "--limit=0", "--flat").
 				Dir(workDir).
 				StripBeadsDir().
-				Stderr(io.Discard).
-				Output()
+				Stderr(io.Discard))
 			if err != nil {
 				resultChan <- rigResult{}
 				return
 			}
-
-			var rr rigResult
-			if err := json.Unmarshal(out, &rr.agents); err != nil {
-				resultChan <- rigResult{}
-				return
-			}
-			resultChan <- rr
+			resultChan <- rigResult{agents: agents}
 		}(dir)
 	}
 
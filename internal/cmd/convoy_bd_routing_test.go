@@ -334,3 +334,40 @@ esac
 		t.Errorf("tracking helper issues = %q, want %q", got, "ag-95s.1,ag-95s.2")
 	}
 }
+
+func TestFindConvoyRigMismatches(t *testing.T) {
+	townRoot := t.TempDir()
+	routes := []beads.Route{
+		{Prefix: "hq-", Path: "."},
+		{Prefix: "gt-", Path: "gastown/mayor/rig"},
+		{Prefix: "bd-", Path: "beads/mayor/rig"},
+	}
+	if err := beads.WriteRoutes(filepath.Join(townRoot, ".beads"), routes); err != nil {
+		t.Fatalf("write routes: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		tracked []string
+		added   []string
+		want    []string
+	}{
+		{"same rig", []string{"gt-a"}, []string{"gt-b"}, nil},
+		{"different rig", []string{"gt-a"}, []string{"bd-b", "gt-c"}, []string{"bd-b"}},
+		{"empty convoy accepts anything", nil, []string{"bd-b"}, nil},
+		{"town-level tracked beads ignored", []string{"hq-x"}, []string{"bd-b"}, nil},
+		{"town-level and unroutable additions ignored", []string{"gt-a"}, []string{"hq-y", "zz-z"}, nil},
+		{"multi-rig convoy accepts either rig", []string{"gt-a", "bd-a"}, []string{"bd-b", "gt-b"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, m := range findConvoyRigMismatches(townRoot, tt.tracked, tt.added) {
+				got = append(got, m.IssueID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("mismatches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestPlanConvoyCascade_ClosesOpenTrackedBeads(t *testing.T) {
+	tracked := []trackedIssueInfo{
+		{ID: "gt-a", Status: "open"},
+		{ID: "gt-b", Status: "in_progress"},
+		{ID: "gt-c", Status: "closed"},
+		{ID: "gt-d", Status: "tombstone"},
+	}
+
+	toClose, skipped := planConvoyCascade(tracked, nil)
+	if len(skipped) != 0 {
+		t.Errorf("expected no skips, got %v", skipped)
+	}
+	if len(toClose) != 2 || toClose[0].ID != "gt-a" || toClose[1].ID != "gt-b" {
+		t.Errorf("toClose = %v, want [gt-a gt-b]", toClose)
+	}
+}
+
+func TestPlanConvoyCascade_SkipsBeadsSharedWithOtherConvoys(t *testing.T) {
+	tracked := []trackedIssueInfo{
+		{ID: "gt-a", Status: "open"},
+		{ID: "gt-shared", Status: "hooked"},
+		{ID: "gt-done", Status: "closed"},
+	}
+	shared := map[string]string{
+		"gt-shared": "hq-cv-other",
+		// Closed beads are never candidates, even when shared.
+		"gt-done": "hq-cv-other",
+	}
+
+	toClose, skipped := planConvoyCascade(tracked, shared)
+	if len(toClose) != 1 || toClose[0].ID != "gt-a" {
+		t.Errorf("toClose = %v, want [gt-a]", toClose)
+	}
+	if len(skipped) != 1 {
+		t.Fatalf("expected 1 skip, got %v", skipped)
+	}
+	if skipped[0].ID != "gt-shared" || skipped[0].ConvoyID != "hq-cv-other" {
+		t.Errorf("skipped = %+v, want gt-shared via hq-cv-other", skipped[0])
+	}
+}
+
+// TestOtherOpenConvoyTracking_FailsClosed verifies the shared-bead check
+// reports an error, rather than an empty map that would let --cascade close
+// shared beads, when it can't list open convoys or their tracked beads.
+func TestOtherOpenConvoyTracking_FailsClosed(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows - shell stubs")
+	}
+
+	tests := []struct {
+		name    string
+		script  string
+		wantErr string
+	}{
+		{
+			name:    "list fails",
+			script:  "echo 'dolt unavailable' >&2\nexit 1\n",
+			wantErr: "listing open convoys",
+		},
+		{
+			name: "dep list fails",
+			script: `case "$1" in
+  list) echo '[{"id":"hq-cv-other","title":"Other","status":"open","issue_type":"convoy","labels":["gt:convoy"]}]' ;;
+  *) echo 'dolt unavailable' >&2; exit 1 ;;
+esac
+`,
+			wantErr: "listing beads tracked by hq-cv-other",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, townBeads, _ := makeExternalTrackingTownWorkspace(t)
+			writeExternalTrackingBdStub(t, tt.script)
+
+			shared, err := otherOpenConvoyTracking(townBeads, "hq-cv-self", []string{"gt-a"})
+			if err == nil {
+				t.Fatalf("expected error, got shared=%v", shared)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+//go:build integration
+
+// Integration tests for convoy tracking across rigs.
+//
+// Run with: go test -tags=integration ./internal/cmd -run TestConvoyCrossRig -v
+package cmd
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// createTestConvoy creates an owned, direct-merge convoy bead in the town
+// database the same way runConvoyCreate does (task type + gt:convoy label,
+// cv- ID under the HQ prefix). The description deliberately does not mention
+// any tracked bead so lookups must go through the tracks deps.
+func createTestConvoy(t *testing.T, townRoot, hqPrefix string) string {
+	t.Helper()
+
+	convoyID := fmt.Sprintf("%s-cv-%s", hqPrefix, generateShortID())
+	description := beads.SetConvoyFields(
+		&beads.Issue{Description: "Cross-rig integration convoy"},
+		&beads.ConvoyFields{Merge: beads.MergeStrategyDirect},
+	)
+	args := []string{
+		"create",
+		"--type=task",
+		"--id=" + convoyID,
+		"--title=Cross-rig convoy",
+		"--description=" + description,
+		"--labels=" + convoyLabels(true),
+		"--json",
+	}
+	if beads.NeedsForceForID(convoyID) {
+		args = append(args, "--force")
+	}
+	cmd := exec.Command("bd", args...)
+	cmd.Dir = townRoot
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("create convoy %s: %v\n%s", convoyID, err, out)
+	}
+	return convoyID
+}
+
+// TestConvoyCrossRigTracking pins convoy lookups for beads that live in rig
+// databases while the convoy lives in HQ. Cross-database dep resolution is
+// where the G19/G21 workarounds and phantom-convoy handling exist; if bd's
+// routing changes, these lookups are the first to silently return "".
+func TestConvoyCrossRigTracking(t *testing.T) {
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed, skipping cross-rig convoy test")
+	}
+	requireDoltServer(t)
+
+	n := routingTestCounter.Add(1)
+	hqP := fmt.Sprintf("xhq%d", n)
+	gtP := fmt.Sprintf("xgt%d", n)
+	trP := fmt.Sprintf("xtr%d", n)
+
+	townRoot := setupRoutingTestTownWithPrefixes(t, hqP, gtP, trP)
+	gastownRigPath := filepath.Join(townRoot, "gastown", "mayor", "rig")
+	testrigRigPath := filepath.Join(townRoot, "testrig", "mayor", "rig")
+
+	initBeadsDBWithPrefix(t, townRoot, hqP)
+	initBeadsDBWithPrefix(t, gastownRigPath, gtP)
+	initBeadsDBWithPrefix(t, testrigRigPath, trP)
+
+	gastownIssue := createTestIssue(t, gastownRigPath, "Gastown convoy work")
+	testrigIssue := createTestIssue(t, testrigRigPath, "Testrig convoy work")
+	untracked := createTestIssue(t, testrigRigPath, "Testrig untracked work")
+
+	convoyID := createTestConvoy(t, townRoot, hqP)
+	for _, id := range []string{gastownIssue.ID, testrigIssue.ID} {
+		if err := addTrackingRelation(townRoot, convoyID, id); err != nil {
+			t.Fatalf("track %s in %s: %v", id, convoyID, err)
+		}
+	}
+
+	// The lookups resolve the town from cwd, as they do inside gt commands.
+	t.Chdir(townRoot)
+	townBeads := filepath.Join(townRoot, ".beads")
+
+	for _, id := range []string{gastownIssue.ID, testrigIssue.ID} {
+		t.Run(id, func(t *testing.T) {
+			if !convoyTracksBead(townBeads, convoyID, id) {
+				t.Errorf("convoyTracksBead(%s, %s) = false, want true", convoyID, id)
+			}
+			if got := isTrackedByConvoy(id); got != convoyID {
+				t.Errorf("isTrackedByConvoy(%s) = %q, want %q", id, got, convoyID)
+			}
+			info := getConvoyInfoForIssue(id)
+			if info == nil {
+				t.Fatalf("getConvoyInfoForIssue(%s) = nil, want convoy %s", id, convoyID)
+			}
+			if info.ID != convoyID {
+				t.Errorf("getConvoyInfoForIssue(%s).ID = %q, want %q", id, info.ID, convoyID)
+			}
+			if !info.IsOwnedDirect() {
+				t.Errorf("getConvoyInfoForIssue(%s) = %+v, want owned with direct merge", id, info)
+			}
+		})
+	}
+
+	t.Run("untracked", func(t *testing.T) {
+		if convoyTracksBead(townBeads, convoyID, untracked.ID) {
+			t.Errorf("convoyTracksBead(%s, %s) = true, want false", convoyID, untracked.ID)
+		}
+		if got := isTrackedByConvoy(untracked.ID); got != "" {
+			t.Errorf("isTrackedByConvoy(%s) = %q, want empty", untracked.ID, got)
+		}
+		if info := getConvoyInfoForIssue(untracked.ID); info != nil {
+			t.Errorf("getConvoyInfoForIssue(%s) = %+v, want nil", untracked.ID, info)
+		}
+	})
+}
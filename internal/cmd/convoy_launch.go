@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -42,7 +43,9 @@ var convoyLaunchCmd = &cobra.Command{
 	Long: `Launch a staged convoy by transitioning its status from staged to open
 and dispatching Wave 1 tasks.
 
-For staged convoy-id input: transitions directly and dispatches.
+For staged convoy-id input: transitions directly and dispatches. A
+staged_warnings convoy requires --force, acknowledging its warnings. Convoys
+that are already open or closed are refused.
 For epic/task input: runs stage + launch in one step.`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: runConvoyLaunch,
@@ -52,39 +55,46 @@ func init() {
 	convoyLaunchCmd.Flags().BoolVar(&convoyLaunchForce, "force", false, "Launch even with warnings")
 }
 
-// transitionConvoyToOpen transitions a staged convoy to open status.
-// If the convoy is staged_ready, it transitions unconditionally.
-// If the convoy is staged_warnings and force is true, it transitions.
-// If the convoy is staged_warnings and force is false, it returns an error.
-// If the convoy is already open or closed, it returns an error.
+// transitionConvoyToOpen transitions a staged convoy to open status, after
+// checkConvoyLaunch approves the transition.
 func transitionConvoyToOpen(convoyID string, force bool) error {
 	result, err := bdShow(convoyID)
 	if err != nil {
 		return fmt.Errorf("cannot resolve convoy %s: %w", convoyID, err)
 	}
+	if err := checkConvoyLaunch(convoyID, result.Status, force); err != nil {
+		return err
+	}
+	return bdUpdateStatus(convoyID, convoyStatusOpen)
+}
 
-	status := normalizeConvoyStatus(result.Status)
-
-	switch status {
+// checkConvoyLaunch reports whether a convoy in the given status may be
+// launched (moved to open).
+// If the convoy is staged_ready, it may launch unconditionally.
+// If the convoy is staged_warnings, it may launch only with force, which
+// acknowledges the warnings.
+// If the convoy is already open or closed, it returns an error.
+// The transition is finally checked by validateConvoyStatusTransition.
+func checkConvoyLaunch(convoyID, status string, force bool) error {
+	switch normalizeConvoyStatus(status) {
 	case convoyStatusStagedReady:
-		// Transition directly to open.
-		return bdUpdateStatus(convoyID, convoyStatusOpen)
+		// Launch directly.
 
 	case convoyStatusStagedWarnings:
 		if !force {
 			return fmt.Errorf("convoy %s has warnings, use --force to launch", convoyID)
 		}
-		return bdUpdateStatus(convoyID, convoyStatusOpen)
 
 	case convoyStatusOpen:
 		return fmt.Errorf("convoy %s is already launched", convoyID)
 
 	case convoyStatusClosed:
-		return fmt.Errorf("convoy %s is closed", convoyID)
+		return fmt.Errorf("convoy %s is closed (use 'gt convoy reopen' to reopen it)", convoyID)
 
 	default:
-		return fmt.Errorf("convoy %s has unexpected status %q", convoyID, result.Status)
+		return fmt.Errorf("convoy %s has unexpected status %q", convoyID, status)
 	}
+	return validateConvoyStatusTransition(status, convoyStatusOpen)
 }
 
 // bdUpdateStatus runs `bd update <id> --status=<status>` against the town beads
@@ -281,15 +291,24 @@ func runConvoyLaunch(cmd *cobra.Command, args []string) error {
 	}
 
 	// Step 3: If single arg is a convoy with staged status, transition to open
-	// and dispatch Wave 1.
+	// and dispatch Wave 1. A convoy that is already open or closed is refused
+	// rather than re-staged.
 	if len(args) == 1 {
 		result := beadTypes[args[0]]
-		if isConvoyIssue(result.IssueType, result.Labels) && isStagedStatus(normalizeConvoyStatus(result.Status)) {
+		status := normalizeConvoyStatus(result.Status)
+		if isConvoyIssue(result.IssueType, result.Labels) && !isStagedStatus(status) {
+			return checkConvoyLaunch(args[0], result.Status, convoyLaunchForce)
+		}
+		if isConvoyIssue(result.IssueType, result.Labels) {
 			convoyID := args[0]
 
 			if err := transitionConvoyToOpen(convoyID, convoyLaunchForce); err != nil {
 				return err
 			}
+			fmt.Printf("%s Convoy %s: %s → %s\n", style.Bold.Render("✓"), convoyID, status, convoyStatusOpen)
+			if status == convoyStatusStagedWarnings {
+				fmt.Printf("  Warnings acknowledged with --force\n")
+			}
 
 			// Rebuild DAG from tracked beads and dispatch Wave 1.
 			beads, deps, err := collectConvoyBeads(convoyID)
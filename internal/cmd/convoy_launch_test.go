@@ -117,6 +117,54 @@ func TestTransitionConvoyToOpen_AlreadyOpen(t *testing.T) {
 	}
 }
 
+func TestCheckConvoyLaunch(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		status  string
+		force   bool
+		wantErr string
+	}{
+		{status: "staged_ready", force: false},
+		{status: "staged_ready", force: true},
+		{status: "staged_warnings", force: false, wantErr: "--force"},
+		{status: "staged_warnings", force: true},
+		{status: "open", force: true, wantErr: "already launched"},
+		{status: "closed", force: true, wantErr: "reopen"},
+		{status: "in_progress", force: true, wantErr: "unexpected status"},
+	}
+	for _, tc := range cases {
+		err := checkConvoyLaunch("hq-cv-x", tc.status, tc.force)
+		if tc.wantErr == "" && err != nil {
+			t.Errorf("checkConvoyLaunch(%q, force=%v) = %v, want nil", tc.status, tc.force, err)
+		}
+		if tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+			t.Errorf("checkConvoyLaunch(%q, force=%v) = %v, want error containing %q", tc.status, tc.force, err, tc.wantErr)
+		}
+	}
+}
+
+// An open convoy is refused outright instead of being re-staged.
+func TestRunConvoyLaunch_OpenConvoyRefused(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows — shell stubs")
+	}
+
+	dag := newTestDAG(t).
+		Convoy("hq-cv-live", "Live Convoy").WithStatus("open")
+	_, logPath := dag.Setup(t)
+
+	err := runConvoyLaunch(nil, []string{"hq-cv-live"})
+	if err == nil || !strings.Contains(err.Error(), "already launched") {
+		t.Fatalf("runConvoyLaunch error = %v, want 'already launched'", err)
+	}
+
+	logBytes, _ := os.ReadFile(logPath)
+	if strings.Contains(string(logBytes), "CMD:update") || strings.Contains(string(logBytes), "CMD:create") {
+		t.Errorf("refused launch should not mutate beads, got:\n%s", logBytes)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Subcommand registration tests (gt-csl.6.4)
 // ---------------------------------------------------------------------------
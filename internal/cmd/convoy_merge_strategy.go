@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+func init() {
+	convoyMergeStrategyCmd.AddCommand(convoyMergeStrategySetCmd)
+	convoyMergeStrategyCmd.AddCommand(convoyMergeStrategyGetCmd)
+	convoyCmd.AddCommand(convoyMergeStrategyCmd)
+}
+
+var convoyMergeStrategyCmd = &cobra.Command{
+	Use:   "merge-strategy",
+	Short: "Show or change a convoy's merge strategy",
+	Long: `Show or change the merge strategy stored in a convoy's description.
+
+Strategies:
+  direct  Push directly to main
+  mr      Submit to the merge queue (default)
+  local   Keep work on the branch
+
+Examples:
+  gt convoy merge-strategy get hq-cv-abc
+  gt convoy merge-strategy set hq-cv-abc direct`,
+	RunE: requireSubcommand,
+}
+
+var convoyMergeStrategySetCmd = &cobra.Command{
+	Use:   "set <convoy-id> <direct|mr|local>",
+	Short: "Set a convoy's merge strategy",
+	Long: `Set a convoy's merge strategy.
+
+Rewrites the Merge: field in the convoy's description, preserving the rest
+of the description and its other fields.
+
+Examples:
+  gt convoy merge-strategy set hq-cv-abc direct
+  gt convoy merge-strategy set hq-cv-abc mr`,
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	RunE:         runConvoyMergeStrategySet,
+}
+
+var convoyMergeStrategyGetCmd = &cobra.Command{
+	Use:   "get <convoy-id>",
+	Short: "Show a convoy's merge strategy",
+	Long: `Show a convoy's merge strategy.
+
+Convoys with no Merge: field use the merge queue (mr).
+
+Examples:
+  gt convoy merge-strategy get hq-cv-abc`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runConvoyMergeStrategyGet,
+}
+
+// validateConvoyMergeStrategy rejects anything other than direct, mr, or local.
+func validateConvoyMergeStrategy(strategy string) error {
+	switch strategy {
+	case beads.MergeStrategyDirect, beads.MergeStrategyMR, beads.MergeStrategyLocal:
+		return nil
+	default:
+		return fmt.Errorf("invalid merge strategy %q: must be direct, mr, or local", strategy)
+	}
+}
+
+// setConvoyMergeStrategy returns description with its Merge: field set to
+// strategy, leaving the prose and all other convoy fields intact.
+func setConvoyMergeStrategy(description, strategy string) (string, error) {
+	if err := validateConvoyMergeStrategy(strategy); err != nil {
+		return "", err
+	}
+	issue := &beads.Issue{Description: description}
+	fields := issue.ConvoyFields()
+	if fields == nil {
+		fields = &beads.ConvoyFields{}
+	}
+	fields.Merge = strategy
+	return beads.SetConvoyFields(issue, fields), nil
+}
+
+func runConvoyMergeStrategySet(cmd *cobra.Command, args []string) error {
+	convoyID, strategy := args[0], args[1]
+	if err := validateConvoyMergeStrategy(strategy); err != nil {
+		return err
+	}
+
+	townBeads, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+	convoy, err := getConvoyForWatch(townBeads, convoyID)
+	if err != nil {
+		return err
+	}
+
+	if convoyMergeFromFields(convoy.Description) == strategy {
+		fmt.Printf("%s Convoy %s already uses merge strategy %s\n", style.Dim.Render("○"), convoyID, strategy)
+		return nil
+	}
+
+	newDesc, err := setConvoyMergeStrategy(convoy.Description, strategy)
+	if err != nil {
+		return err
+	}
+	if err := updateConvoyDescription(townBeads, convoyID, newDesc); err != nil {
+		return fmt.Errorf("updating convoy merge strategy: %w", err)
+	}
+
+	fmt.Printf("%s Set merge strategy for convoy %s: %s\n", style.Bold.Render("✓"), convoyID, strategy)
+	return nil
+}
+
+func runConvoyMergeStrategyGet(cmd *cobra.Command, args []string) error {
+	convoyID := args[0]
+
+	townBeads, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+	convoy, err := getConvoyForWatch(townBeads, convoyID)
+	if err != nil {
+		return err
+	}
+
+	strategy := convoyMergeFromFields(convoy.Description)
+	if strategy == "" {
+		fmt.Printf("%s (default)\n", beads.DefaultMergeStrategy)
+		return nil
+	}
+	fmt.Println(strategy)
+	return nil
+}
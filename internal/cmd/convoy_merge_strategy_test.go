@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetConvoyMergeStrategy_RoundTrip(t *testing.T) {
+	desc := "Auto-created convoy tracking gt-abc\nOwner: mayor/\nMerge: mr"
+
+	for _, strategy := range []string{"direct", "local", "mr"} {
+		updated, err := setConvoyMergeStrategy(desc, strategy)
+		if err != nil {
+			t.Fatalf("setConvoyMergeStrategy(%q): %v", strategy, err)
+		}
+		if got := convoyMergeFromFields(updated); got != strategy {
+			t.Errorf("after set %q, get = %q", strategy, got)
+		}
+		if !strings.Contains(updated, "Auto-created convoy tracking gt-abc") || !strings.Contains(updated, "Owner: mayor/") {
+			t.Errorf("set %q dropped other description content:\n%s", strategy, updated)
+		}
+		desc = updated
+	}
+
+	// A description with no convoy fields gains a Merge: line.
+	updated, err := setConvoyMergeStrategy("Just prose", "direct")
+	if err != nil {
+		t.Fatalf("setConvoyMergeStrategy on bare prose: %v", err)
+	}
+	if got := convoyMergeFromFields(updated); got != "direct" {
+		t.Errorf("bare prose: get = %q, want direct", got)
+	}
+}
+
+func TestSetConvoyMergeStrategy_RejectsInvalid(t *testing.T) {
+	for _, strategy := range []string{"", "squash", "MR"} {
+		if _, err := setConvoyMergeStrategy("Merge: mr", strategy); err == nil {
+			t.Errorf("setConvoyMergeStrategy(%q) = nil error, want rejection", strategy)
+		}
+	}
+}
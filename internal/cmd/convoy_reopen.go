@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+func init() {
+	convoyCmd.AddCommand(convoyReopenCmd)
+}
+
+var convoyReopenCmd = &cobra.Command{
+	Use:   "reopen <convoy-id>",
+	Short: "Reopen a closed convoy",
+	Long: `Reopen a convoy that was closed prematurely.
+
+The convoy must currently be closed, and at least one of the beads it tracks
+must still exist: a convoy whose tracked beads have all been deleted or can
+no longer be resolved has nothing left to track and is not reopened.
+
+Reopening clears the convoy's completion-notification marker, so owners and
+watchers are notified again when it next lands.
+
+Examples:
+  gt convoy reopen hq-cv-abc`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runConvoyReopen,
+}
+
+func runConvoyReopen(cmd *cobra.Command, args []string) error {
+	convoyID := args[0]
+
+	townBeads, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+
+	stdout, err := runBdJSON(townBeads, "show", convoyID, "--json")
+	if err != nil {
+		return fmt.Errorf("convoy '%s' not found", convoyID)
+	}
+
+	var convoys []struct {
+		ID          string   `json:"id"`
+		Title       string   `json:"title"`
+		Status      string   `json:"status"`
+		Type        string   `json:"issue_type"`
+		Description string   `json:"description"`
+		Labels      []string `json:"labels"`
+	}
+	if err := json.Unmarshal(stdout, &convoys); err != nil {
+		return fmt.Errorf("parsing convoy data: %w", err)
+	}
+	if len(convoys) == 0 {
+		return fmt.Errorf("convoy '%s' not found", convoyID)
+	}
+	convoy := convoys[0]
+
+	if !isConvoyIssue(convoy.Type, convoy.Labels) {
+		return fmt.Errorf("'%s' is not a convoy (type: %s)", convoyID, convoy.Type)
+	}
+	if err := checkConvoyReopenable(convoy.Status); err != nil {
+		return fmt.Errorf("can't reopen convoy '%s': %w", convoyID, err)
+	}
+
+	tracked, err := getTrackedIssues(townBeads, convoyID)
+	if err != nil {
+		return fmt.Errorf("couldn't verify tracked issues: %w", err)
+	}
+	live := liveTrackedIssues(tracked)
+	if len(live) == 0 {
+		return fmt.Errorf("can't reopen convoy '%s': none of its %d tracked bead(s) still exist", convoyID, len(tracked))
+	}
+
+	if err := reopenConvoy(townBeads, convoyID, convoy.Description); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Reopened convoy 🚚 %s: %s\n", style.Bold.Render("↺"), convoyID, convoy.Title)
+	open := 0
+	for _, t := range live {
+		if t.Status != "closed" {
+			open++
+		}
+	}
+	fmt.Printf("  Tracked: %d issue(s) (%d open", len(live), open)
+	if gone := len(tracked) - len(live); gone > 0 {
+		fmt.Printf(", %d gone", gone)
+	}
+	fmt.Println(")")
+	return nil
+}
+
+// checkConvoyReopenable reports whether a convoy in the given status can be
+// reopened: only closed convoys can, via the validated closed → open transition.
+func checkConvoyReopenable(status string) error {
+	if err := ensureKnownConvoyStatus(status); err != nil {
+		return err
+	}
+	if current := normalizeConvoyStatus(status); current != convoyStatusClosed {
+		return fmt.Errorf("convoy is %s, not closed", current)
+	}
+	return validateConvoyStatusTransition(status, convoyStatusOpen)
+}
+
+// liveTrackedIssues filters out tracked beads that no longer exist: deleted
+// (tombstone) beads and those whose details could not be resolved.
+func liveTrackedIssues(tracked []trackedIssueInfo) []trackedIssueInfo {
+	var live []trackedIssueInfo
+	for _, t := range tracked {
+		if t.Status == "tombstone" || t.Status == trackedStatusUnknown {
+			continue
+		}
+		live = append(live, t)
+	}
+	return live
+}
+
+// reopenConvoy moves a closed convoy back to open and clears its
+// completion-notification marker so it notifies again when it next lands.
+// description is the convoy's current description.
+func reopenConvoy(townBeads, convoyID, description string) error {
+	if err := BdCmd("update", convoyID, "--status=open").
+		Dir(townBeads).
+		WithAutoCommit().
+		Run(); err != nil {
+		return fmt.Errorf("couldn't reopen convoy: %w", err)
+	}
+	if fields := beads.ParseConvoyFields(&beads.Issue{Description: description}); fields != nil && fields.CompletionNotifiedAt != "" {
+		fields.CompletionNotifiedAt = ""
+		newDesc := beads.SetConvoyFields(&beads.Issue{Description: description}, fields)
+		if err := BdCmd("update", convoyID, "--description="+newDesc).
+			Dir(townBeads).
+			WithAutoCommit().
+			Run(); err != nil {
+			return fmt.Errorf("couldn't clear convoy completion notification state: %w", err)
+		}
+	}
+	if err := persistTownBeadsJSONL(townBeads); err != nil {
+		return fmt.Errorf("couldn't persist reopened convoy to JSONL: %w", err)
+	}
+	return nil
+}
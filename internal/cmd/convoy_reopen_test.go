@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestCheckConvoyReopenable(t *testing.T) {
+	t.Parallel()
+
+	if err := checkConvoyReopenable("closed"); err != nil {
+		t.Errorf("closed convoy should be reopenable: %v", err)
+	}
+	for _, status := range []string{"open", "staged_ready", "staged_warnings", "archived"} {
+		if err := checkConvoyReopenable(status); err == nil {
+			t.Errorf("checkConvoyReopenable(%q) = nil, want error", status)
+		}
+	}
+}
+
+func TestLiveTrackedIssues(t *testing.T) {
+	t.Parallel()
+
+	live := liveTrackedIssues([]trackedIssueInfo{
+		{ID: "gt-a", Status: "open"},
+		{ID: "gt-b", Status: "closed"},
+		{ID: "gt-gone", Status: "tombstone"},
+		{ID: "ws-unrouted", Status: trackedStatusUnknown},
+	})
+	if len(live) != 2 || live[0].ID != "gt-a" || live[1].ID != "gt-b" {
+		t.Errorf("liveTrackedIssues = %v, want [gt-a gt-b]", live)
+	}
+}
+
+// writeConvoyReopenBdStub serves a convoy with the given status tracking
+// hq-task (with taskStatus), and logs bd update calls to the returned file.
+func writeConvoyReopenBdStub(t *testing.T, convoyStatus, taskStatus string) string {
+	t.Helper()
+	logPath := filepath.Join(t.TempDir(), "bd-update.log")
+	writeExternalTrackingBdStub(t, fmt.Sprintf(`
+case "$*" in
+  "show hq-cv-re --json")
+    echo '[{"id":"hq-cv-re","title":"Reopen me","status":"%s","issue_type":"convoy"}]'
+    ;;
+  *sql*dependencies*)
+    echo '[{"depends_on_id":"hq-task"}]'
+    ;;
+  *show*hq-task*)
+    echo '[{"id":"hq-task","title":"Task","status":"%s","issue_type":"task"}]'
+    ;;
+  *update*)
+    echo "$*" >> "%s"
+    ;;
+  *)
+    exit 0
+    ;;
+esac
+`, convoyStatus, taskStatus, logPath))
+	return logPath
+}
+
+func TestRunConvoyReopen(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows - shell stubs")
+	}
+
+	cases := []struct {
+		name       string
+		convoy     string
+		task       string
+		wantErr    string
+		wantUpdate bool
+	}{
+		{name: "closed convoy reopens", convoy: "closed", task: "closed", wantUpdate: true},
+		{name: "open convoy rejected", convoy: "open", task: "open", wantErr: "not closed"},
+		{name: "all tracked beads gone", convoy: "closed", task: "tombstone", wantErr: "still exist"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			townRoot, _, _ := makeExternalTrackingTownWorkspace(t)
+			chdirExternalTrackingTest(t, townRoot)
+			logPath := writeConvoyReopenBdStub(t, tc.convoy, tc.task)
+
+			_, err := captureConvoyStdoutErr(t, func() error {
+				return runConvoyReopen(nil, []string{"hq-cv-re"})
+			})
+			if tc.wantErr == "" && err != nil {
+				t.Fatalf("runConvoyReopen: %v", err)
+			}
+			if tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Fatalf("runConvoyReopen error = %v, want containing %q", err, tc.wantErr)
+			}
+
+			log, _ := os.ReadFile(logPath)
+			gotUpdate := strings.Contains(string(log), "update hq-cv-re --status=open")
+			if gotUpdate != tc.wantUpdate {
+				t.Errorf("status update issued = %v, want %v (log: %q)", gotUpdate, tc.wantUpdate, log)
+			}
+		})
+	}
+}
@@ -770,7 +770,7 @@ func createStagedConvoy(dag *ConvoyDAG, waves []Wave, status string, title strin
 	if beads.NeedsForceForID(convoyID) {
 		createArgs = append(createArgs, "--force")
 	}
-	if out, err := BdCmd(createArgs...).Dir(townBeads).WithAutoCommit().CombinedOutput(); err != nil {
+	if out, err := BdCmd(createArgs...).Dir(townBeads).WithAutoCommit().WithRetry(bdTransientRetries, bdTransientBackoff).WithTimeout(bdMutationTimeout).CombinedOutput(); err != nil {
 		return "", fmt.Errorf("bd create convoy: %w\noutput: %s", err, out)
 	}
 
@@ -1137,13 +1137,13 @@ func appendValidationWave(dag *ConvoyDAG, waves []Wave, epicID string) ([]Wave,
 	if beads.NeedsForceForID(validationID) {
 		createArgs = append(createArgs, "--force")
 	}
-	if out, err := BdCmd(createArgs...).Dir(townBeads).WithAutoCommit().CombinedOutput(); err != nil {
+	if out, err := BdCmd(createArgs...).Dir(townBeads).WithAutoCommit().WithRetry(bdTransientRetries, bdTransientBackoff).WithTimeout(bdMutationTimeout).CombinedOutput(); err != nil {
 		return waves, "", fmt.Errorf("bd create validation bead: %w\noutput: %s", err, out)
 	}
 
 	// Set the validation bead as a child of the epic.
 	if out, err := BdCmd("dep", "add", epicID, validationID, "--type=parent-child").
-		Dir(townBeads).WithAutoCommit().StripBeadsDir().
+		Dir(townBeads).WithAutoCommit().StripBeadsDir().WithRetry(bdTransientRetries, bdTransientBackoff).WithTimeout(bdMutationTimeout).
 		CombinedOutput(); err != nil {
 		return waves, "", fmt.Errorf("bd dep add parent-child %s %s: %w\noutput: %s", epicID, validationID, err, out)
 	}
@@ -1152,7 +1152,7 @@ func appendValidationWave(dag *ConvoyDAG, waves []Wave, epicID string) ([]Wave,
 	// Cross-rig deps may fail (bd validates both IDs in same DB). Non-fatal.
 	for _, beadID := range slingableIDs {
 		if out, err := BdCmd("dep", "add", beadID, validationID, "--type=blocks").
-			Dir(townBeads).WithAutoCommit().StripBeadsDir().
+			Dir(townBeads).WithAutoCommit().StripBeadsDir().WithRetry(bdTransientRetries, bdTransientBackoff).WithTimeout(bdMutationTimeout).
 			CombinedOutput(); err != nil {
 			printStageWarning("  Warning: could not add blocking dep %s → %s: %v\n", beadID, validationID, err)
 			_ = out
@@ -134,3 +134,46 @@ func TestValidateConvoyStatusTransition_Staged(t *testing.T) {
 		})
 	}
 }
+
+func TestKnownConvoyStatuses(t *testing.T) {
+	t.Parallel()
+
+	got := KnownConvoyStatuses()
+	want := []string{"open", "closed", "staged_ready", "staged_warnings"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("KnownConvoyStatuses() = %v, want %v", got, want)
+	}
+
+	// Callers get a copy; mutating it must not change validation.
+	got[0] = "bogus"
+	if err := ensureKnownConvoyStatus("open"); err != nil {
+		t.Fatalf("mutating the returned slice affected validation: %v", err)
+	}
+	if KnownConvoyStatuses()[0] != "open" {
+		t.Fatal("mutating the returned slice changed the shared list")
+	}
+}
+
+func TestNormalizeConvoyStatus(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		in        string
+		want      string
+		wantKnown bool
+	}{
+		{in: "open", want: "open", wantKnown: true},
+		{in: " closed ", want: "closed", wantKnown: true},
+		{in: "STAGED_READY", want: "staged_ready", wantKnown: true},
+		{in: "\tStaged_Warnings\n", want: "staged_warnings", wantKnown: true},
+		{in: "", want: "", wantKnown: false},
+		{in: " In_Progress ", want: "in_progress", wantKnown: false},
+		{in: "staged:ready", want: "staged:ready", wantKnown: false},
+	}
+	for _, tc := range cases {
+		got, known := NormalizeConvoyStatus(tc.in)
+		if got != tc.want || known != tc.wantKnown {
+			t.Errorf("NormalizeConvoyStatus(%q) = (%q, %v), want (%q, %v)", tc.in, got, known, tc.want, tc.wantKnown)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var convoyTreeJSON bool
+
+func init() {
+	convoyTreeCmd.Flags().BoolVar(&convoyTreeJSON, "json", false, "Output the tree as JSON")
+
+	convoyCmd.AddCommand(convoyTreeCmd)
+}
+
+var convoyTreeCmd = &cobra.Command{
+	Use:   "tree <convoy-id>",
+	Short: "Show a convoy's tracked beads as a tree",
+	Long: `Show a convoy, its tracked beads with statuses, and the sub-steps of any
+tracked bead that is itself a molecule.
+
+Status icons: ● open, ✓ closed, ◆ hooked/pinned, ○ other.
+
+Examples:
+  gt convoy tree hq-cv-abc
+  gt convoy tree 1            # Numeric shortcut from 'gt convoy list'
+  gt convoy tree hq-cv-abc --json`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runConvoyTree,
+}
+
+// convoyTreeNode is one node in the convoy tree: the convoy itself, a tracked
+// bead, or a molecule step.
+type convoyTreeNode struct {
+	ID        string           `json:"id"`
+	Title     string           `json:"title"`
+	Status    string           `json:"status"`
+	IssueType string           `json:"issue_type,omitempty"`
+	Children  []convoyTreeNode `json:"children,omitempty"`
+}
+
+func runConvoyTree(cmd *cobra.Command, args []string) error {
+	townBeads, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+
+	convoyID := args[0]
+	if n, err := strconv.Atoi(convoyID); err == nil && n > 0 {
+		resolved, err := resolveConvoyNumber(townBeads, n)
+		if err != nil {
+			return err
+		}
+		convoyID = resolved
+	}
+
+	showOut, err := runBdJSON(townBeads, "show", convoyID, "--json")
+	if err != nil {
+		return fmt.Errorf("convoy '%s' not found", convoyID)
+	}
+	var convoys []struct {
+		ID     string `json:"id"`
+		Title  string `json:"title"`
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(showOut, &convoys); err != nil {
+		return fmt.Errorf("parsing convoy data: %w", err)
+	}
+	if len(convoys) == 0 {
+		return fmt.Errorf("convoy '%s' not found", convoyID)
+	}
+
+	tracked, err := getTrackedIssues(townBeads, convoyID)
+	if err != nil {
+		return fmt.Errorf("getting tracked issues for %s: %w", convoyID, err)
+	}
+
+	root := convoyTreeNode{
+		ID:        convoys[0].ID,
+		Title:     convoys[0].Title,
+		Status:    convoys[0].Status,
+		IssueType: "convoy",
+	}
+	root.Children = buildConvoyTreeChildren(tracked, moleculeStepsFetcher())
+
+	if convoyTreeJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(root)
+	}
+
+	printConvoyTreeNode(os.Stdout, root)
+	return nil
+}
+
+// isMoleculeBead reports whether a tracked bead is a molecule root whose
+// steps should be expanded in the tree.
+func isMoleculeBead(t trackedIssueInfo) bool {
+	return t.IssueType == "molecule" || hasLabel(t.Labels, "gt:molecule")
+}
+
+// buildConvoyTreeChildren converts tracked beads into tree nodes, expanding
+// molecule beads with the steps returned by steps.
+func buildConvoyTreeChildren(tracked []trackedIssueInfo, steps func(id string) []convoyTreeNode) []convoyTreeNode {
+	nodes := make([]convoyTreeNode, 0, len(tracked))
+	for _, t := range tracked {
+		node := convoyTreeNode{
+			ID:        t.ID,
+			Title:     t.Title,
+			Status:    t.Status,
+			IssueType: t.IssueType,
+		}
+		if isMoleculeBead(t) && steps != nil {
+			node.Children = steps(t.ID)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// moleculeStepsFetcher returns a function that lists a molecule's steps
+// (its child beads) via the routed town beads client. Lookup failures yield
+// no steps rather than failing the whole tree.
+func moleculeStepsFetcher() func(id string) []convoyTreeNode {
+	client := convoyIssueClient()
+	return func(id string) []convoyTreeNode {
+		if client == nil {
+			return nil
+		}
+		children, err := client.List(beads.ListOptions{
+			Parent:   id,
+			Status:   "all",
+			Priority: -1,
+		})
+		if err != nil {
+			return nil
+		}
+		steps := make([]convoyTreeNode, 0, len(children))
+		for _, c := range children {
+			steps = append(steps, convoyTreeNode{
+				ID:        c.ID,
+				Title:     c.Title,
+				Status:    c.Status,
+				IssueType: c.Type,
+			})
+		}
+		return steps
+	}
+}
+
+// printConvoyTreeNode renders the convoy header followed by its tracked beads
+// and molecule steps using box-drawing connectors.
+func printConvoyTreeNode(w io.Writer, root convoyTreeNode) {
+	completed := 0
+	for _, c := range root.Children {
+		if c.Status == "closed" {
+			completed++
+		}
+	}
+	progress := ""
+	if len(root.Children) > 0 {
+		progress = fmt.Sprintf(" (%d/%d)", completed, len(root.Children))
+	}
+	fmt.Fprintf(w, "🚚 %s %s%s\n", style.Bold.Render(root.ID+":"), root.Title, progress)
+	printConvoyTreeChildren(w, root.Children, "")
+}
+
+func printConvoyTreeChildren(w io.Writer, nodes []convoyTreeNode, indent string) {
+	for i, n := range nodes {
+		connector, childIndent := "├── ", "│   "
+		if i == len(nodes)-1 {
+			connector, childIndent = "└── ", "    "
+		}
+		title := n.Title
+		if title == "" {
+			title = "(no title)"
+		}
+		fmt.Fprintf(w, "%s%s%s %s: %s %s\n", indent, connector, trackedStatusIcon(n.Status), n.ID, title, style.Dim.Render("["+n.Status+"]"))
+		printConvoyTreeChildren(w, n.Children, indent+childIndent)
+	}
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestBuildConvoyTreeChildren_ExpandsMolecules(t *testing.T) {
+	tracked := []trackedIssueInfo{
+		{ID: "gt-a", Title: "Plain task", Status: "closed", IssueType: "task"},
+		{ID: "gt-mol", Title: "Release molecule", Status: "in_progress", IssueType: "molecule"},
+		{ID: "gt-lbl", Title: "Labeled molecule", Status: "open", Labels: []string{"gt:molecule"}},
+	}
+	var fetched []string
+	steps := func(id string) []convoyTreeNode {
+		fetched = append(fetched, id)
+		return []convoyTreeNode{{ID: id + ".1", Title: "step", Status: "open"}}
+	}
+
+	nodes := buildConvoyTreeChildren(tracked, steps)
+	if len(nodes) != 3 {
+		t.Fatalf("expected 3 nodes, got %d", len(nodes))
+	}
+	if len(nodes[0].Children) != 0 {
+		t.Errorf("plain task should not be expanded, got %v", nodes[0].Children)
+	}
+	if len(nodes[1].Children) != 1 || nodes[1].Children[0].ID != "gt-mol.1" {
+		t.Errorf("molecule not expanded: %+v", nodes[1])
+	}
+	if len(nodes[2].Children) != 1 {
+		t.Errorf("gt:molecule-labeled bead not expanded: %+v", nodes[2])
+	}
+	if strings.Join(fetched, ",") != "gt-mol,gt-lbl" {
+		t.Errorf("fetched steps for %v, want only molecules", fetched)
+	}
+}
+
+func TestPrintConvoyTreeNode(t *testing.T) {
+	root := convoyTreeNode{
+		ID:    "hq-cv-abc",
+		Title: "Batch",
+		Children: []convoyTreeNode{
+			{ID: "gt-a", Title: "First", Status: "closed"},
+			{ID: "gt-mol", Title: "Mol", Status: "hooked", Children: []convoyTreeNode{
+				{ID: "gt-mol.1", Title: "", Status: "open"},
+			}},
+		},
+	}
+
+	var buf bytes.Buffer
+	printConvoyTreeNode(&buf, root)
+	out := buf.String()
+
+	for _, want := range []string{
+		"hq-cv-abc:",
+		"(1/2)",
+		"├── ✓ gt-a: First",
+		"└── ◆ gt-mol: Mol",
+		"    └── ● gt-mol.1: (no title)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -60,11 +60,18 @@ var daemonStatusCmd = &cobra.Command{
 	Short: "Show daemon status",
 	Long: `Show the current status of the Gas Town daemon.
 
-Displays whether the daemon is running, its PID, uptime, heartbeat
-count, and whether the binary has been rebuilt since the daemon started.
+Displays whether the daemon is running, its PID (checked against the PID
+file), uptime, heartbeat count, and whether the binary has been rebuilt
+since the daemon started. Also shows when each patrol last ran, agents the
+daemon is holding back from restart (crash loop or backoff), and how many
+escalations it sent in the last 24 hours.
+
+Patrol last-run times come from daemon/patrol_metrics.json, which the
+daemon flushes after every heartbeat, so they can lag by one heartbeat.
 
 Examples:
-  gt daemon status`,
+  gt daemon status
+  gt daemon status --json`,
 	RunE: runDaemonStatus,
 }
 
@@ -145,8 +152,9 @@ Examples:
 }
 
 var (
-	daemonLogLines  int
-	daemonLogFollow bool
+	daemonLogLines   int
+	daemonLogFollow  bool
+	daemonStatusJSON bool
 )
 
 func init() {
@@ -159,6 +167,7 @@ func init() {
 	daemonCmd.AddCommand(daemonClearBackoffCmd)
 	daemonCmd.AddCommand(daemonRotateLogsCmd)
 
+	daemonStatusCmd.Flags().BoolVar(&daemonStatusJSON, "json", false, "Output as JSON")
 	daemonLogsCmd.Flags().IntVarP(&daemonLogLines, "lines", "n", 50, "Number of lines to show")
 	daemonLogsCmd.Flags().BoolVarP(&daemonLogFollow, "follow", "f", false, "Follow log output")
 	daemonRotateLogsCmd.Flags().BoolVar(&daemonRotateLogsForce, "force", false, "Rotate all logs regardless of size")
@@ -256,54 +265,6 @@ func runDaemonStop(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runDaemonStatus(cmd *cobra.Command, args []string) error {
-	townRoot, err := workspace.FindFromCwdOrError()
-	if err != nil {
-		return fmt.Errorf("not in a Gas Town workspace: %w", err)
-	}
-
-	running, pid, err := daemon.IsRunning(townRoot)
-	if err != nil {
-		return fmt.Errorf("checking daemon status: %w", err)
-	}
-
-	if running {
-		fmt.Printf("%s Daemon is %s (PID %d)\n",
-			style.Bold.Render("●"),
-			style.Bold.Render("running"),
-			pid)
-		fmt.Printf("  Town: %s\n", townRoot)
-
-		// Load state for more details
-		state, err := daemon.LoadState(townRoot)
-		if err == nil && !state.StartedAt.IsZero() {
-			fmt.Printf("  Started: %s\n", state.StartedAt.Format("2006-01-02 15:04:05"))
-			if !state.LastHeartbeat.IsZero() {
-				fmt.Printf("  Last heartbeat: %s (#%d)\n",
-					state.LastHeartbeat.Format("15:04:05"),
-					state.HeartbeatCount)
-			}
-
-			// Check if binary is newer than process
-			if binaryModTime, err := getBinaryModTime(); err == nil {
-				fmt.Printf("  Binary: %s\n", binaryModTime.Format("2006-01-02 15:04:05"))
-				if binaryModTime.After(state.StartedAt) {
-					fmt.Printf("  %s Binary is newer than process - consider '%s'\n",
-						style.Bold.Render("⚠"),
-						style.Dim.Render("gt daemon stop && gt daemon start"))
-				}
-			}
-		}
-	} else {
-		fmt.Printf("%s Daemon is %s\n",
-			style.Dim.Render("○"),
-			"not running")
-		fmt.Printf("\nStart with: %s\n", style.Dim.Render("gt daemon start"))
-	}
-
-	return nil
-}
-
 // getBinaryModTime returns the modification time of the current executable
 func getBinaryModTime() (time.Time, error) {
 	exePath, err := os.Executable()
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	daemonEscalationsSince string
+	daemonEscalationsJSON  bool
+)
+
+var daemonEscalationsCmd = &cobra.Command{
+	Use:   "escalations",
+	Short: "Show recent daemon escalations",
+	Long: `Show the escalations the daemon has sent, oldest first.
+
+Every alert the daemon escalates to the mayor is also recorded in
+daemon/escalations.jsonl. Repeats of the same alert collapsed by the
+escalation dedup window are recorded once, with the number of occurrences
+shown as (xN). The file is capped and keeps its newest half when full.
+
+Examples:
+  gt daemon escalations
+  gt daemon escalations --since=24h
+  gt daemon escalations --since=7d --json`,
+	Args: cobra.NoArgs,
+	RunE: runDaemonEscalations,
+}
+
+func init() {
+	daemonEscalationsCmd.Flags().StringVar(&daemonEscalationsSince, "since", "", "Show escalations since duration (e.g., 1h, 24h, 7d)")
+	daemonEscalationsCmd.Flags().BoolVar(&daemonEscalationsJSON, "json", false, "Output as JSON")
+
+	daemonCmd.AddCommand(daemonEscalationsCmd)
+}
+
+func runDaemonEscalations(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	var since time.Time
+	if daemonEscalationsSince != "" {
+		duration, err := parseDuration(daemonEscalationsSince)
+		if err != nil {
+			return fmt.Errorf("invalid --since duration: %w", err)
+		}
+		since = time.Now().Add(-duration)
+	}
+
+	records, err := daemon.ReadEscalationLog(townRoot, since)
+	if err != nil {
+		return fmt.Errorf("reading escalation log: %w", err)
+	}
+
+	if daemonEscalationsJSON {
+		if records == nil {
+			records = []daemon.EscalationRecord{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(records)
+	}
+
+	printDaemonEscalations(os.Stdout, records)
+	return nil
+}
+
+func printDaemonEscalations(w io.Writer, records []daemon.EscalationRecord) {
+	if len(records) == 0 {
+		fmt.Fprintf(w, "%s\n", style.Dim.Render("No escalations recorded."))
+		return
+	}
+
+	for _, r := range records {
+		count := ""
+		if r.Count > 1 {
+			count = style.Warning.Render(fmt.Sprintf(" (x%d)", r.Count))
+		}
+		fmt.Fprintf(w, "%s  %s  %s%s\n",
+			style.Dim.Render(r.Time.Local().Format("2006-01-02 15:04:05")),
+			style.Bold.Render(r.Patrol), r.Message, count)
+	}
+	fmt.Fprintf(w, "\n%d escalation(s)\n", len(records))
+}
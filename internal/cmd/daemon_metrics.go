@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var daemonMetricsJSON bool
+
+var daemonMetricsCmd = &cobra.Command{
+	Use:   "metrics",
+	Short: "Show patrol run and action counters",
+	Long: `Show how often each daemon patrol has run and what it did.
+
+The daemon counts patrol runs (heartbeat, wisp_reaper, doctor_dog, ...) and
+patrol actions (wisps reaped, doctor molecules poured, agent restarts, ...)
+and flushes them to daemon/patrol_metrics.json after every heartbeat and on
+shutdown. Counts carry over across daemon restarts, so they cover the period
+since the time shown as "Since". Delete the file to reset them.
+
+Examples:
+  gt daemon metrics
+  gt daemon metrics --json`,
+	Args: cobra.NoArgs,
+	RunE: runDaemonMetrics,
+}
+
+func init() {
+	daemonMetricsCmd.Flags().BoolVar(&daemonMetricsJSON, "json", false, "Output as JSON")
+
+	daemonCmd.AddCommand(daemonMetricsCmd)
+}
+
+func runDaemonMetrics(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	m, err := daemon.LoadPatrolMetrics(townRoot)
+	if err != nil {
+		return fmt.Errorf("reading patrol metrics: %w", err)
+	}
+
+	if daemonMetricsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(m)
+	}
+
+	printDaemonMetrics(os.Stdout, m)
+	return nil
+}
+
+func printDaemonMetrics(w io.Writer, m *daemon.PatrolMetrics) {
+	if len(m.Runs) == 0 && len(m.Actions) == 0 {
+		fmt.Fprintf(w, "%s\n", style.Dim.Render("No patrol metrics recorded yet."))
+		return
+	}
+
+	fmt.Fprintf(w, "Since %s, last flushed %s\n",
+		m.Since.Local().Format("2006-01-02 15:04:05"),
+		m.UpdatedAt.Local().Format("2006-01-02 15:04:05"))
+
+	fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Patrol runs"))
+	printCounterTable(w, m.Runs)
+
+	if len(m.Actions) > 0 {
+		fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Patrol actions"))
+		printCounterTable(w, m.Actions)
+	}
+}
+
+// printCounterTable writes counters as aligned "name  count" rows sorted by name.
+func printCounterTable(w io.Writer, counters map[string]int64) {
+	keys := make([]string, 0, len(counters))
+	width := 0
+	for k := range counters {
+		keys = append(keys, k)
+		width = max(width, len(k))
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(w, "  %-*s  %d\n", width, k, counters[k])
+	}
+}
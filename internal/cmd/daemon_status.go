@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// daemonStatusEscalationWindow is how far back gt daemon status counts
+// escalations.
+const daemonStatusEscalationWindow = 24 * time.Hour
+
+// DaemonStatusReport is the consolidated view printed by gt daemon status.
+type DaemonStatusReport struct {
+	Running bool `json:"running"`
+	PID     int  `json:"pid,omitempty"`
+	// PIDVerified is true when the PID file names a live process matching PID.
+	PIDVerified    bool       `json:"pid_verified"`
+	StartedAt      *time.Time `json:"started_at,omitempty"`
+	UptimeSeconds  int64      `json:"uptime_seconds,omitempty"`
+	LastHeartbeat  *time.Time `json:"last_heartbeat,omitempty"`
+	HeartbeatCount int64      `json:"heartbeat_count,omitempty"`
+	// BinaryNewer is true when the gt binary was rebuilt after the daemon started.
+	BinaryNewer bool `json:"binary_newer,omitempty"`
+
+	Patrols []DaemonPatrolStatus `json:"patrols,omitempty"`
+
+	// RestartHealth lists agents the daemon won't restart yet, keyed by agent ID.
+	RestartHealth map[string]RestartHealth `json:"restart_health,omitempty"`
+
+	RecentEscalations int `json:"recent_escalations"` // Within daemonStatusEscalationWindow
+}
+
+// DaemonPatrolStatus is one patrol's run count and last-run time.
+type DaemonPatrolStatus struct {
+	Name    string    `json:"name"`
+	Runs    int64     `json:"runs"`
+	LastRun time.Time `json:"last_run"`
+}
+
+func runDaemonStatus(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	report, err := collectDaemonStatus(townRoot, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if daemonStatusJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	renderDaemonStatus(os.Stdout, townRoot, report, time.Now())
+	return nil
+}
+
+// collectDaemonStatus gathers the daemon's liveness, state file, patrol
+// metrics, restart tracker, and escalation log into one report. Only failing
+// to determine whether the daemon is running is an error; the other sources
+// are best-effort so the command still works on a partly initialized town.
+func collectDaemonStatus(townRoot string, now time.Time) (*DaemonStatusReport, error) {
+	running, pid, err := daemon.IsRunning(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("checking daemon status: %w", err)
+	}
+	report := &DaemonStatusReport{Running: running, PID: pid}
+
+	if running {
+		if filePID, alive, err := daemon.VerifyPIDFile(townRoot); err == nil {
+			report.PIDVerified = alive && filePID == pid
+		}
+		if state, err := daemon.LoadState(townRoot); err == nil && !state.StartedAt.IsZero() {
+			startedAt := state.StartedAt
+			report.StartedAt = &startedAt
+			report.UptimeSeconds = int64(now.Sub(startedAt) / time.Second)
+			if !state.LastHeartbeat.IsZero() {
+				lastHeartbeat := state.LastHeartbeat
+				report.LastHeartbeat = &lastHeartbeat
+				report.HeartbeatCount = state.HeartbeatCount
+			}
+			if binaryModTime, err := getBinaryModTime(); err == nil {
+				report.BinaryNewer = binaryModTime.After(startedAt)
+			}
+		}
+	}
+
+	if m, err := daemon.LoadPatrolMetrics(townRoot); err == nil {
+		for name, runs := range m.Runs {
+			report.Patrols = append(report.Patrols, DaemonPatrolStatus{Name: name, Runs: runs, LastRun: m.LastRun[name]})
+		}
+		sort.Slice(report.Patrols, func(i, j int) bool { return report.Patrols[i].Name < report.Patrols[j].Name })
+	}
+
+	if rt, err := daemon.LoadRestartTracker(townRoot); err == nil {
+		report.RestartHealth = collectRestartHealth(rt)
+	}
+
+	if records, err := daemon.ReadEscalationLog(townRoot, now.Add(-daemonStatusEscalationWindow)); err == nil {
+		report.RecentEscalations = len(records)
+	}
+
+	return report, nil
+}
+
+func renderDaemonStatus(w io.Writer, townRoot string, r *DaemonStatusReport, now time.Time) {
+	if r.Running {
+		fmt.Fprintf(w, "%s Daemon is %s (PID %d)\n",
+			style.Bold.Render("●"),
+			style.Bold.Render("running"),
+			r.PID)
+		if !r.PIDVerified {
+			fmt.Fprintf(w, "  %s PID file does not match a live daemon process\n", style.Warning.Render("⚠"))
+		}
+		fmt.Fprintf(w, "  Town: %s\n", townRoot)
+		if r.StartedAt != nil {
+			fmt.Fprintf(w, "  Started: %s (up %s)\n",
+				r.StartedAt.Format("2006-01-02 15:04:05"),
+				formatDuration(time.Duration(r.UptimeSeconds)*time.Second))
+		}
+		if r.LastHeartbeat != nil {
+			fmt.Fprintf(w, "  Last heartbeat: %s (#%d)\n",
+				r.LastHeartbeat.Format("15:04:05"),
+				r.HeartbeatCount)
+		}
+		if r.BinaryNewer {
+			fmt.Fprintf(w, "  %s Binary is newer than process - consider '%s'\n",
+				style.Bold.Render("⚠"),
+				style.Dim.Render("gt daemon stop && gt daemon start"))
+		}
+	} else {
+		fmt.Fprintf(w, "%s Daemon is %s\n",
+			style.Dim.Render("○"),
+			"not running")
+		fmt.Fprintf(w, "\nStart with: %s\n", style.Dim.Render("gt daemon start"))
+	}
+
+	if len(r.Patrols) > 0 {
+		width := 0
+		for _, p := range r.Patrols {
+			width = max(width, len(p.Name))
+		}
+		fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Patrols:"))
+		for _, p := range r.Patrols {
+			last := style.Dim.Render("never")
+			if !p.LastRun.IsZero() {
+				last = formatDuration(now.Sub(p.LastRun)) + " ago"
+			}
+			fmt.Fprintf(w, "  %-*s  %6d runs  last %s\n", width, p.Name, p.Runs, last)
+		}
+	}
+
+	fmt.Fprintln(w)
+	renderRestartHealth(w, r.RestartHealth)
+
+	if r.RecentEscalations > 0 {
+		fmt.Fprintf(w, "%s %d escalation(s) in the last 24h — %s\n",
+			style.Warning.Render("⚠"), r.RecentEscalations,
+			style.Dim.Render("gt daemon escalations --since=24h"))
+	} else {
+		fmt.Fprintf(w, "%s\n", style.Dim.Render("No escalations in the last 24h"))
+	}
+}
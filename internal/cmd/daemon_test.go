@@ -1,9 +1,15 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/daemon"
 )
 
 func TestReadDaemonStartupFailure(t *testing.T) {
@@ -41,3 +47,108 @@ func TestReadDaemonStartupFailure_MissingPIDReturnsEmpty(t *testing.T) {
 		t.Fatalf("readDaemonStartupFailure() = %q, want empty string", got)
 	}
 }
+
+func TestPrintDaemonMetrics(t *testing.T) {
+	var buf bytes.Buffer
+	printDaemonMetrics(&buf, &daemon.PatrolMetrics{})
+	if !strings.Contains(buf.String(), "No patrol metrics") {
+		t.Errorf("empty metrics output = %q", buf.String())
+	}
+
+	buf.Reset()
+	printDaemonMetrics(&buf, &daemon.PatrolMetrics{
+		Runs:      map[string]int64{"wisp_reaper": 4, "heartbeat": 12},
+		Actions:   map[string]int64{"wisp_reaper.reaped": 31},
+		Since:     time.Now().Add(-time.Hour),
+		UpdatedAt: time.Now(),
+	})
+	out := buf.String()
+	for _, want := range []string{"heartbeat    12", "wisp_reaper  4", "wisp_reaper.reaped  31"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "heartbeat") > strings.Index(out, "wisp_reaper") {
+		t.Errorf("runs not sorted by name:\n%s", out)
+	}
+}
+
+func TestCollectDaemonStatus_NotRunning(t *testing.T) {
+	townRoot := t.TempDir()
+	daemonDir := filepath.Join(townRoot, "daemon")
+	if err := os.MkdirAll(daemonDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	if err := daemon.SavePatrolMetrics(townRoot, &daemon.PatrolMetrics{
+		Runs:    map[string]int64{"wisp_reaper": 3, "heartbeat": 20},
+		LastRun: map[string]time.Time{"wisp_reaper": now.Add(-10 * time.Minute), "heartbeat": now.Add(-time.Minute)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	restart := daemon.RestartState{Agents: map[string]*daemon.AgentRestartInfo{
+		"deacon": {RestartCount: 5, CrashLoopSince: now},
+	}}
+	data, _ := json.Marshal(restart)
+	if err := os.WriteFile(filepath.Join(daemonDir, "restart_state.json"), data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	escalations := `{"ts":"` + now.Add(-48*time.Hour).Format(time.RFC3339) + `","patrol":"old","message":"m","count":1}` + "\n" +
+		`{"ts":"` + now.Add(-time.Hour).Format(time.RFC3339) + `","patrol":"doctor_dog","message":"m","count":2}` + "\n"
+	if err := os.WriteFile(daemon.EscalationLogFile(townRoot), []byte(escalations), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := collectDaemonStatus(townRoot, now)
+	if err != nil {
+		t.Fatalf("collectDaemonStatus: %v", err)
+	}
+	if r.Running || r.PID != 0 || r.StartedAt != nil {
+		t.Errorf("report = %+v, want not running with no process details", r)
+	}
+	if len(r.Patrols) != 2 || r.Patrols[0].Name != "heartbeat" || r.Patrols[1].Runs != 3 {
+		t.Errorf("Patrols = %+v, want heartbeat then wisp_reaper", r.Patrols)
+	}
+	if h, ok := r.RestartHealth["deacon"]; !ok || !h.CrashLoop {
+		t.Errorf("RestartHealth = %+v, want deacon crash loop", r.RestartHealth)
+	}
+	if r.RecentEscalations != 1 {
+		t.Errorf("RecentEscalations = %d, want 1 (48h-old record excluded)", r.RecentEscalations)
+	}
+
+	var buf bytes.Buffer
+	renderDaemonStatus(&buf, townRoot, r, now)
+	out := buf.String()
+	for _, want := range []string{"not running", "Patrols:", "wisp_reaper", "10m 0s ago", "Restart health:", "deacon", "1 escalation(s) in the last 24h"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderDaemonStatus_Running(t *testing.T) {
+	started := time.Now().Add(-2 * time.Hour)
+	r := &DaemonStatusReport{
+		Running:       true,
+		PID:           4242,
+		StartedAt:     &started,
+		UptimeSeconds: int64(2 * time.Hour / time.Second),
+	}
+
+	var buf bytes.Buffer
+	renderDaemonStatus(&buf, "/town", r, time.Now())
+	out := buf.String()
+	for _, want := range []string{"running (PID 4242)", "PID file does not match", "up 2h 0m", "No escalations"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	r.PIDVerified = true
+	buf.Reset()
+	renderDaemonStatus(&buf, "/town", r, time.Now())
+	if strings.Contains(buf.String(), "PID file does not match") {
+		t.Errorf("verified PID still warned:\n%s", buf.String())
+	}
+}
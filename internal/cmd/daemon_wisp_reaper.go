@@ -0,0 +1,256 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/reaper"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	daemonWispReaperStatusJSON  bool
+	daemonWispReaperRunOnceDry  bool
+	daemonWispReaperRunOnceJSON bool
+)
+
+var daemonWispReaperCmd = &cobra.Command{
+	Use:   "wisp-reaper",
+	Short: "Inspect the wisp_reaper patrol",
+	RunE:  requireSubcommand,
+}
+
+var daemonWispReaperStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the resolved wisp_reaper ages",
+	Long: `Show the wisp_reaper patrol settings with defaults applied.
+
+When max_age is set explicitly it is also passed to patrol formulas as
+wisp_gc_age, so the 'bd mol wisp gc --age' run inside witness and refinery
+patrols uses the same threshold as the daemon's reaper. Otherwise patrols
+keep their own 1h default.
+
+Examples:
+  gt daemon wisp-reaper status
+  gt daemon wisp-reaper status --json`,
+	Args: cobra.NoArgs,
+	RunE: runDaemonWispReaperStatus,
+}
+
+var daemonWispReaperRunOnceCmd = &cobra.Command{
+	Use:   "run-once",
+	Short: "Run a single wisp_reaper pass now",
+	Long: `Run one wisp_reaper pass immediately instead of waiting for the interval.
+
+The pass uses the same configured thresholds as the daemon (see
+'gt daemon wisp-reaper status'):
+  - open wisps older than max_age are closed
+  - closed wisps older than delete_age (and closed mail) are deleted
+  - issues stale longer than stale_issue_age are labeled gt:stale for
+    review (or auto-closed when label_stale is false)
+
+Use this to clear wisp accumulation before it triggers escalations.
+With --dry-run (or dry_run in the patrol config) nothing is changed and
+the counts are what a real pass would do. Exits 1 if any database
+failed, after reporting the counts from the rest.
+
+Examples:
+  gt daemon wisp-reaper run-once --dry-run
+  gt daemon wisp-reaper run-once
+  gt daemon wisp-reaper run-once --json`,
+	Args: cobra.NoArgs,
+	RunE: runDaemonWispReaperRunOnce,
+}
+
+func init() {
+	daemonWispReaperStatusCmd.Flags().BoolVar(&daemonWispReaperStatusJSON, "json", false, "Output as JSON")
+	daemonWispReaperRunOnceCmd.Flags().BoolVar(&daemonWispReaperRunOnceDry, "dry-run", false, "Report what would be closed or deleted without acting")
+	daemonWispReaperRunOnceCmd.Flags().BoolVar(&daemonWispReaperRunOnceJSON, "json", false, "Output as JSON")
+
+	daemonWispReaperCmd.AddCommand(daemonWispReaperStatusCmd)
+	daemonWispReaperCmd.AddCommand(daemonWispReaperRunOnceCmd)
+	daemonCmd.AddCommand(daemonWispReaperCmd)
+}
+
+func runDaemonWispReaperStatus(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	settings := daemon.ResolveWispReaperSettings(daemon.LoadPatrolConfig(townRoot))
+
+	if daemonWispReaperStatusJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(settings)
+	}
+
+	printWispReaperSettings(os.Stdout, settings)
+	return nil
+}
+
+func printWispReaperSettings(w io.Writer, s daemon.WispReaperSettings) {
+	state := style.Success.Render("enabled")
+	if !s.Enabled {
+		state = style.Dim.Render("disabled")
+	}
+	if s.DryRun {
+		state += style.Warning.Render(" (dry run)")
+	}
+	fmt.Fprintf(w, "%s %s\n\n", style.Bold.Render("Wisp reaper:"), state)
+
+	fmt.Fprintf(w, "  Interval:         %s\n", s.Interval)
+	fmt.Fprintf(w, "  Max age:          %s  (open wisps older than this are closed)\n", s.MaxAge)
+	fmt.Fprintf(w, "  Delete age:       %s  (closed wisps older than this are deleted)\n", s.DeleteAge)
+	fmt.Fprintf(w, "  Stale issue age:  %s\n", s.StaleIssueAge)
+	fmt.Fprintf(w, "  Mail delete age:  %s\n", s.MailDeleteAge)
+	fmt.Fprintf(w, "  Label stale:      %v  (false: stale issues are auto-closed)\n", s.LabelStale)
+	if len(s.Databases) > 0 {
+		fmt.Fprintf(w, "  Databases:        %s\n", strings.Join(s.Databases, ", "))
+	}
+	fmt.Fprintf(w, "\n  Patrol GC:        bd mol wisp gc %s --force\n", strings.Join(s.GCArgs, " "))
+}
+
+// wispReaperPassResult totals one on-demand wisp_reaper pass.
+type wispReaperPassResult struct {
+	DryRun              bool     `json:"dry_run"`
+	Databases           []string `json:"databases"`
+	WispsClosed         int      `json:"wisps_closed"`
+	MoleculeStepsClosed int      `json:"molecule_steps_closed,omitempty"`
+	WispsDeleted        int      `json:"wisps_deleted"`
+	MailDeleted         int      `json:"mail_deleted"`
+	StaleLabeled        int      `json:"stale_labeled"`
+	StaleClosed         int      `json:"stale_closed"`
+	OpenRemain          int      `json:"open_remain"`
+	Errors              []string `json:"errors,omitempty"`
+}
+
+func runDaemonWispReaperRunOnce(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	patrolConfig := daemon.LoadPatrolConfig(townRoot)
+	settings := daemon.ResolveWispReaperSettings(patrolConfig)
+	ages := daemon.ResolveWispReaperAges(patrolConfig)
+	dryRun := daemonWispReaperRunOnceDry || settings.DryRun
+
+	host, port := defaultReaperEndpoint()
+	databases := settings.Databases
+	if len(databases) == 0 {
+		databases = reaper.DiscoverDatabases(host, port)
+	}
+	if len(databases) == 0 {
+		return fmt.Errorf("no databases found on %s:%d", host, port)
+	}
+
+	result := wispReaperPassResult{DryRun: dryRun, Databases: databases}
+	for _, dbName := range databases {
+		if err := reaper.ValidateDBName(dbName); err != nil {
+			result.Errors = append(result.Errors, err.Error())
+			continue
+		}
+		if err := reapWispDatabaseOnce(&result, host, port, dbName, ages, settings.LabelStale, dryRun); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", dbName, err))
+		}
+	}
+
+	return reportWispReaperPass(os.Stdout, result, daemonWispReaperRunOnceJSON)
+}
+
+// reportWispReaperPass writes the pass result as text or JSON. A pass with
+// per-database errors exits 1 (silently, since the errors are already in the
+// output) so cron and CI can tell a failed run from a clean one.
+func reportWispReaperPass(w io.Writer, result wispReaperPassResult, asJSON bool) error {
+	if asJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+	} else {
+		printWispReaperPass(w, result)
+	}
+
+	if len(result.Errors) > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// reapWispDatabaseOnce runs the reap, purge and stale-issue steps of the
+// wisp_reaper cycle against one database, adding its counts to result.
+// Databases without the beads schema are skipped silently, as the daemon does.
+func reapWispDatabaseOnce(result *wispReaperPassResult, host string, port int, dbName string, ages daemon.WispReaperAges, labelStale, dryRun bool) error {
+	db, err := reaper.OpenDB(host, port, dbName, 30*time.Second, 30*time.Second)
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	defer db.Close()
+
+	if ok, err := reaper.HasReaperSchema(db); err != nil {
+		return fmt.Errorf("schema check: %w", err)
+	} else if !ok {
+		return nil
+	}
+
+	reaped, err := reaper.Reap(db, dbName, ages.MaxAge, dryRun)
+	if err != nil {
+		return fmt.Errorf("reap: %w", err)
+	}
+	result.WispsClosed += reaped.Reaped
+	result.MoleculeStepsClosed += reaped.MoleculeStepsClosed
+	result.OpenRemain += reaped.OpenRemain
+
+	purged, err := reaper.Purge(db, dbName, ages.DeleteAge, ages.MailDeleteAge, dryRun)
+	if err != nil {
+		return fmt.Errorf("purge: %w", err)
+	}
+	result.WispsDeleted += purged.WispsPurged
+	result.MailDeleted += purged.MailPurged
+
+	if labelStale {
+		labeled, err := reaper.LabelStale(db, dbName, ages.StaleIssueAge, dryRun)
+		if err != nil {
+			return fmt.Errorf("label stale: %w", err)
+		}
+		result.StaleLabeled += labeled.Labeled
+		return nil
+	}
+	closed, err := reaper.AutoClose(db, dbName, ages.StaleIssueAge, dryRun)
+	if err != nil {
+		return fmt.Errorf("auto-close: %w", err)
+	}
+	result.StaleClosed += closed.Closed
+	return nil
+}
+
+func printWispReaperPass(w io.Writer, r wispReaperPassResult) {
+	title := "Wisp reaper pass complete:"
+	if r.DryRun {
+		title = "[DRY RUN] Wisp reaper pass (nothing changed):"
+	}
+	fmt.Fprintf(w, "%s\n", style.Bold.Render(title))
+	fmt.Fprintf(w, "  Databases:     %d\n", len(r.Databases))
+	fmt.Fprintf(w, "  Closed:        %d wisps", r.WispsClosed)
+	if r.MoleculeStepsClosed > 0 {
+		fmt.Fprintf(w, " (+%d closed-molecule steps)", r.MoleculeStepsClosed)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "  Deleted:       %d wisps, %d mail\n", r.WispsDeleted, r.MailDeleted)
+	fmt.Fprintf(w, "  Stale issues:  %d labeled %s, %d auto-closed\n", r.StaleLabeled, reaper.StaleLabel, r.StaleClosed)
+	fmt.Fprintf(w, "  Open:          %d wisps remain\n", r.OpenRemain)
+
+	for _, e := range r.Errors {
+		fmt.Fprintf(w, "  %s %s\n", style.WarningPrefix, e)
+	}
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestPrintWispReaperPass(t *testing.T) {
+	var buf bytes.Buffer
+	printWispReaperPass(&buf, wispReaperPassResult{
+		DryRun:              true,
+		Databases:           []string{"hq", "gastown"},
+		WispsClosed:         4,
+		MoleculeStepsClosed: 2,
+		WispsDeleted:        7,
+		MailDeleted:         3,
+		StaleLabeled:        1,
+		OpenRemain:          12,
+		Errors:              []string{"gastown: connect: refused"},
+	})
+	out := buf.String()
+
+	for _, want := range []string{
+		"[DRY RUN]",
+		"Databases:     2",
+		"Closed:        4 wisps (+2 closed-molecule steps)",
+		"Deleted:       7 wisps, 3 mail",
+		"Stale issues:  1 labeled gt:stale, 0 auto-closed",
+		"Open:          12 wisps remain",
+		"gastown: connect: refused",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestReportWispReaperPassExitCode(t *testing.T) {
+	for _, asJSON := range []bool{false, true} {
+		var buf bytes.Buffer
+		if err := reportWispReaperPass(&buf, wispReaperPassResult{Databases: []string{"hq"}}, asJSON); err != nil {
+			t.Errorf("json=%v: clean pass returned %v, want nil", asJSON, err)
+		}
+
+		buf.Reset()
+		err := reportWispReaperPass(&buf, wispReaperPassResult{
+			Databases: []string{"hq"},
+			Errors:    []string{"hq: connect: refused"},
+		}, asJSON)
+		if code, ok := IsSilentExit(err); !ok || code != 1 {
+			t.Errorf("json=%v: failed pass returned %v, want silent exit 1", asJSON, err)
+		}
+		if !strings.Contains(buf.String(), "hq: connect: refused") {
+			t.Errorf("json=%v: output missing error:\n%s", asJSON, buf.String())
+		}
+	}
+}
@@ -1,12 +1,15 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -17,6 +20,9 @@ var (
 	doctorRestartSessions bool
 	doctorNoStart         bool
 	doctorSlow            string
+	doctorJSON            bool
+	doctorWarningsAsErrs  bool
+	doctorEmitEvents      bool
 )
 
 var doctorCmd = &cobra.Command{
@@ -117,7 +123,18 @@ Patrol checks:
 Use --fix to attempt automatic fixes for issues that support it.
 Use --no-start with --fix to suppress starting the daemon and agents.
 Use --rig to check a specific rig instead of the entire workspace.
-Use --slow to highlight slow checks (default threshold: 1s, e.g. --slow=500ms).`,
+Use --slow to highlight slow checks (default threshold: 1s, e.g. --slow=500ms).
+Use --verbose to show each check's run time and details.
+Use --json for machine-readable results (includes per-check elapsed_ms and
+the exit code).
+Use --emit-events to append a doctor_run summary (overall status, warning
+count, failing check names) to the town events feed, e.g. from a scheduled
+run, for a history of town health.
+
+Exit codes:
+  0  No errors (warnings allowed)
+  1  At least one check reported an error, or a --fix failed
+  2  Warnings only, with --warnings-as-errors`,
 	RunE: runDoctor,
 }
 
@@ -130,6 +147,9 @@ func init() {
 	doctorCmd.Flags().StringVar(&doctorSlow, "slow", "", "Highlight slow checks (optional threshold, default 1s)")
 	// Allow --slow without a value (uses default 1s)
 	doctorCmd.Flags().Lookup("slow").NoOptDefVal = "1s"
+	doctorCmd.Flags().BoolVar(&doctorJSON, "json", false, "Output results as JSON")
+	doctorCmd.Flags().BoolVar(&doctorWarningsAsErrs, "warnings-as-errors", false, "Exit 2 when checks report warnings (for CI gating)")
+	doctorCmd.Flags().BoolVar(&doctorEmitEvents, "emit-events", false, "Record a summary of this run in the events feed")
 	rootCmd.AddCommand(doctorCmd)
 }
 
@@ -161,6 +181,26 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if doctorJSON {
+		var report *doctor.Report
+		if doctorFix {
+			report = d.Fix(ctx)
+		} else {
+			report = d.Run(ctx)
+		}
+		if doctorEmitEvents {
+			emitDoctorRunEvent(report, doctorFix)
+		}
+		code := report.ExitCode(doctorWarningsAsErrs)
+		if err := writeDoctorJSON(os.Stdout, report, code); err != nil {
+			return err
+		}
+		if code != doctor.ExitOK {
+			return NewSilentExit(code)
+		}
+		return nil
+	}
+
 	// Run checks with streaming output
 	fmt.Println() // Initial blank line
 	var report *doctor.Report
@@ -173,12 +213,111 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	// Print summary (checks were already printed during streaming)
 	report.PrintSummaryOnly(os.Stdout, doctorVerbose, slowThreshold)
 
-	// Exit with error code if there are errors
-	if report.HasErrors() {
-		return fmt.Errorf("doctor found %d error(s)", report.Summary.Errors)
+	if doctorEmitEvents {
+		emitDoctorRunEvent(report, doctorFix)
 	}
 
-	return nil
+	// On partial --fix failure, show what was applied (and how to undo it)
+	// alongside what failed, in the order the fixes ran.
+	if report.HasFixFailures() {
+		report.PrintFixLog(os.Stdout)
+	}
+
+	return doctorTextExit(os.Stderr, report, report.ExitCode(doctorWarningsAsErrs))
+}
+
+// doctorTextExit explains a non-OK exit code on w and returns it as a silent
+// exit, so the text path exits with the same code as --json.
+func doctorTextExit(w io.Writer, report *doctor.Report, code int) error {
+	switch {
+	case code == doctor.ExitOK:
+		return nil
+	case report.HasErrors():
+		fmt.Fprintf(w, "Error: doctor found %d error(s)\n", report.Summary.Errors)
+	case report.HasFixFailures():
+		fmt.Fprintln(w, "Error: doctor --fix: one or more fixes failed")
+	default:
+		fmt.Fprintf(w, "Error: doctor found %d warning(s) (--warnings-as-errors)\n", report.Summary.Warnings)
+	}
+	return NewSilentExit(code)
+}
+
+// emitDoctorRunEvent appends a doctor_run summary of report to the events
+// feed. Best-effort: a failed write must not change the doctor result.
+func emitDoctorRunEvent(report *doctor.Report, fix bool) {
+	_ = events.LogFeed(events.TypeDoctorRun, "gt", doctorRunPayload(report, fix))
+}
+
+// doctorRunPayload summarizes report for the events feed.
+func doctorRunPayload(report *doctor.Report, fix bool) map[string]interface{} {
+	var failing, warned []string
+	for _, c := range report.Checks {
+		switch c.Status {
+		case doctor.StatusError:
+			failing = append(failing, c.Name)
+		case doctor.StatusWarning:
+			warned = append(warned, c.Name)
+		}
+	}
+	return events.DoctorRunPayload(doctor.WorstStatus(report.Checks).String(), report.Summary.Total, failing, warned, fix)
+}
+
+// doctorJSONCheck is the JSON form of a single check result.
+type doctorJSONCheck struct {
+	Name      string   `json:"name"`
+	Category  string   `json:"category,omitempty"`
+	Status    string   `json:"status"`
+	Message   string   `json:"message"`
+	Details   []string `json:"details,omitempty"`
+	FixHint   string   `json:"fix_hint,omitempty"`
+	Fixed     bool     `json:"fixed,omitempty"`
+	ElapsedMS int64    `json:"elapsed_ms"`
+}
+
+// doctorJSONOutput is the top-level gt doctor --json document. Status is the
+// worst check status; ExitCode is the same code the command exits with.
+type doctorJSONOutput struct {
+	Status  string            `json:"status"`
+	Checks  []doctorJSONCheck `json:"checks"`
+	Summary struct {
+		Total    int `json:"total"`
+		OK       int `json:"ok"`
+		Warnings int `json:"warnings"`
+		Errors   int `json:"errors"`
+		Fixed    int `json:"fixed"`
+	} `json:"summary"`
+	FixFailures bool `json:"fix_failures,omitempty"`
+	ExitCode    int  `json:"exit_code"`
+}
+
+func writeDoctorJSON(w io.Writer, report *doctor.Report, exitCode int) error {
+	out := doctorJSONOutput{
+		Status:      doctor.WorstStatus(report.Checks).String(),
+		Checks:      make([]doctorJSONCheck, 0, len(report.Checks)),
+		FixFailures: report.HasFixFailures(),
+		ExitCode:    exitCode,
+	}
+	for _, c := range report.Checks {
+		out.Checks = append(out.Checks, doctorJSONCheck{
+			Name:      c.Name,
+			Category:  c.Category,
+			Status:    c.Status.String(),
+			Message:   c.Message,
+			Details:   c.Details,
+			FixHint:   c.FixHint,
+			Fixed:     c.Fixed,
+			ElapsedMS: c.Elapsed.Milliseconds(),
+		})
+	}
+	out.Summary.Total = report.Summary.Total
+	out.Summary.OK = report.Summary.OK
+	out.Summary.Warnings = report.Summary.Warnings
+	out.Summary.Errors = report.Summary.Errors
+	out.Summary.Fixed = report.Summary.Fixed
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
 }
 
 func newDoctorForCommand(rig string) *doctor.Doctor {
@@ -223,6 +362,7 @@ func newDoctorForCommand(rig string) *doctor.Doctor {
 	d.Register(doctor.NewCustomTypesCheck())
 	d.Register(doctor.NewCustomStatusesCheck())
 	d.Register(doctor.NewFormulaCheck())
+	d.Register(doctor.NewFormulaValidityCheck())
 	d.Register(doctor.NewOverlayHealthCheck())
 	d.Register(doctor.NewPrefixConflictCheck())
 	d.Register(doctor.NewRigNameMismatchCheck())
@@ -246,6 +386,7 @@ func newDoctorForCommand(rig string) *doctor.Doctor {
 	d.Register(doctor.NewStaleBeadsRedirectCheck())
 	d.Register(doctor.NewBeadsRedirectTargetCheck())
 	d.Register(doctor.NewStaleRuntimeFilesCheck())
+	d.Register(doctor.NewOrphanedHeartbeatCheck())
 	d.Register(doctor.NewBranchCheck())
 	d.Register(doctor.NewCloneDivergenceCheck())
 	d.Register(doctor.NewDefaultBranchAllRigsCheck())
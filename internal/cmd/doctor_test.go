@@ -1,6 +1,13 @@
 package cmd
 
-import "testing"
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/doctor"
+)
 
 func TestDoctorDoesNotRegisterDoltConfigCheck(t *testing.T) {
 	d := newDoctorForCommand("")
@@ -10,3 +17,95 @@ func TestDoctorDoesNotRegisterDoltConfigCheck(t *testing.T) {
 		}
 	}
 }
+
+func TestWriteDoctorJSONReportsExitCode(t *testing.T) {
+	report := doctor.NewReport()
+	report.Add(&doctor.CheckResult{Name: "ok-check", Status: doctor.StatusOK, Message: "fine"})
+	report.Add(&doctor.CheckResult{Name: "warn-check", Status: doctor.StatusWarning, Message: "meh", Details: []string{"d1"}})
+
+	for _, warningsAsErrors := range []bool{false, true} {
+		code := report.ExitCode(warningsAsErrors)
+		var buf bytes.Buffer
+		if err := writeDoctorJSON(&buf, report, code); err != nil {
+			t.Fatalf("writeDoctorJSON: %v", err)
+		}
+		var out doctorJSONOutput
+		if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+			t.Fatalf("unmarshal: %v\n%s", err, buf.String())
+		}
+		if out.Status != "Warning" {
+			t.Errorf("status = %q, want Warning", out.Status)
+		}
+		if out.ExitCode != code {
+			t.Errorf("exit_code = %d, want %d", out.ExitCode, code)
+		}
+		if out.Summary.Warnings != 1 || out.Summary.OK != 1 || len(out.Checks) != 2 {
+			t.Errorf("summary = %+v, checks = %d", out.Summary, len(out.Checks))
+		}
+		if out.Checks[1].Status != "Warning" || out.Checks[1].Details[0] != "d1" {
+			t.Errorf("checks[1] = %+v", out.Checks[1])
+		}
+	}
+	if report.ExitCode(false) != doctor.ExitOK || report.ExitCode(true) != doctor.ExitWarnings {
+		t.Errorf("ExitCode = %d/%d, want %d/%d", report.ExitCode(false), report.ExitCode(true), doctor.ExitOK, doctor.ExitWarnings)
+	}
+}
+
+func TestDoctorTextExitMatchesExitCode(t *testing.T) {
+	warnings := doctor.NewReport()
+	warnings.Add(&doctor.CheckResult{Name: "warn-check", Status: doctor.StatusWarning})
+	errs := doctor.NewReport()
+	errs.Add(&doctor.CheckResult{Name: "warn-check", Status: doctor.StatusWarning})
+	errs.Add(&doctor.CheckResult{Name: "err-check", Status: doctor.StatusError})
+
+	tests := []struct {
+		name             string
+		report           *doctor.Report
+		warningsAsErrors bool
+		wantMsg          string
+	}{
+		{"warnings tolerated", warnings, false, ""},
+		{"warnings as errors", warnings, true, "1 warning(s)"},
+		{"errors", errs, false, "1 error(s)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := tt.report.ExitCode(tt.warningsAsErrors)
+			var buf bytes.Buffer
+			err := doctorTextExit(&buf, tt.report, code)
+			if code == doctor.ExitOK {
+				if err != nil || buf.Len() != 0 {
+					t.Fatalf("doctorTextExit = %v, output %q; want nil and no output", err, buf.String())
+				}
+				return
+			}
+			if got, ok := IsSilentExit(err); !ok || got != code {
+				t.Fatalf("doctorTextExit = %v, want silent exit %d", err, code)
+			}
+			if !strings.Contains(buf.String(), tt.wantMsg) {
+				t.Errorf("output = %q, want %q", buf.String(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestDoctorRunPayload(t *testing.T) {
+	report := doctor.NewReport()
+	report.Add(&doctor.CheckResult{Name: "ok-check", Status: doctor.StatusOK})
+	report.Add(&doctor.CheckResult{Name: "warn-check", Status: doctor.StatusWarning})
+	report.Add(&doctor.CheckResult{Name: "err-a", Status: doctor.StatusError})
+	report.Add(&doctor.CheckResult{Name: "err-b", Status: doctor.StatusError})
+
+	p := doctorRunPayload(report, true)
+	if p["status"] != "Error" || p["total"] != 4 || p["warnings"] != 1 || p["fix"] != true {
+		t.Errorf("payload = %v", p)
+	}
+	failing, _ := p["failing"].([]string)
+	if len(failing) != 2 || failing[0] != "err-a" || failing[1] != "err-b" {
+		t.Errorf("failing = %v, want [err-a err-b] in check order", p["failing"])
+	}
+	warned, _ := p["warned"].([]string)
+	if len(warned) != 1 || warned[0] != "warn-check" {
+		t.Errorf("warned = %v, want [warn-check]", p["warned"])
+	}
+}
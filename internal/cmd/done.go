@@ -97,7 +97,7 @@ func shouldRetirePolecatSessionAfterDone(exitType, mergeStrategy string, pushFai
 	if exitType != ExitCompleted || pushFailed || mrFailed {
 		return false
 	}
-	return mergeStrategy != "local"
+	return mergeStrategy != beads.MergeStrategyLocal
 }
 
 type doneSessionKiller interface {
@@ -443,13 +443,13 @@ func doneDirectMergeSkipReason(bd *beads.Beads, issueID string, issue *beads.Iss
 	if err := validateConcreteSourceIssue(issueID, issue); err != nil {
 		return err.Error()
 	}
-	if attachment := beads.ParseAttachmentFields(issue); attachment != nil {
+	if attachment := issue.Attachment(); attachment != nil {
 		switch {
 		case attachment.NoMerge:
 			return fmt.Sprintf("source_issue %s has no_merge=true", issueID)
 		case attachment.ReviewOnly:
 			return fmt.Sprintf("review-only issue %s cannot be direct-merged to %s", issueID, targetBranch)
-		case strings.EqualFold(strings.TrimSpace(attachment.MergeStrategy), "local"):
+		case strings.EqualFold(strings.TrimSpace(attachment.MergeStrategy), beads.MergeStrategyLocal):
 			return fmt.Sprintf("source_issue %s has merge_strategy=local", issueID)
 		}
 	}
@@ -467,7 +467,7 @@ func doneSourceCloseSkipReasonForHead(bd *beads.Beads, issueID string, issue *be
 	if err := validateConcreteSourceIssue(issueID, issue); err != nil {
 		return err.Error(), true
 	}
-	if attachment := beads.ParseAttachmentFields(issue); attachment != nil && strings.EqualFold(strings.TrimSpace(attachment.MergeStrategy), "local") {
+	if attachment := issue.Attachment(); attachment != nil && strings.EqualFold(strings.TrimSpace(attachment.MergeStrategy), beads.MergeStrategyLocal) {
 		return fmt.Sprintf("issue %s has merge_strategy=local — skipping close", issueID), false
 	}
 	if skipReason, fatal := doneReviewOnlyCloseSkipReasonForHead(bd, issueID, issue, currentHead); skipReason != "" {
@@ -484,7 +484,7 @@ func doneReviewOnlyCloseSkipReason(bd *beads.Beads, issueID string, issue *beads
 	if skipReason != "" {
 		return skipReason, fatal
 	}
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	if attachment == nil || !attachment.ReviewOnly {
 		return "", false
 	}
@@ -500,7 +500,7 @@ func doneReviewOnlyCloseSkipReasonForHead(bd *beads.Beads, issueID string, issue
 	if skipReason != "" {
 		return skipReason, fatal
 	}
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	if attachment == nil || !attachment.ReviewOnly {
 		return "", false
 	}
@@ -1005,7 +1005,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			}
 			sourceIssueForNoMerge = sourceInfo.Issue
 			sourceBD = sourceInfo.BD
-			if af := beads.ParseAttachmentFields(sourceIssueForNoMerge); af != nil {
+			if af := sourceIssueForNoMerge.Attachment(); af != nil {
 				if af.NoMerge || af.ReviewOnly {
 					isNoMergeTask = true
 				}
@@ -1194,7 +1194,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		}
 
 		// Handle "local" strategy: skip push and MR entirely
-		if convoyInfo != nil && convoyInfo.MergeStrategy == "local" {
+		if convoyInfo.EffectiveStrategy() == beads.MergeStrategyLocal {
 			fmt.Printf("%s Local merge strategy: skipping push and merge queue\n", style.Bold.Render("→"))
 			fmt.Printf("  Branch: %s\n", branch)
 			if issueID != "" {
@@ -1206,7 +1206,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		}
 
 		// Handle "direct" strategy: push to target branch, skip MR
-		if convoyInfo != nil && convoyInfo.MergeStrategy == "direct" {
+		if convoyInfo.EffectiveStrategy() == beads.MergeStrategyDirect {
 			fmt.Printf("%s Direct merge strategy: pushing to %s\n", style.Bold.Render("→"), defaultBranch)
 			directBd := sourceBD
 			if directBd == nil {
@@ -1287,7 +1287,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			fmt.Fprintf(os.Stderr, "  MR beads written here will be invisible to the Refinery — run 'gt polecat repair' to fix\n")
 		}
 		bd := beads.NewWithBeadsDir(cwd, resolvedBeads)
-		if attachmentFields := beads.ParseAttachmentFields(sourceIssueForNoMerge); attachmentFields != nil && strings.EqualFold(strings.TrimSpace(attachmentFields.MergeStrategy), "local") {
+		if attachmentFields := sourceIssueForNoMerge.Attachment(); attachmentFields != nil && strings.EqualFold(strings.TrimSpace(attachmentFields.MergeStrategy), beads.MergeStrategyLocal) {
 			fmt.Printf("%s Local merge strategy: skipping push and merge queue\n", style.Bold.Render("→"))
 			fmt.Printf("  Branch: %s\n", branch)
 			fmt.Printf("  Issue: %s\n", issueID)
@@ -1305,7 +1305,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		if convoyInfo == nil {
 			convoyInfo = getConvoyInfoForIssue(issueID)
 		}
-		if convoyInfo != nil && convoyInfo.MergeStrategy == "direct" {
+		if convoyInfo.EffectiveStrategy() == beads.MergeStrategyDirect {
 			fmt.Printf("%s Late-detected direct merge strategy: pushing to %s\n", style.Bold.Render("→"), defaultBranch)
 			fmt.Printf("  Convoy: %s\n", convoyInfo.ID)
 			directBd := sourceBD
@@ -1468,7 +1468,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 
 		// Check for no_merge flag - if set, skip merge queue and notify for review
 		{
-			attachmentFields := beads.ParseAttachmentFields(sourceIssueForNoMerge)
+			attachmentFields := sourceIssueForNoMerge.Attachment()
 			if attachmentFields != nil && attachmentFields.NoMerge {
 				fmt.Printf("%s No-merge mode: skipping merge queue\n", style.Bold.Render("→"))
 				fmt.Printf("  Branch: %s\n", branch)
@@ -1607,7 +1607,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		}
 
 		// Determine target branch for the MR.
-		// Priority: explicit --target flag > formula_vars base_branch > integration branch auto-detect > rig default.
+		// Priority: explicit --target flag > formula_vars target_branch/base_branch > integration branch auto-detect > rig default.
 		target := defaultBranch
 		explicitTarget := false
 
@@ -1620,12 +1620,12 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			fmt.Printf("  Target branch: %s (from --target flag)\n", target)
 		}
 
-		// 2. Check for --base-branch override in formula vars (stored on bead at sling time).
-		// Fallback for polecats dispatched before --target flag existed, or when
-		// the formula doesn't pass --target explicitly.
+		// 2. Check for --target-branch / --base-branch overrides in formula vars
+		// (stored on bead at sling time). Fallback for polecats dispatched before
+		// --target flag existed, or when the formula doesn't pass --target explicitly.
 		if !explicitTarget && target == defaultBranch && sourceIssueForNoMerge != nil {
-			if af := beads.ParseAttachmentFields(sourceIssueForNoMerge); af != nil {
-				if bb := extractFormulaVar(af.FormulaVars, "base_branch"); bb != "" && bb != defaultBranch {
+			if af := sourceIssueForNoMerge.Attachment(); af != nil {
+				if bb := formulaVarsMRTarget(af.FormulaVars, defaultBranch); bb != "" {
 					target = bb
 					fmt.Printf("  Target branch override: %s (from formula_vars)\n", target)
 				}
@@ -2359,7 +2359,7 @@ func updateAgentStateOnDone(cwd, townRoot, exitType, issueID string) error {
 			// has attached_molecule pointing to the wisp. Without this fix, gt done
 			// only closed the hooked bead, leaving the wisp orphaned.
 			// Order matters: wisp closes -> unblocks base bead -> base bead closes.
-			attachment := beads.ParseAttachmentFields(hookedBead)
+			attachment := hookedBead.Attachment()
 			if attachment != nil && attachment.AttachedMolecule != "" {
 				// Close molecule step descendants before closing the wisp root.
 				// bd close doesn't cascade — without this, open/in_progress steps
@@ -2746,3 +2746,18 @@ func purgeClosedEphemeralBeads(bd *beads.Beads) {
 		fmt.Fprintf(os.Stderr, "Purged closed ephemeral beads: %s\n", outStr)
 	}
 }
+
+// formulaVarsMRTarget returns the MR target branch recorded in a bead's
+// formula vars at sling time. target_branch (sling --target-branch) takes
+// precedence over base_branch (sling --base-branch). Returns "" when the
+// recorded target is defaultBranch or nothing was recorded.
+func formulaVarsMRTarget(formulaVars, defaultBranch string) string {
+	b := extractFormulaVar(formulaVars, "target_branch")
+	if b == "" {
+		b = extractFormulaVar(formulaVars, "base_branch")
+	}
+	if b == defaultBranch {
+		return ""
+	}
+	return b
+}
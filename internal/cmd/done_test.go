@@ -2234,3 +2234,28 @@ func testRunGit(t *testing.T, dir string, args ...string) {
 		t.Fatalf("git %v in %s: %v\n%s", args, dir, err, out)
 	}
 }
+
+// TestFormulaVarsMRTarget verifies that a target recorded by sling
+// --target-branch reaches MR creation and wins over --base-branch.
+func TestFormulaVarsMRTarget(t *testing.T) {
+	tests := []struct {
+		name        string
+		formulaVars string
+		want        string
+	}{
+		{"nothing recorded", "issue=gt-1", ""},
+		{"base branch only", "base_branch=develop", "develop"},
+		{"target branch only", "target_branch=release/v2", "release/v2"},
+		{"target wins over base", "base_branch=develop\ntarget_branch=release/v2", "release/v2"},
+		{"target equal to default", "base_branch=develop\ntarget_branch=main", ""},
+		{"base equal to default", "base_branch=main", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formulaVarsMRTarget(tt.formulaVars, "main"); got != tt.want {
+				t.Errorf("formulaVarsMRTarget(%q) = %q, want %q", tt.formulaVars, got, tt.want)
+			}
+		})
+	}
+}
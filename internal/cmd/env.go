@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var envShowDiff bool
+
+var envCmd = &cobra.Command{
+	Use:     "env",
+	GroupID: GroupDiag,
+	Short:   "Inspect agent session environments",
+	Long: `Inspect the tmux environment of running agent sessions.
+
+Use 'gt role env' to print the env vars for your own role instead.`,
+	RunE: requireSubcommand,
+}
+
+var envShowCmd = &cobra.Command{
+	Use:   "show <session>",
+	Short: "Show a session's tmux env vars, or how they differ from expected",
+	Long: `Show the tmux session environment of one agent session.
+
+Without --diff, prints every variable set in the session, sorted by name.
+
+With --diff, compares the session against what Gas Town expects for the role
+in the session name (the same comparison 'gt doctor' runs in its env-vars
+check) and prints only the differences:
+  missing    expected variable is not set
+  wrong      variable is set to a different value
+  unset      expected-empty variable is not set (the session inherits tmux's
+             global value; 'gt doctor' does not flag these)
+  BEADS_DIR  set in the session; breaks prefix-based bead routing
+
+Exits 1 when --diff finds a difference 'gt doctor' would report. Use
+'gt doctor --fix' to repair all sessions in place.
+
+Examples:
+  gt env show hq-mayor
+  gt env show gt-witness --diff`,
+	Args: cobra.ExactArgs(1),
+	RunE: runEnvShow,
+}
+
+func init() {
+	envShowCmd.Flags().BoolVar(&envShowDiff, "diff", false, "Show only differences from the expected environment")
+	envCmd.AddCommand(envShowCmd)
+	rootCmd.AddCommand(envCmd)
+}
+
+func runEnvShow(cmd *cobra.Command, args []string) error {
+	sess := args[0]
+
+	t := tmux.NewTmux()
+	has, err := t.HasSession(sess)
+	if err != nil {
+		return fmt.Errorf("checking session %s: %w", sess, err)
+	}
+	if !has {
+		return fmt.Errorf("session %q not found", sess)
+	}
+	actual, err := t.GetAllEnvironment(sess)
+	if err != nil {
+		return fmt.Errorf("reading environment of %s: %w", sess, err)
+	}
+
+	if !envShowDiff {
+		printSessionEnv(os.Stdout, actual)
+		return nil
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	expected, err := doctor.ExpectedSessionEnv(sess, townRoot)
+	if err != nil {
+		return fmt.Errorf("cannot determine role for %s: %w", sess, err)
+	}
+
+	if printEnvDiff(os.Stdout, sess, config.DiffEnv(expected, actual)) {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// printSessionEnv writes env as KEY=value lines sorted by key.
+func printSessionEnv(w io.Writer, env map[string]string) {
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s=%s\n", k, env[k])
+	}
+}
+
+// printEnvDiff writes one line per mismatch and reports whether any of them
+// is one the env-vars doctor check would flag (everything except unset).
+func printEnvDiff(w io.Writer, sess string, mismatches []config.EnvMismatch) bool {
+	if len(mismatches) == 0 {
+		fmt.Fprintf(w, "%s %s matches the expected environment\n", style.Success.Render("✓"), sess)
+		return false
+	}
+
+	fmt.Fprintf(w, "%s: %d difference(s) from the expected environment\n", sess, len(mismatches))
+	flagged := false
+	for _, m := range mismatches {
+		switch m.Kind {
+		case config.EnvMissing:
+			flagged = true
+			fmt.Fprintf(w, "  %-9s %s (expected %q)\n", "missing", m.Key, m.Expected)
+		case config.EnvWrong:
+			flagged = true
+			fmt.Fprintf(w, "  %-9s %s=%q (expected %q)\n", "wrong", m.Key, m.Actual, m.Expected)
+		case config.EnvUnset:
+			fmt.Fprintf(w, "  %-9s %s %s\n", "unset", m.Key, style.Dim.Render("(expected empty)"))
+		case config.EnvBeadsDir:
+			flagged = true
+			fmt.Fprintf(w, "  %-9s %q %s\n", m.Key, m.Actual, style.Warning.Render("(breaks prefix routing)"))
+		}
+	}
+	return flagged
+}
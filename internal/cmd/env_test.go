@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestPrintSessionEnv_Sorted(t *testing.T) {
+	var buf bytes.Buffer
+	printSessionEnv(&buf, map[string]string{"GT_ROLE": "mayor", "BD_ACTOR": "mayor", "CLAUDECODE": ""})
+
+	want := "BD_ACTOR=mayor\nCLAUDECODE=\nGT_ROLE=mayor\n"
+	if got := buf.String(); got != want {
+		t.Errorf("printSessionEnv() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestPrintEnvDiff(t *testing.T) {
+	expected := map[string]string{"GT_ROLE": "mayor", "GT_ROOT": "/town", "CLAUDECODE": "", "BEADS_DIR": ""}
+
+	t.Run("match", func(t *testing.T) {
+		var buf bytes.Buffer
+		env := map[string]string{"GT_ROLE": "mayor", "GT_ROOT": "/town", "CLAUDECODE": "", "BEADS_DIR": ""}
+		if printEnvDiff(&buf, "hq-mayor", config.DiffEnv(expected, env)) {
+			t.Error("printEnvDiff() flagged a matching environment")
+		}
+		if !strings.Contains(buf.String(), "matches the expected environment") {
+			t.Errorf("output = %q, want match message", buf.String())
+		}
+	})
+
+	t.Run("unset only", func(t *testing.T) {
+		var buf bytes.Buffer
+		env := map[string]string{"GT_ROLE": "mayor", "GT_ROOT": "/town", "BEADS_DIR": ""}
+		if printEnvDiff(&buf, "hq-mayor", config.DiffEnv(expected, env)) {
+			t.Error("printEnvDiff() flagged an unset-only difference; doctor does not")
+		}
+		if !strings.Contains(buf.String(), "unset") || !strings.Contains(buf.String(), "CLAUDECODE") {
+			t.Errorf("output = %q, want CLAUDECODE listed as unset", buf.String())
+		}
+	})
+
+	t.Run("flagged", func(t *testing.T) {
+		var buf bytes.Buffer
+		env := map[string]string{"GT_ROLE": "deacon", "CLAUDECODE": "", "BEADS_DIR": "/x/.beads"}
+		if !printEnvDiff(&buf, "hq-mayor", config.DiffEnv(expected, env)) {
+			t.Error("printEnvDiff() did not flag missing/wrong/BEADS_DIR")
+		}
+		out := buf.String()
+		for _, want := range []string{
+			"3 difference(s)",
+			`missing   GT_ROOT (expected "/town")`,
+			`wrong     GT_ROLE="deacon" (expected "mayor")`,
+			`BEADS_DIR "/x/.beads"`,
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("output missing %q:\n%s", want, out)
+			}
+		}
+	})
+}
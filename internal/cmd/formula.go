@@ -54,6 +54,8 @@ Commands:
   show    Display formula details (steps, variables, composition)
   run     Execute a formula (pour and dispatch)
   create  Create a new formula template
+  new     Scaffold a minimal valid formula in the current rig
+  diff    Compare the resolved steps of two formulas
 
 Search paths (in order):
   1. .beads/formulas/ (project)
@@ -1037,7 +1039,22 @@ func substituteFormulaVars(text string, vars map[string]interface{}) string {
 
 // findFormulaFile searches for a formula file by name
 func findFormulaFile(name string) (string, error) {
-	// Search paths in order
+	// Try each path with common extensions
+	extensions := []string{".formula.toml", ".formula.json"}
+	for _, basePath := range formulaSearchPaths() {
+		for _, ext := range extensions {
+			path := filepath.Join(basePath, name+ext)
+			if _, err := os.Stat(path); err == nil {
+				return path, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("formula '%s' not found in search paths", name)
+}
+
+// formulaSearchPaths returns the on-disk formula directories in lookup order.
+func formulaSearchPaths() []string {
 	searchPaths := []string{}
 
 	// 1. Project .beads/formulas/
@@ -1055,18 +1072,7 @@ func findFormulaFile(name string) (string, error) {
 		searchPaths = append(searchPaths, filepath.Join(home, ".beads", "formulas"))
 	}
 
-	// Try each path with common extensions
-	extensions := []string{".formula.toml", ".formula.json"}
-	for _, basePath := range searchPaths {
-		for _, ext := range extensions {
-			path := filepath.Join(basePath, name+ext)
-			if _, err := os.Stat(path); err == nil {
-				return path, nil
-			}
-		}
-	}
-
-	return "", fmt.Errorf("formula '%s' not found in search paths", name)
+	return searchPaths
 }
 
 // parseFormulaFile parses a formula file using the formula package's TOML parser.
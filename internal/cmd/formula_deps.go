@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/formula"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var formulaDepsJSON bool
+
+var formulaDepsCmd = &cobra.Command{
+	Use:   "deps <formula> <step>",
+	Short: "Show what a step depends on and what depends on it",
+	Long: `Show the transitive dependencies of a formula step.
+
+Upstream lists every step the given step transitively needs; downstream lists
+every step that transitively needs it. Both are in topological order. The
+formula is resolved first, so inherited and expanded steps are included.
+
+Use this to gauge the blast radius of changing or removing a step.
+
+Examples:
+  gt formula deps shiny implement
+  gt formula deps shiny-enterprise implement.draft
+  gt formula deps shiny review --json`,
+	Args: cobra.ExactArgs(2),
+	RunE: runFormulaDeps,
+}
+
+func init() {
+	formulaDepsCmd.Flags().BoolVar(&formulaDepsJSON, "json", false, "Output as JSON")
+
+	formulaCmd.AddCommand(formulaDepsCmd)
+}
+
+func runFormulaDeps(cmd *cobra.Command, args []string) error {
+	f, err := loadResolvedFormula(args[0])
+	if err != nil {
+		return err
+	}
+
+	d, err := f.StepDependencies(args[1])
+	if err != nil {
+		return err
+	}
+
+	if formulaDepsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(d)
+	}
+
+	printFormulaDeps(os.Stdout, f.Name, d)
+	return nil
+}
+
+// printFormulaDeps renders a step's upstream and downstream closures.
+func printFormulaDeps(w io.Writer, formulaName string, d *formula.StepDeps) {
+	fmt.Fprintf(w, "%s %s\n", style.Bold.Render(formulaName+":"), d.Step)
+
+	fmt.Fprintf(w, "\n%s %s\n", style.Bold.Render("Upstream"), style.Dim.Render(fmt.Sprintf("(%d, needed by %s)", len(d.Upstream), d.Step)))
+	printFormulaDepsList(w, d.Upstream)
+
+	fmt.Fprintf(w, "\n%s %s\n", style.Bold.Render("Downstream"), style.Dim.Render(fmt.Sprintf("(%d, need %s)", len(d.Downstream), d.Step)))
+	printFormulaDepsList(w, d.Downstream)
+}
+
+func printFormulaDepsList(w io.Writer, ids []string) {
+	if len(ids) == 0 {
+		fmt.Fprintf(w, "  %s\n", style.Dim.Render("(none)"))
+		return
+	}
+	for _, id := range ids {
+		fmt.Fprintf(w, "  %s\n", id)
+	}
+}
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/formula"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var formulaDiffJSON bool
+
+var formulaDiffCmd = &cobra.Command{
+	Use:   "diff <formula-a> [formula-b]",
+	Short: "Compare the resolved steps of two formulas",
+	Long: `Compare two formulas after resolving extends and compose rules.
+
+Steps are aligned by ID. The diff lists steps added, removed, or changed in
+formula-b relative to formula-a, plus added and removed dependency edges.
+
+With a single formula, it is compared against its first extends parent,
+showing exactly what the composition added. A formula without extends is
+compared against its own steps before compose, expand, and advice rules are
+applied.
+
+Examples:
+  gt formula diff shiny shiny-secure
+  gt formula diff shiny-enterprise          # vs. its base (shiny)
+  gt formula diff shiny shiny-secure --json`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runFormulaDiff,
+}
+
+func init() {
+	formulaDiffCmd.Flags().BoolVar(&formulaDiffJSON, "json", false, "Output as JSON")
+
+	formulaCmd.AddCommand(formulaDiffCmd)
+}
+
+func runFormulaDiff(cmd *cobra.Command, args []string) error {
+	to, err := loadResolvedFormula(args[len(args)-1])
+	if err != nil {
+		return err
+	}
+
+	var d *formula.Diff
+	if len(args) == 2 {
+		from, err := loadResolvedFormula(args[0])
+		if err != nil {
+			return err
+		}
+		d = formula.DiffSteps(from, to)
+	} else {
+		raw, err := loadFormulaByName(args[0])
+		if err != nil {
+			return err
+		}
+		if len(raw.Extends) > 0 {
+			from, err := loadResolvedFormula(raw.Extends[0])
+			if err != nil {
+				return fmt.Errorf("base of %q: %w", args[0], err)
+			}
+			d = formula.DiffSteps(from, to)
+		} else {
+			// Compose, expand, and advice rules rewrite the formula's own
+			// steps, so show what they changed.
+			d = formula.DiffSteps(raw, to)
+			if d.Empty() {
+				return fmt.Errorf("formula %q has no extends and its transforms change no steps; pass two formula names to compare", args[0])
+			}
+			d.From = raw.Name + " (unresolved)"
+		}
+	}
+
+	if formulaDiffJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(d)
+	}
+
+	printFormulaDiff(os.Stdout, d)
+	return nil
+}
+
+// loadFormulaByName parses a formula from the on-disk search paths, falling
+// back to the embedded formulas shipped with gt.
+func loadFormulaByName(name string) (*formula.Formula, error) {
+	if path, err := findFormulaFile(name); err == nil {
+		return parseFormulaFile(path)
+	}
+	data, err := formula.GetEmbeddedFormulaContent(name)
+	if err != nil {
+		return nil, fmt.Errorf("formula '%s' not found in search paths or embedded formulas", name)
+	}
+	return formula.Parse(data)
+}
+
+// loadResolvedFormula loads a formula and applies its extends/compose rules.
+func loadResolvedFormula(name string) (*formula.Formula, error) {
+	f, err := loadFormulaByName(name)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := formula.Resolve(f, formulaSearchPaths())
+	if err != nil {
+		return nil, fmt.Errorf("resolving %s: %w", name, err)
+	}
+	return resolved, nil
+}
+
+// printFormulaDiff renders a formula diff in a unified-diff-like layout.
+func printFormulaDiff(w io.Writer, d *formula.Diff) {
+	fmt.Fprintf(w, "%s\n", style.Bold.Render("--- "+d.From))
+	fmt.Fprintf(w, "%s\n", style.Bold.Render("+++ "+d.To))
+	if d.Empty() {
+		fmt.Fprintf(w, "%s\n", style.Dim.Render("No step differences."))
+		return
+	}
+
+	if len(d.Added) > 0 || len(d.Removed) > 0 || len(d.Changed) > 0 {
+		fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Steps:"))
+		for _, s := range d.Added {
+			fmt.Fprintf(w, "  %s %s %s\n", style.Success.Render("+"), s.ID, style.Dim.Render(s.Title))
+		}
+		for _, s := range d.Removed {
+			fmt.Fprintf(w, "  %s %s %s\n", style.Error.Render("-"), s.ID, style.Dim.Render(s.Title))
+		}
+		for _, c := range d.Changed {
+			fmt.Fprintf(w, "  %s %s %s\n", style.Warning.Render("~"), c.ID, style.Dim.Render("("+strings.Join(c.Fields, ", ")+")"))
+		}
+	}
+
+	if len(d.AddedEdges) > 0 || len(d.RemovedEdges) > 0 {
+		fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Dependencies:"))
+		for _, e := range d.AddedEdges {
+			fmt.Fprintf(w, "  %s %s → %s\n", style.Success.Render("+"), e.Step, e.Needs)
+		}
+		for _, e := range d.RemovedEdges {
+			fmt.Fprintf(w, "  %s %s → %s\n", style.Error.Render("-"), e.Step, e.Needs)
+		}
+	}
+}
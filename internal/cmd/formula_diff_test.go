@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeDiffTestFormula(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name+".formula.toml"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunFormulaDiff_SingleFormulaWithoutExtends(t *testing.T) {
+	root := t.TempDir()
+	formulasDir := filepath.Join(root, ".beads", "formulas")
+	if err := os.MkdirAll(formulasDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(root)
+
+	writeDiffTestFormula(t, formulasDir, "two-pass", `formula = "two-pass"
+type = "expansion"
+version = 1
+
+[[template]]
+id = "{target}-draft"
+title = "Draft {target}"
+
+[[template]]
+id = "{target}-polish"
+title = "Polish {target}"
+needs = ["{target}-draft"]
+`)
+	writeDiffTestFormula(t, formulasDir, "composed", `formula = "composed"
+type = "workflow"
+version = 1
+
+[[steps]]
+id = "design"
+title = "Design"
+
+[[steps]]
+id = "implement"
+title = "Implement"
+needs = ["design"]
+
+[compose]
+
+[[compose.expand]]
+target = "implement"
+with = "two-pass"
+`)
+	writeDiffTestFormula(t, formulasDir, "plain", `formula = "plain"
+type = "workflow"
+version = 1
+
+[[steps]]
+id = "design"
+title = "Design"
+`)
+
+	oldJSON := formulaDiffJSON
+	formulaDiffJSON = false
+	t.Cleanup(func() { formulaDiffJSON = oldJSON })
+
+	out := captureStdout(t, func() {
+		if err := runFormulaDiff(nil, []string{"composed"}); err != nil {
+			t.Fatalf("runFormulaDiff(composed): %v", err)
+		}
+	})
+	if !strings.Contains(out, "composed (unresolved)") {
+		t.Errorf("expected the pre-transform formula as the diff base:\n%s", out)
+	}
+	if !strings.Contains(out, "implement-draft") || !strings.Contains(out, "- implement") {
+		t.Errorf("expected expanded steps added and the target removed:\n%s", out)
+	}
+
+	err := runFormulaDiff(nil, []string{"plain"})
+	if err == nil || !strings.Contains(err.Error(), "change no steps") {
+		t.Errorf("runFormulaDiff(plain) error = %v, want no-change error", err)
+	}
+}
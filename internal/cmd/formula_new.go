@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/formula"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+var (
+	formulaNewType string
+	formulaNewRig  string
+)
+
+var formulaNewCmd = &cobra.Command{
+	Use:   "new <name>",
+	Short: "Scaffold a minimal valid formula in the current rig",
+	Long: `Scaffold a minimal, valid formula skeleton in the rig's .beads/formulas/.
+
+Unlike 'gt formula create', the skeleton uses the formula package's own types
+(workflow, convoy, aspect, expansion) and is parsed before it is written, so
+the result always loads. Each skeleton has the required top-level fields and
+one example block to copy:
+
+  workflow   [[steps]]
+  convoy     [[legs]] plus [synthesis]
+  aspect     [[aspects]]
+  expansion  [[template]]
+
+The rig is inferred from the current directory (or GT_RIG); use --rig to pick
+one explicitly. Fails if a formula with that name already exists in the rig.
+
+Examples:
+  gt formula new my-workflow
+  gt formula new code-audit --type=convoy
+  gt formula new security-lens --type=aspect --rig=gastown`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFormulaNew,
+}
+
+func init() {
+	formulaNewCmd.Flags().StringVar(&formulaNewType, "type", string(formula.TypeWorkflow), "Formula type: workflow, convoy, aspect, or expansion")
+	formulaNewCmd.Flags().StringVar(&formulaNewRig, "rig", "", "Target rig (default: inferred from cwd)")
+	formulaCmd.AddCommand(formulaNewCmd)
+}
+
+// formulaNamePattern restricts formula names to safe file name stems.
+var formulaNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+func runFormulaNew(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return fmt.Errorf("not in a Gas Town workspace")
+	}
+
+	rigPath := ""
+	if formulaNewRig != "" {
+		rigPath = filepath.Join(townRoot, formulaNewRig)
+		if _, err := os.Stat(rigPath); err != nil {
+			return fmt.Errorf("rig %q not found at %s", formulaNewRig, rigPath)
+		}
+	} else {
+		_, r, err := findCurrentRig(townRoot)
+		if err != nil {
+			return fmt.Errorf("cannot determine rig: %w; use --rig=NAME", err)
+		}
+		rigPath = r.Path
+	}
+
+	path, err := writeNewFormula(filepath.Join(rigPath, ".beads", "formulas"), args[0], formula.FormulaType(formulaNewType))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Created %s formula: %s\n", style.Bold.Render("✓"), formulaNewType, path)
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  1. Edit the formula: %s\n", path)
+	fmt.Printf("  2. View it:          gt formula show %s\n", args[0])
+	return nil
+}
+
+// writeNewFormula renders a skeleton of the given type, checks that it parses
+// as that type, and writes it to dir as <name>.formula.toml. It refuses to
+// overwrite an existing formula of the same name in dir.
+func writeNewFormula(dir, name string, typ formula.FormulaType) (string, error) {
+	if !formulaNamePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid formula name %q (use letters, digits, '.', '_' and '-')", name)
+	}
+	if !typ.IsValid() {
+		return "", fmt.Errorf("unknown formula type %q (use: workflow, convoy, aspect, or expansion)", typ)
+	}
+
+	for _, ext := range []string{".formula.toml", ".formula.json"} {
+		existing := filepath.Join(dir, name+ext)
+		if _, err := os.Stat(existing); err == nil {
+			return "", fmt.Errorf("formula already exists: %s", existing)
+		}
+	}
+
+	content := formulaSkeleton(name, typ)
+	parsed, err := formula.Parse([]byte(content))
+	if err != nil {
+		return "", fmt.Errorf("generated %s skeleton is invalid: %w", typ, err)
+	}
+	if parsed.Type != typ || parsed.Name != name {
+		return "", fmt.Errorf("generated skeleton parsed as %s %q, want %s %q", parsed.Type, parsed.Name, typ, name)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating formulas directory: %w", err)
+	}
+	path := filepath.Join(dir, name+".formula.toml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return "", fmt.Errorf("writing formula file: %w", err)
+	}
+	return path, nil
+}
+
+// formulaSkeleton returns minimal TOML for a formula of the given type: the
+// common header plus one example block of the type-specific section.
+func formulaSkeleton(name string, typ formula.FormulaType) string {
+	title := cases.Title(language.English).String(strings.ReplaceAll(name, "-", " "))
+
+	header := fmt.Sprintf(`# Formula: %s
+# Type: %s
+# Created by: gt formula new
+
+formula = %q
+type = %q
+version = 1
+description = """%s %s.
+
+Describe what this formula does and when to use it."""
+`, name, typ, name, string(typ), title, typ)
+
+	var body string
+	switch typ {
+	case formula.TypeWorkflow:
+		body = `
+[[steps]]
+id = "do-work"
+title = "Do the work"
+description = """
+Describe what the agent should do in this step.
+Add more [[steps]] and chain them with needs = ["do-work"].
+"""
+`
+	case formula.TypeConvoy:
+		body = `
+[[legs]]
+id = "review"
+title = "Review"
+focus = "What this leg looks at"
+description = """
+Describe what this parallel leg should analyze or produce.
+Add more [[legs]] to fan out further.
+"""
+
+[synthesis]
+title = "Synthesize"
+description = "Combine the leg outputs into a single result."
+depends_on = ["review"]
+`
+	case formula.TypeAspect:
+		body = `
+[[aspects]]
+id = "primary"
+title = "Primary aspect"
+focus = "What this aspect examines"
+description = """
+Describe the analysis this aspect contributes.
+Add more [[aspects]] for additional perspectives.
+"""
+`
+	case formula.TypeExpansion:
+		body = `
+[[template]]
+id = "{target}.expanded"
+title = "Expanded step for {target}"
+description = """
+Describe the step generated in place of the expanded target.
+Add more [[template]] entries and chain them with needs.
+"""
+`
+	}
+
+	return header + body
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/formula"
+)
+
+func TestWriteNewFormula_AllTypesParse(t *testing.T) {
+	t.Parallel()
+
+	for _, typ := range []formula.FormulaType{
+		formula.TypeWorkflow, formula.TypeConvoy, formula.TypeAspect, formula.TypeExpansion,
+	} {
+		t.Run(string(typ), func(t *testing.T) {
+			t.Parallel()
+			dir := filepath.Join(t.TempDir(), ".beads", "formulas")
+			name := "my-" + string(typ)
+
+			path, err := writeNewFormula(dir, name, typ)
+			if err != nil {
+				t.Fatalf("writeNewFormula: %v", err)
+			}
+			if want := filepath.Join(dir, name+".formula.toml"); path != want {
+				t.Errorf("path = %q, want %q", path, want)
+			}
+
+			f, err := formula.ParseFile(path)
+			if err != nil {
+				t.Fatalf("ParseFile: %v", err)
+			}
+			if f.Name != name || f.Type != typ {
+				t.Errorf("parsed %s %q, want %s %q", f.Type, f.Name, typ, name)
+			}
+		})
+	}
+}
+
+func TestWriteNewFormula_RejectsExisting(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	if _, err := writeNewFormula(dir, "dup", formula.TypeWorkflow); err != nil {
+		t.Fatalf("first writeNewFormula: %v", err)
+	}
+	_, err := writeNewFormula(dir, "dup", formula.TypeConvoy)
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("second writeNewFormula err = %v, want already exists", err)
+	}
+
+	// A JSON formula of the same name also blocks scaffolding.
+	if err := os.WriteFile(filepath.Join(dir, "legacy.formula.json"), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := writeNewFormula(dir, "legacy", formula.TypeWorkflow); err == nil {
+		t.Fatal("expected error for existing .formula.json")
+	}
+}
+
+func TestWriteNewFormula_RejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	if _, err := writeNewFormula(dir, "ok", formula.FormulaType("patrol")); err == nil {
+		t.Error("expected error for unknown type")
+	}
+	if _, err := writeNewFormula(dir, "../escape", formula.TypeWorkflow); err == nil {
+		t.Error("expected error for name with path separator")
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("rejected input wrote files: %v", entries)
+	}
+}
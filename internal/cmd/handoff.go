@@ -14,6 +14,7 @@ import (
 	"golang.org/x/term"
 
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/checkpoint"
 	"github.com/steveyegge/gastown/internal/cli"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
@@ -61,6 +62,10 @@ Unlike --auto (state only) or normal handoff (polecat→gt-done redirect), --cyc
 always does a full respawn regardless of role. This enables crew workers and
 polecats to get a fresh context window when the current one fills up.
 
+The --reason flag is recorded in the handoff marker so the successor's
+'gt prime' can frame the resume: 'compaction' resumes from the hooked work
+without re-running startup, 'idle' notes the predecessor was idle.
+
 Any molecule on the hook will be auto-continued by the new session.
 The SessionStart hook runs 'gt prime' to restore context.`,
 	RunE: runHandoff,
@@ -89,13 +94,27 @@ func init() {
 	handoffCmd.Flags().BoolVar(&handoffStdin, "stdin", false, "Read message body from stdin (avoids shell quoting issues)")
 	handoffCmd.Flags().BoolVar(&handoffAuto, "auto", false, "Save state only, no session cycling (for PreCompact hooks)")
 	handoffCmd.Flags().BoolVar(&handoffCycle, "cycle", false, "Auto-cycle session (for PreCompact hooks that want full session replacement)")
-	handoffCmd.Flags().StringVar(&handoffReason, "reason", "", "Reason for handoff (e.g., 'compaction', 'idle')")
+	handoffCmd.Flags().StringVar(&handoffReason, "reason", "", "Reason recorded in the handoff marker for the successor's prime: compaction or idle")
 	handoffCmd.Flags().BoolVar(&handoffNoGitCheck, "no-git-check", false, "Skip git workspace cleanliness check")
 	handoffCmd.Flags().BoolVarP(&handoffYes, "yes", "y", false, "Skip confirmation prompt (for automation and scripting)")
 	rootCmd.AddCommand(handoffCmd)
 }
 
+// callerTmux returns a Tmux for the server the calling process runs on, read
+// from $TMUX. Outside tmux this is tmux's own default server.
+func callerTmux() *tmux.Tmux {
+	socket := tmux.SocketFromEnv()
+	if socket == "" {
+		socket = "default"
+	}
+	return tmux.NewTmuxWithSocket(socket)
+}
+
 func runHandoff(cmd *cobra.Command, args []string) error {
+	if err := validateHandoffReason(handoffReason); err != nil {
+		return err
+	}
+
 	// Handle --stdin: read message body from stdin (avoids shell quoting issues)
 	if handoffStdin {
 		if handoffMessage != "" {
@@ -192,8 +211,7 @@ func runHandoff(cmd *cobra.Command, args []string) error {
 	// on a different tmux server than the town socket (e.g., default socket).
 	// For self-handoff, pane operations (clear-history, respawn-pane) must target
 	// the caller's own server. SocketFromEnv() reads $TMUX to find the right one.
-	callerSocket := tmux.SocketFromEnv()
-	t := tmux.NewTmuxWithSocket(callerSocket)
+	t := callerTmux()
 	// Town-socket Tmux for session-level queries (getSessionPane, etc.)
 	townTmux := tmux.NewTmux()
 	_ = townTmux // used later for remote handoff
@@ -330,10 +348,10 @@ func runHandoff(cmd *cobra.Command, args []string) error {
 	// The marker is cleared by gt prime after it outputs the warning.
 	// This tells the new session "you're post-handoff, don't re-run /handoff"
 	if cwd, err := os.Getwd(); err == nil {
-		runtimeDir := filepath.Join(cwd, constants.DirRuntime)
-		_ = os.MkdirAll(runtimeDir, 0755)
-		markerPath := filepath.Join(runtimeDir, constants.FileHandoffMarker)
-		_ = os.WriteFile(markerPath, []byte(currentSession), 0644)
+		_ = writeHandoffMarker(cwd, currentSession, handoffReason)
+		// Handoff mail carries the context now; a leftover checkpoint would
+		// make the successor think it is recovering from a crash.
+		_ = checkpoint.Clear(cwd)
 	}
 
 	// Record handoff time for cooldown enforcement (gt-058d).
@@ -412,16 +430,13 @@ func runHandoffAuto() error {
 
 	// Write handoff marker so post-compact prime knows it's post-handoff
 	if cwd, err := os.Getwd(); err == nil {
-		runtimeDir := filepath.Join(cwd, constants.DirRuntime)
-		_ = os.MkdirAll(runtimeDir, 0755)
-		markerPath := filepath.Join(runtimeDir, constants.FileHandoffMarker)
 		sessionName := "auto-handoff"
 		if tmux.IsInsideTmux() {
 			if name, err := getCurrentTmuxSession(); err == nil {
 				sessionName = name
 			}
 		}
-		_ = os.WriteFile(markerPath, []byte(sessionName), 0644)
+		_ = writeHandoffMarker(cwd, sessionName, handoffReason)
 	}
 
 	// Log handoff event
@@ -495,8 +510,7 @@ func runHandoffCycle() error {
 	}
 
 	// Use the caller's socket for pane operations (same as runHandoff).
-	callerSocket := tmux.SocketFromEnv()
-	t := tmux.NewTmuxWithSocket(callerSocket)
+	t := callerTmux()
 
 	if handoffDryRun {
 		fmt.Printf("[cycle] Would send handoff mail: subject=%q\n", subject)
@@ -527,18 +541,12 @@ func runHandoffCycle() error {
 	fmt.Fprintf(os.Stderr, "handoff --cycle: saved state to %s\n", beadID)
 
 	// Write handoff marker so post-cycle prime knows it's post-handoff.
-	// Format: "session_id\nreason" — the reason enables isCompactResume()
-	// to detect compaction-triggered cycles and use a lighter continuation
-	// directive instead of full re-initialization. (GH#1965)
+	// The reason enables isCompactResume() to detect compaction-triggered
+	// cycles and use a lighter continuation directive instead of full
+	// re-initialization. (GH#1965)
 	if cwd, err := os.Getwd(); err == nil {
-		runtimeDir := filepath.Join(cwd, constants.DirRuntime)
-		_ = os.MkdirAll(runtimeDir, 0755)
-		markerPath := filepath.Join(runtimeDir, constants.FileHandoffMarker)
-		markerContent := currentSession
-		if handoffReason != "" {
-			markerContent += "\n" + handoffReason
-		}
-		_ = os.WriteFile(markerPath, []byte(markerContent), 0644)
+		_ = writeHandoffMarker(cwd, currentSession, handoffReason)
+		_ = checkpoint.Clear(cwd)
 	}
 
 	// Record handoff time for cooldown enforcement (gt-058d).
@@ -1642,7 +1650,7 @@ func cleanupMoleculeOnHandoff() {
 	}
 
 	// Check for attached molecule on the handoff bead
-	attachment := beads.ParseAttachmentFields(handoffBead)
+	attachment := handoffBead.Attachment()
 	if attachment == nil || attachment.AttachedMolecule == "" {
 		return
 	}
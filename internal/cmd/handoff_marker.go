@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/atomicfile"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// HandoffMarkerVersion is the format version written by writeHandoffMarker.
+const HandoffMarkerVersion = 1
+
+// Handoff reasons that gt prime acts on. "compaction" sends the successor down
+// the compact/resume path (GH#1965); "idle" gets idle-specific resume framing.
+const (
+	HandoffReasonCompaction = "compaction"
+	HandoffReasonIdle       = "idle"
+)
+
+// knownHandoffReasons is the set accepted by gt handoff --reason.
+var knownHandoffReasons = []string{HandoffReasonCompaction, HandoffReasonIdle}
+
+// validateHandoffReason returns an error unless reason is empty or one of
+// knownHandoffReasons.
+func validateHandoffReason(reason string) error {
+	if reason == "" {
+		return nil
+	}
+	for _, known := range knownHandoffReasons {
+		if reason == known {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown handoff reason %q (valid: %s)", reason, strings.Join(knownHandoffReasons, ", "))
+}
+
+// HandoffMarker is the content of the handoff marker file
+// (<workdir>/.runtime/handoff_to_successor). gt handoff writes it before
+// respawning; gt prime reads and clears it in the successor session.
+//
+// Markers are written as JSON. Readers also accept the legacy positional
+// format (session ID on line 1, optional reason on line 2), reported as
+// Version 0, so a marker left by an older gt is still honoured.
+type HandoffMarker struct {
+	Version   int    `json:"version"`
+	SessionID string `json:"session_id"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// handoffMarkerPath returns the marker file path for an agent work directory.
+func handoffMarkerPath(dir string) string {
+	return filepath.Join(dir, constants.DirRuntime, constants.FileHandoffMarker)
+}
+
+// writeHandoffMarker atomically writes a current-version handoff marker under
+// dir's runtime directory, creating the directory if needed. A concurrent
+// gt prime sees either the old marker or the complete new one.
+func writeHandoffMarker(dir, sessionID, reason string) error {
+	path := handoffMarkerPath(dir)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating runtime dir: %w", err)
+	}
+	data, err := json.Marshal(HandoffMarker{
+		Version:   HandoffMarkerVersion,
+		SessionID: sessionID,
+		Reason:    reason,
+	})
+	if err != nil {
+		return fmt.Errorf("encoding handoff marker: %w", err)
+	}
+	if err := atomicfile.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing handoff marker: %w", err)
+	}
+	return nil
+}
+
+// readHandoffMarker reads the handoff marker under dir. If there is no marker
+// the returned error satisfies errors.Is(err, os.ErrNotExist).
+func readHandoffMarker(dir string) (*HandoffMarker, error) {
+	data, err := os.ReadFile(handoffMarkerPath(dir))
+	if err != nil {
+		return nil, err
+	}
+	return parseHandoffMarker(data)
+}
+
+// parseHandoffMarker decodes marker content in either the JSON or the legacy
+// positional format.
+func parseHandoffMarker(data []byte) (*HandoffMarker, error) {
+	content := strings.TrimSpace(string(data))
+	if strings.HasPrefix(content, "{") {
+		var m HandoffMarker
+		if err := json.Unmarshal([]byte(content), &m); err != nil {
+			return nil, fmt.Errorf("parsing handoff marker: %w", err)
+		}
+		return &m, nil
+	}
+
+	// Legacy: "session_id\nreason" (reason optional).
+	lines := strings.SplitN(content, "\n", 2)
+	m := &HandoffMarker{SessionID: strings.TrimSpace(lines[0])}
+	if len(lines) > 1 {
+		m.Reason = strings.TrimSpace(lines[1])
+	}
+	return m, nil
+}
@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+func writeRawHandoffMarker(t *testing.T, dir, content string) {
+	t.Helper()
+	runtimeDir := filepath.Join(dir, constants.DirRuntime)
+	if err := os.MkdirAll(runtimeDir, 0755); err != nil {
+		t.Fatalf("create runtime dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(runtimeDir, constants.FileHandoffMarker), []byte(content), 0644); err != nil {
+		t.Fatalf("write marker: %v", err)
+	}
+}
+
+func TestReadHandoffMarker_Legacy(t *testing.T) {
+	tests := []struct {
+		content string
+		want    HandoffMarker
+	}{
+		{"hq-mayor", HandoffMarker{SessionID: "hq-mayor"}},
+		{"hq-mayor\n", HandoffMarker{SessionID: "hq-mayor"}},
+		{"hq-mayor\ncompaction", HandoffMarker{SessionID: "hq-mayor", Reason: "compaction"}},
+		{" gt-crew-max \n compaction \n", HandoffMarker{SessionID: "gt-crew-max", Reason: "compaction"}},
+	}
+	for _, tt := range tests {
+		dir := t.TempDir()
+		writeRawHandoffMarker(t, dir, tt.content)
+		got, err := readHandoffMarker(dir)
+		if err != nil {
+			t.Fatalf("readHandoffMarker(%q): %v", tt.content, err)
+		}
+		if *got != tt.want {
+			t.Errorf("readHandoffMarker(%q) = %+v, want %+v", tt.content, *got, tt.want)
+		}
+	}
+}
+
+func TestReadHandoffMarker_JSON(t *testing.T) {
+	dir := t.TempDir()
+	// Unknown fields from a newer gt are ignored.
+	writeRawHandoffMarker(t, dir, `{"version":2,"session_id":"hq-deacon","reason":"compaction","extra":true}`)
+
+	got, err := readHandoffMarker(dir)
+	if err != nil {
+		t.Fatalf("readHandoffMarker: %v", err)
+	}
+	want := HandoffMarker{Version: 2, SessionID: "hq-deacon", Reason: "compaction"}
+	if *got != want {
+		t.Errorf("readHandoffMarker = %+v, want %+v", *got, want)
+	}
+}
+
+func TestReadHandoffMarker_MissingAndCorrupt(t *testing.T) {
+	if _, err := readHandoffMarker(t.TempDir()); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("missing marker error = %v, want os.ErrNotExist", err)
+	}
+
+	dir := t.TempDir()
+	writeRawHandoffMarker(t, dir, `{"session_id":`)
+	if _, err := readHandoffMarker(dir); err == nil || errors.Is(err, os.ErrNotExist) {
+		t.Errorf("corrupt marker error = %v, want a parse error", err)
+	}
+}
+
+func TestHandoffMarkerRoundTrip(t *testing.T) {
+	for _, reason := range []string{"", "compaction", "multi\nline"} {
+		dir := t.TempDir()
+		if err := writeHandoffMarker(dir, "gt-witness", reason); err != nil {
+			t.Fatalf("writeHandoffMarker: %v", err)
+		}
+		data, err := os.ReadFile(handoffMarkerPath(dir))
+		if err != nil {
+			t.Fatalf("read marker file: %v", err)
+		}
+		if !strings.HasPrefix(string(data), "{") {
+			t.Errorf("marker not written as JSON: %q", data)
+		}
+
+		got, err := readHandoffMarker(dir)
+		if err != nil {
+			t.Fatalf("readHandoffMarker: %v", err)
+		}
+		want := HandoffMarker{Version: HandoffMarkerVersion, SessionID: "gt-witness", Reason: reason}
+		if *got != want {
+			t.Errorf("round trip = %+v, want %+v", *got, want)
+		}
+	}
+}
+
+func TestCheckHandoffMarker_JSONFormat(t *testing.T) {
+	origReason := primeHandoffReason
+	defer func() { primeHandoffReason = origReason }()
+	primeHandoffReason = ""
+
+	workDir := t.TempDir()
+	if err := writeHandoffMarker(workDir, "test-session-789", "compaction"); err != nil {
+		t.Fatalf("writeHandoffMarker: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		checkHandoffMarker(workDir)
+	})
+
+	if primeHandoffReason != "compaction" {
+		t.Errorf("primeHandoffReason = %q, want compaction", primeHandoffReason)
+	}
+	if !strings.Contains(out, "test-session-789") {
+		t.Errorf("warning does not name predecessor session:\n%s", out)
+	}
+	if _, err := os.Stat(handoffMarkerPath(workDir)); !os.IsNotExist(err) {
+		t.Error("handoff marker was not removed")
+	}
+}
+
+func TestValidateHandoffReason(t *testing.T) {
+	for _, reason := range []string{"", HandoffReasonCompaction, HandoffReasonIdle} {
+		if err := validateHandoffReason(reason); err != nil {
+			t.Errorf("validateHandoffReason(%q) = %v, want nil", reason, err)
+		}
+	}
+	for _, reason := range []string{"compact", "Idle", "context full"} {
+		if err := validateHandoffReason(reason); err == nil {
+			t.Errorf("validateHandoffReason(%q) = nil, want error", reason)
+		}
+	}
+}
+
+func TestWriteHandoffMarker_ReplacesAtomically(t *testing.T) {
+	dir := t.TempDir()
+	if err := writeHandoffMarker(dir, "gt-old", HandoffReasonIdle); err != nil {
+		t.Fatalf("first write: %v", err)
+	}
+	if err := writeHandoffMarker(dir, "gt-new", HandoffReasonCompaction); err != nil {
+		t.Fatalf("second write: %v", err)
+	}
+
+	got, err := readHandoffMarker(dir)
+	if err != nil {
+		t.Fatalf("readHandoffMarker: %v", err)
+	}
+	if got.SessionID != "gt-new" || got.Reason != HandoffReasonCompaction {
+		t.Errorf("marker = %+v, want gt-new/compaction", *got)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(handoffMarkerPath(dir)))
+	if err != nil {
+		t.Fatalf("read runtime dir: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Name() != constants.FileHandoffMarker {
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		t.Errorf("runtime dir = %v, want only %s", names, constants.FileHandoffMarker)
+	}
+}
@@ -29,24 +29,37 @@ States:
   exiting  - In gt done flow
   stuck    - Self-reporting stuck (triggers witness escalation)
 
+Any process can keep a session's heartbeat fresh, so external agents and
+custom tooling can take part in liveness detection. Pass --session to target
+a session explicitly; otherwise it is detected from GT_SESSION, the GT_*
+role variables, or the current tmux session.
+
+Heartbeats are best-effort: if the runtime directory can't be created or the
+file can't be written, the command still succeeds.
+
 Examples:
   gt heartbeat --state=stuck "blocked on auth issue"
   gt heartbeat --state=idle
-  gt heartbeat --state=working`,
+  gt heartbeat --state=working
+  gt heartbeat --session=gt-toast      # From a script outside the session`,
 	RunE: runHeartbeat,
 }
 
-var heartbeatState string
+var (
+	heartbeatState   string
+	heartbeatSession string
+)
 
 func init() {
 	rootCmd.AddCommand(heartbeatCmd)
 	heartbeatCmd.Flags().StringVar(&heartbeatState, "state", "working", "Agent state (working, idle, exiting, stuck)")
+	heartbeatCmd.Flags().StringVar(&heartbeatSession, "session", "", "Session to heartbeat (default: auto-detect)")
 }
 
 func runHeartbeat(cmd *cobra.Command, args []string) error {
-	sessionName := os.Getenv("GT_SESSION")
+	sessionName := resolveHeartbeatSession(heartbeatSession)
 	if sessionName == "" {
-		return fmt.Errorf("GT_SESSION not set (not running in a Gas Town session)")
+		return fmt.Errorf("could not detect session (not running in a Gas Town session); pass --session")
 	}
 
 	townRoot, err := workspace.FindFromCwd()
@@ -71,7 +84,8 @@ func runHeartbeat(cmd *cobra.Command, args []string) error {
 
 	// Deacon liveness has extra stores beyond session heartbeat. Keep the
 	// generic heartbeat command and `gt deacon heartbeat` on one shared path.
-	if os.Getenv("GT_ROLE") == "deacon" {
+	// An explicit --session targets some other session, not this deacon.
+	if heartbeatSession == "" && os.Getenv("GT_ROLE") == "deacon" {
 		if err := syncDeaconHeartbeatStores(townRoot, context); err != nil {
 			fmt.Printf("warning: failed to touch deacon heartbeat file: %v\n", err)
 		}
@@ -81,6 +95,22 @@ func runHeartbeat(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// resolveHeartbeatSession returns the session to heartbeat: the explicit
+// --session value, then GT_SESSION, then the name derived from GT_* role
+// variables, then the current tmux session. Returns "" if none apply.
+func resolveHeartbeatSession(explicit string) string {
+	if explicit != "" {
+		return explicit
+	}
+	if sessionName := os.Getenv("GT_SESSION"); sessionName != "" {
+		return sessionName
+	}
+	if sessionName := deriveSessionName(); sessionName != "" {
+		return sessionName
+	}
+	return detectCurrentTmuxSession()
+}
+
 // deaconBeadHeartbeatSyncThreshold throttles agent-bead label refreshes from
 // gt heartbeat: each refresh is a Dolt commit, so only sync when the label is
 // stale enough to matter to watchers.
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestResolveHeartbeatSession(t *testing.T) {
+	setupCostsTestRegistry(t)
+	for _, key := range []string{"GT_SESSION", "GT_ROLE", "GT_RIG", "GT_POLECAT", "GT_CREW", "TMUX_PANE"} {
+		t.Setenv(key, "")
+	}
+
+	if got := resolveHeartbeatSession(""); got != "" {
+		t.Errorf("no env: got %q, want empty", got)
+	}
+
+	t.Setenv("GT_ROLE", "polecat")
+	t.Setenv("GT_RIG", "gastown")
+	t.Setenv("GT_POLECAT", "toast")
+	if got := resolveHeartbeatSession(""); got != "gt-toast" {
+		t.Errorf("derived from role env: got %q, want %q", got, "gt-toast")
+	}
+
+	t.Setenv("GT_SESSION", "gt-furiosa")
+	if got := resolveHeartbeatSession(""); got != "gt-furiosa" {
+		t.Errorf("GT_SESSION: got %q, want %q", got, "gt-furiosa")
+	}
+
+	if got := resolveHeartbeatSession("gt-nux"); got != "gt-nux" {
+		t.Errorf("explicit: got %q, want %q", got, "gt-nux")
+	}
+}
+
+func TestRunHeartbeat_ExplicitSession(t *testing.T) {
+	townRoot := t.TempDir()
+	mayorDir := filepath.Join(townRoot, "mayor")
+	if err := os.MkdirAll(mayorDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(mayorDir, "town.json"), []byte(`{"name":"test"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(townRoot)
+	t.Setenv("GT_SESSION", "")
+	t.Setenv("GT_ROLE", "")
+
+	oldSession, oldState := heartbeatSession, heartbeatState
+	t.Cleanup(func() { heartbeatSession, heartbeatState = oldSession, oldState })
+	heartbeatSession = "gt-external"
+	heartbeatState = "idle"
+
+	if err := runHeartbeat(heartbeatCmd, []string{"custom", "tool"}); err != nil {
+		t.Fatalf("runHeartbeat: %v", err)
+	}
+
+	hb := polecat.ReadSessionHeartbeat(townRoot, "gt-external")
+	if hb == nil {
+		t.Fatal("expected heartbeat for explicit session")
+	}
+	if hb.State != polecat.HeartbeatIdle || hb.Context != "custom tool" {
+		t.Errorf("heartbeat = %+v, want state=idle context=%q", hb, "custom tool")
+	}
+}
@@ -447,7 +447,7 @@ func closeCompletedHookedMolecule(workDir, beadID string) error {
 // - hasAttachment=true if there's an attached molecule
 func checkPinnedBeadComplete(b *beads.Beads, issue *beads.Issue) (isComplete bool, hasAttachment bool) {
 	// Check for attached molecule
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	if attachment == nil || attachment.AttachedMolecule == "" {
 		// No molecule attached - consider complete (naked bead)
 		return true, false
@@ -87,7 +87,7 @@ func runMailDirectory(cmd *cobra.Command, args []string) error {
 			if issue == nil {
 				continue
 			}
-			fields := beads.ParseQueueFields(issue.Description)
+			fields := issue.QueueFields()
 			if fields.Name == "" {
 				fmt.Fprintf(os.Stderr, "warning: queue %s has no name field, skipping\n", id)
 				continue
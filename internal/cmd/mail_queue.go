@@ -686,7 +686,7 @@ func runMailQueueList(cmd *cobra.Command, args []string) error {
 	if mailQueueJSON {
 		var output []map[string]interface{}
 		for _, issue := range queues {
-			fields := beads.ParseQueueFields(issue.Description)
+			fields := issue.QueueFields()
 			output = append(output, map[string]interface{}{
 				"id":            issue.ID,
 				"name":          fields.Name,
@@ -705,7 +705,7 @@ func runMailQueueList(cmd *cobra.Command, args []string) error {
 	// Human-readable output
 	fmt.Printf("%s Queues (%d)\n\n", style.Bold.Render("📬"), len(queues))
 	for _, issue := range queues {
-		fields := beads.ParseQueueFields(issue.Description)
+		fields := issue.QueueFields()
 		fmt.Printf("  %s\n", style.Bold.Render(fields.Name))
 		fmt.Printf("    Claimers: %s\n", fields.ClaimPattern)
 		fmt.Printf("    Status: %s\n", fields.Status)
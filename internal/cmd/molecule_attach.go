@@ -77,7 +77,7 @@ func runMoleculeAttach(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("attaching molecule: %w", err)
 	}
 
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	fmt.Printf("%s Attached %s to %s\n", style.Bold.Render("✓"), moleculeID, pinnedBeadID)
 	if attachment != nil && attachment.AttachedAt != "" {
 		fmt.Printf("  attached_at: %s\n", attachment.AttachedAt)
@@ -139,7 +139,7 @@ func runMoleculeAttachment(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("getting issue: %w", err)
 	}
 
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 
 	if moleculeJSON {
 		type attachmentOutput struct {
@@ -115,7 +115,7 @@ func runMoleculeAttachFromMail(cmd *cobra.Command, args []string) error {
 	}
 
 	// Output success
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	fmt.Printf("%s Attached molecule from mail\n", style.Bold.Render("✓"))
 	fmt.Printf("  Mail: %s\n", mailID)
 	fmt.Printf("  Hook: %s\n", hookBead.ID)
@@ -75,7 +75,7 @@ func runMoleculeBurn(cmd *cobra.Command, args []string) (retErr error) {
 	}
 
 	// Check for attached molecule
-	attachment := beads.ParseAttachmentFields(handoff)
+	attachment := handoff.Attachment()
 	if attachment == nil || attachment.AttachedMolecule == "" {
 		fmt.Printf("%s No molecule attached to %s - nothing to burn\n",
 			style.Dim.Render("ℹ"), target)
@@ -209,7 +209,7 @@ func runMoleculeSquash(cmd *cobra.Command, args []string) (retErr error) {
 	}
 
 	// Check for attached molecule
-	attachment := beads.ParseAttachmentFields(handoff)
+	attachment := handoff.Attachment()
 	if attachment == nil || attachment.AttachedMolecule == "" {
 		fmt.Printf("%s No molecule attached to %s - nothing to squash\n",
 			style.Dim.Render("ℹ"), target)
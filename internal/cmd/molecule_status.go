@@ -462,7 +462,7 @@ func runMoleculeStatus(cmd *cobra.Command, args []string) error {
 		status.PinnedBead = hookBead
 
 		// Check for attached molecule
-		attachment := beads.ParseAttachmentFields(hookBead)
+		attachment := hookBead.Attachment()
 		if attachment != nil {
 			status.AttachedMolecule = attachment.AttachedMolecule
 			status.AttachedFormula = attachment.AttachedFormula
@@ -984,7 +984,7 @@ func runMoleculeCurrent(cmd *cobra.Command, args []string) error {
 	info.HandoffTitle = handoff.Title
 
 	// Check for attached molecule
-	attachment := beads.ParseAttachmentFields(handoff)
+	attachment := handoff.Attachment()
 	if attachment == nil || attachment.AttachedMolecule == "" {
 		info.Status = "naked"
 		return outputMoleculeCurrent(info)
@@ -824,7 +824,7 @@ func findOpenMRsForIntegration(bd *beads.Beads, targetBranch string) ([]*beads.I
 func filterMRsByTarget(mrs []*beads.Issue, targetBranch string) []*beads.Issue {
 	var result []*beads.Issue
 	for _, mr := range mrs {
-		fields := beads.ParseMRFields(mr)
+		fields := mr.MRFields()
 		if fields != nil && fields.Target == targetBranch {
 			result = append(result, mr)
 		}
@@ -952,7 +952,7 @@ func runMqIntegrationStatus(cmd *cobra.Command, args []string) error {
 	// Filter by target branch and separate into merged/pending
 	var mergedMRs, pendingMRs []*beads.Issue
 	for _, mr := range allMRs {
-		fields := beads.ParseMRFields(mr)
+		fields := mr.MRFields()
 		if fields == nil {
 			continue
 		}
@@ -106,7 +106,7 @@ func runMQList(cmd *cobra.Command, args []string) error {
 		}
 
 		// Parse MR fields
-		fields := beads.ParseMRFields(issue)
+		fields := issue.MRFields()
 
 		// Filter by rig — wisps are shared across all rigs in the Dolt server,
 		// so we must filter to only show MRs belonging to this rig.
@@ -141,13 +141,17 @@ func runMQList(cmd *cobra.Command, args []string) error {
 		branchMissing, branchVerifyErr := verifyBranch(mqListVerify, gitClient, fields)
 
 		// Calculate priority score
-		score := calculateMRScore(issue, fields, now)
+		score := refinery.ScoreIssue(issue, now)
 		scored = append(scored, scoredIssue{issue: issue, fields: fields, score: score, branchMissing: branchMissing, branchVerifyErr: branchVerifyErr})
 	}
 
-	// Sort by score descending (highest priority first)
+	// Sort by score descending (highest priority first), ties by ID to match
+	// the refinery's queue order (see refinery.ScoreAndSort)
 	sort.Slice(scored, func(i, j int) bool {
-		return scored[i].score > scored[j].score
+		if scored[i].score != scored[j].score {
+			return scored[i].score > scored[j].score
+		}
+		return scored[i].issue.ID < scored[j].issue.ID
 	})
 
 	// Extract filtered issues for JSON output compatibility
@@ -370,40 +374,6 @@ func buildMQListColumns(verify bool) []style.Column {
 	return append(columns, style.Column{Name: "AGE", Width: 6, Align: style.AlignRight})
 }
 
-// calculateMRScore computes the priority score for an MR using the refinery scoring function.
-// Higher scores mean higher priority (process first).
-func calculateMRScore(issue *beads.Issue, fields *beads.MRFields, now time.Time) float64 {
-	// Parse MR creation time
-	mrCreatedAt, err := time.Parse(time.RFC3339, issue.CreatedAt)
-	if err != nil {
-		mrCreatedAt, err = time.Parse("2006-01-02T15:04:05Z", issue.CreatedAt)
-		if err != nil {
-			mrCreatedAt = now // Fallback to now if parsing fails
-		}
-	}
-
-	// Build score input
-	input := refinery.ScoreInput{
-		Priority:    issue.Priority,
-		MRCreatedAt: mrCreatedAt,
-		Now:         now,
-	}
-
-	// Add fields from MR metadata if available
-	if fields != nil {
-		input.RetryCount = fields.RetryCount
-
-		// Parse convoy created at if available
-		if fields.ConvoyCreatedAt != "" {
-			if convoyTime, err := time.Parse(time.RFC3339, fields.ConvoyCreatedAt); err == nil {
-				input.ConvoyCreatedAt = &convoyTime
-			}
-		}
-	}
-
-	return refinery.ScoreMRWithDefaults(input)
-}
-
 // branchVerifier abstracts git branch existence checks for testability.
 type branchVerifier interface {
 	BranchExists(branch string) (bool, error)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/refinery"
 	"github.com/steveyegge/gastown/internal/style"
 )
 
@@ -100,31 +101,13 @@ func runMQNext(cmd *cobra.Command, args []string) error {
 			return ti.Before(tj)
 		})
 	} else {
-		// Priority: highest score first
-		type scoredIssue struct {
-			issue *beads.Issue
-			score float64
-		}
-		scored := make([]scoredIssue, len(ready))
-		for i, issue := range ready {
-			fields := beads.ParseMRFields(issue)
-			score := calculateMRScore(issue, fields, now)
-			scored[i] = scoredIssue{issue: issue, score: score}
-		}
-
-		sort.Slice(scored, func(i, j int) bool {
-			return scored[i].score > scored[j].score
-		})
-
-		// Rebuild ready slice in sorted order
-		for i, s := range scored {
-			ready[i] = s.issue
-		}
+		// Priority: same ordering the refinery's queue uses
+		ready = refinery.ScoreAndSort(ready, now)
 	}
 
 	// Get the top MR
 	next := ready[0]
-	fields := beads.ParseMRFields(next)
+	fields := next.MRFields()
 
 	// Output based on format flags
 	if mqNextQuiet {
@@ -139,7 +122,7 @@ func runMQNext(cmd *cobra.Command, args []string) error {
 	// Human-readable output
 	fmt.Printf("%s Next MR to process:\n\n", style.Bold.Render("🎯"))
 
-	score := calculateMRScore(next, fields, now)
+	score := refinery.ScoreIssue(next, now)
 
 	fmt.Printf("  ID:       %s\n", next.ID)
 	fmt.Printf("  Score:    %.1f\n", score)
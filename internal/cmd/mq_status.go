@@ -71,7 +71,7 @@ func runMqStatus(cmd *cobra.Command, args []string) error {
 	}
 
 	// Parse MR-specific fields from description
-	mrFields := beads.ParseMRFields(issue)
+	mrFields := issue.MRFields()
 
 	// Build output structure
 	output := MRStatusOutput{
@@ -150,27 +150,27 @@ func runMqSubmit(cmd *cobra.Command, args []string) error {
 	sourceIssue := sourceInfo.Issue
 
 	// Determine target branch
-	// Priority: explicit --epic > formula_vars base_branch > integration branch auto-detect > rig default.
+	// Priority: explicit --epic > formula_vars target_branch > formula_vars base_branch >
+	// integration branch auto-detect > rig default.
 	target := defaultBranch
 	if mqSubmitEpic != "" {
 		// Explicit --epic flag: read stored branch name, fall back to template
 		rigPath := filepath.Join(townRoot, rigName)
 		target = resolveIntegrationBranchName(sourceBD, rigPath, mqSubmitEpic)
 	} else {
-		// Check for explicit --base-branch override in formula vars on the source issue.
-		// When gt sling dispatches with --base-branch, the value is persisted in
-		// the bead's formula_vars field. Without this check, MRs created via
-		// gt mq submit always target the rig's default branch (usually main),
-		// even when the polecat was working against a feature branch.
-		if af := beads.ParseAttachmentFields(sourceIssue); af != nil {
-			if bb := extractFormulaVar(af.FormulaVars, "base_branch"); bb != "" && bb != defaultBranch {
-				target = bb
-				fmt.Printf("  Target branch override: %s (from formula_vars)\n", target)
-			}
+		// Check for explicit --target-branch / --base-branch overrides in formula
+		// vars on the source issue. When gt sling dispatches with either flag,
+		// the value is persisted in the bead's formula_vars field. Without this
+		// check, MRs created via gt mq submit always target the rig's default
+		// branch (usually main), even when the polecat was working against a
+		// feature branch.
+		if bb := mqSubmitFormulaTarget(sourceIssue, defaultBranch); bb != "" {
+			target = bb
+			fmt.Printf("  Target branch override: %s (from formula_vars)\n", target)
 		}
 
 		// Auto-detect: check if source issue has a parent epic with an integration branch
-		// Only if no explicit base_branch was found above
+		// Only if no explicit target_branch or base_branch was found above
 		if target == defaultBranch {
 			refineryEnabled := true
 			rigPath := filepath.Join(townRoot, rigName)
@@ -360,7 +360,7 @@ func verifyMQSubmitPushedBranch(g *git.Git, branch, commitSHA string) error {
 // incomplete steps if any prerequisites are not yet done.
 func checkMoleculeStepDeps(bd *beads.Beads, sourceIssue *beads.Issue) error {
 	// Check if issue has an attached molecule
-	fields := beads.ParseAttachmentFields(sourceIssue)
+	fields := sourceIssue.Attachment()
 	if fields == nil || fields.AttachedMolecule == "" {
 		return nil // No molecule attached — no enforcement needed
 	}
@@ -496,3 +496,14 @@ Please verify state and execute lifecycle action.
 		}
 	}
 }
+
+// mqSubmitFormulaTarget returns the MR target recorded in the source issue's
+// formula_vars at sling time, using the same precedence as gt done: see
+// formulaVarsMRTarget. Returns "" when nothing overrides defaultBranch.
+func mqSubmitFormulaTarget(sourceIssue *beads.Issue, defaultBranch string) string {
+	af := sourceIssue.Attachment()
+	if af == nil {
+		return ""
+	}
+	return formulaVarsMRTarget(af.FormulaVars, defaultBranch)
+}
@@ -211,3 +211,25 @@ func TestValidateMoleculePrereqs(t *testing.T) {
 		})
 	}
 }
+
+func TestMQSubmitFormulaTargetUsesSlingTargetBranch(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		want        string
+	}{
+		{"no attachment", "plain issue", ""},
+		{"target branch", "attached_formula: mol-polecat-work\nformula_vars: target_branch=release/v2", "release/v2"},
+		{"target wins over base", "attached_formula: mol-polecat-work\nformula_vars: base_branch=develop\nformula_vars: target_branch=release/v2", "release/v2"},
+		{"base branch only", "attached_formula: mol-polecat-work\nformula_vars: base_branch=develop", "develop"},
+		{"target is default", "attached_formula: mol-polecat-work\nformula_vars: target_branch=main", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := &beads.Issue{ID: "gt-1", Description: tt.description}
+			if got := mqSubmitFormulaTarget(issue, "main"); got != tt.want {
+				t.Errorf("mqSubmitFormulaTarget() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -550,6 +550,12 @@ func TestNudgeDogTargetRoutesToDogSession(t *testing.T) {
 
 	logPath := filepath.Join(t.TempDir(), "nudge.log")
 	t.Setenv("GT_TEST_NUDGE_LOG", logPath)
+	// Run from a scratch town so the nudge is logged there, not in the tree.
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(townRoot)
 
 	nudgeModeFlag = NudgeModeImmediate
 	nudgePriorityFlag = nudge.PriorityNormal
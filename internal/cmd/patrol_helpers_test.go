@@ -61,6 +61,45 @@ func TestBuildWitnessPatrolVars_InjectsRigAndPrefix(t *testing.T) {
 	}
 }
 
+func TestBuildPatrolVars_WispGCAgeOnlyWhenConfigured(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, "testrig"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	ctx := RoleContext{TownRoot: tmpDir, Rig: "testrig"}
+
+	// Without wisp_reaper.max_age the formula default (1h) must stand.
+	for _, v := range append(buildWitnessPatrolVars(ctx), buildRefineryPatrolVars(ctx)...) {
+		if strings.HasPrefix(v, "wisp_gc_age=") {
+			t.Fatalf("unexpected %q without wisp_reaper.max_age configured", v)
+		}
+	}
+
+	mayorDir := filepath.Join(tmpDir, "mayor")
+	if err := os.MkdirAll(mayorDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	cfg := `{"type":"daemon-patrol-config","version":1,"patrols":{"wisp_reaper":{"enabled":true,"max_age":"6h"}}}`
+	if err := os.WriteFile(filepath.Join(mayorDir, "daemon.json"), []byte(cfg), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, vars := range map[string][]string{
+		"witness":  buildWitnessPatrolVars(ctx),
+		"refinery": buildRefineryPatrolVars(ctx),
+	} {
+		found := false
+		for _, v := range vars {
+			if v == "wisp_gc_age=6h" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s vars = %v, want wisp_gc_age=6h from wisp_reaper.max_age", name, vars)
+		}
+	}
+}
+
 func TestBuildRefineryPatrolVars_NilContext(t *testing.T) {
 	ctx := RoleContext{}
 	vars := buildRefineryPatrolVars(ctx)
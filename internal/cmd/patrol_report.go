@@ -16,6 +16,8 @@ import (
 var (
 	patrolReportSummary string
 	patrolReportSteps   string
+	patrolReportStatus  bool
+	patrolReportDryRun  bool
 )
 
 var patrolReportCmd = &cobra.Command{
@@ -32,54 +34,55 @@ The summary is stored on the patrol root wisp for audit purposes.
 The --steps flag records which patrol steps were executed vs skipped,
 making shortcutting visible in the ledger.
 
+Use --status to show the current patrol wisp and its age without cycling
+it, or --dry-run to print what report would close and start. Both are
+read-only and useful for diagnosing stale wisps left by abnormal exits.
+
 Examples:
   gt patrol report --summary "All clear, no issues" --steps "heartbeat:OK,inbox-check:OK,health-scan:OK"
-  gt patrol report --summary "Dolt latency elevated, filed escalation"`,
+  gt patrol report --summary "Dolt latency elevated, filed escalation"
+  gt patrol report --status
+  gt patrol report --summary "All clear" --dry-run`,
 	RunE: runPatrolReport,
 }
 
 func init() {
 	patrolReportCmd.Flags().StringVar(&patrolReportSummary, "summary", "", "Brief summary of patrol observations (required)")
 	patrolReportCmd.Flags().StringVar(&patrolReportSteps, "steps", "", "Step audit: comma-separated step:STATUS pairs (e.g., heartbeat:OK,inbox-check:OK)")
-	_ = patrolReportCmd.MarkFlagRequired("summary")
+	patrolReportCmd.Flags().BoolVar(&patrolReportStatus, "status", false, "Show the current patrol wisp and its age without cycling it")
+	patrolReportCmd.Flags().BoolVar(&patrolReportDryRun, "dry-run", false, "Show what report would close and start without doing it")
+	patrolReportCmd.MarkFlagsMutuallyExclusive("status", "dry-run")
 }
 
 func runPatrolReport(cmd *cobra.Command, args []string) error {
+	// --summary is required unless only inspecting. Checked here rather than
+	// via MarkFlagRequired so --status works on its own.
+	if !patrolReportStatus && patrolReportSummary == "" {
+		return fmt.Errorf(`required flag(s) "summary" not set`)
+	}
+
 	// Resolve role
 	roleInfo, err := GetRole()
 	if err != nil {
 		return fmt.Errorf("detecting role: %w", err)
 	}
 
-	roleName := string(roleInfo.Role)
+	cfg, err := patrolReportConfig(roleInfo)
+	if err != nil {
+		return err
+	}
 
-	// Build config based on role
-	var cfg PatrolConfig
-	switch roleInfo.Role {
-	case RoleDeacon:
-		cfg = PatrolConfig{
-			RoleName:      "deacon",
-			PatrolMolName: constants.MolDeaconPatrol,
-			BeadsDir:      roleInfo.TownRoot,
-			Assignee:      "deacon",
+	if patrolReportStatus || patrolReportDryRun {
+		wisps, err := inspectPatrolWisps(cfg)
+		if err != nil {
+			return fmt.Errorf("inspecting patrol wisps: %w", err)
 		}
-	case RoleWitness:
-		cfg = PatrolConfig{
-			RoleName:      "witness",
-			PatrolMolName: constants.MolWitnessPatrol,
-			BeadsDir:      roleInfo.TownRoot,
-			Assignee:      roleInfo.Rig + "/witness",
-		}
-	case RoleRefinery:
-		cfg = PatrolConfig{
-			RoleName:      "refinery",
-			PatrolMolName: constants.MolRefineryPatrol,
-			BeadsDir:      roleInfo.TownRoot,
-			Assignee:      roleInfo.Rig + "/refinery",
-			ExtraVars:     buildRefineryPatrolVars(roleInfo),
+		if patrolReportStatus {
+			printPatrolReportStatus(os.Stdout, cfg, wisps)
+			return nil
 		}
-	default:
-		return fmt.Errorf("unsupported role for patrol report: %q", roleName)
+		printPatrolReportPlan(os.Stdout, cfg, wisps, patrolReportSummary, buildStepAudit(cfg.PatrolMolName, patrolReportSteps))
+		return nil
 	}
 
 	// Find the active patrol
@@ -144,6 +147,36 @@ func runPatrolReport(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// patrolReportConfig returns the patrol configuration for the caller's role.
+func patrolReportConfig(roleInfo RoleInfo) (PatrolConfig, error) {
+	switch roleInfo.Role {
+	case RoleDeacon:
+		return PatrolConfig{
+			RoleName:      "deacon",
+			PatrolMolName: constants.MolDeaconPatrol,
+			BeadsDir:      roleInfo.TownRoot,
+			Assignee:      "deacon",
+		}, nil
+	case RoleWitness:
+		return PatrolConfig{
+			RoleName:      "witness",
+			PatrolMolName: constants.MolWitnessPatrol,
+			BeadsDir:      roleInfo.TownRoot,
+			Assignee:      roleInfo.Rig + "/witness",
+		}, nil
+	case RoleRefinery:
+		return PatrolConfig{
+			RoleName:      "refinery",
+			PatrolMolName: constants.MolRefineryPatrol,
+			BeadsDir:      roleInfo.TownRoot,
+			Assignee:      roleInfo.Rig + "/refinery",
+			ExtraVars:     buildRefineryPatrolVars(roleInfo),
+		}, nil
+	default:
+		return PatrolConfig{}, fmt.Errorf("unsupported role for patrol report: %q", string(roleInfo.Role))
+	}
+}
+
 func stampDeaconHeartbeatOnReport(townRoot, summary string) {
 	paused, _, err := deacon.IsPaused(townRoot)
 	if err != nil {
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// patrolWispInfo is a read-only snapshot of one patrol root wisp, used by
+// 'gt patrol report --status' and '--dry-run'.
+type patrolWispInfo struct {
+	ID           string
+	Title        string
+	Status       string
+	Age          time.Duration // zero when created_at is missing or unparseable
+	Children     int
+	OpenChildren int
+	Err          error // child listing failed; report would skip this wisp
+}
+
+// Active reports whether findActivePatrol would treat the wisp as the live
+// patrol. A wisp with no children yet is still materializing its steps and
+// counts as active (see checkHasOpenChildren).
+func (w patrolWispInfo) Active() bool {
+	return w.Err == nil && (w.Children == 0 || w.OpenChildren > 0)
+}
+
+// inspectPatrolWisps lists the role's patrol root wisps without modifying
+// them, in the same order findActivePatrol scans them.
+func inspectPatrolWisps(cfg PatrolConfig) ([]patrolWispInfo, error) {
+	b := cfg.Beads
+	if b == nil {
+		b = beads.New(cfg.BeadsDir)
+	}
+
+	assigned, err := listAssignedActiveWorkAcrossStatuses(b, cfg.Assignee)
+	if err != nil {
+		return nil, fmt.Errorf("listing active patrol work: %w", err)
+	}
+
+	now := time.Now()
+	var wisps []patrolWispInfo
+	for _, issue := range assigned {
+		if !strings.HasPrefix(issue.Title, cfg.PatrolMolName) {
+			continue
+		}
+		children, err := listChildrenAcrossTables(b, issue.ID)
+		wisps = append(wisps, newPatrolWispInfo(issue, children, err, now))
+	}
+	return wisps, nil
+}
+
+func newPatrolWispInfo(issue *beads.Issue, children []*beads.Issue, childErr error, now time.Time) patrolWispInfo {
+	w := patrolWispInfo{
+		ID:     issue.ID,
+		Title:  issue.Title,
+		Status: issue.Status,
+		Err:    childErr,
+	}
+	if created := parseBeadTime(issue.CreatedAt); !created.IsZero() {
+		w.Age = now.Sub(created)
+	}
+	w.Children = len(children)
+	for _, child := range children {
+		if child.Status != "closed" {
+			w.OpenChildren++
+		}
+	}
+	return w
+}
+
+// planPatrolReport mirrors findActivePatrol: it returns the active wisp (nil
+// if none) and the stale wisps scanned before it, capped at
+// maxStalePurgePerRun, which report would clean up first. Stale wisps are
+// roots whose steps are all closed, typically left by an abnormal exit.
+func planPatrolReport(wisps []patrolWispInfo) (active *patrolWispInfo, stale []patrolWispInfo) {
+	for i := range wisps {
+		w := wisps[i]
+		switch {
+		case w.Err != nil:
+			continue
+		case w.Active():
+			return &wisps[i], stale
+		case len(stale) < maxStalePurgePerRun:
+			stale = append(stale, w)
+		}
+	}
+	return nil, stale
+}
+
+func formatPatrolWispAge(w patrolWispInfo) string {
+	if w.Age == 0 {
+		return "age unknown"
+	}
+	return "age " + formatDurationAgo(w.Age)
+}
+
+// printPatrolReportStatus renders the current patrol wisps for a role.
+func printPatrolReportStatus(w io.Writer, cfg PatrolConfig, wisps []patrolWispInfo) {
+	fmt.Fprintf(w, "%s %s (%s)\n", style.Bold.Render("Patrol:"), cfg.RoleName, cfg.PatrolMolName)
+
+	if len(wisps) == 0 {
+		fmt.Fprintf(w, "  %s\n", style.Dim.Render("No open patrol wisp. 'gt patrol report' would fail; start one with 'gt patrol new'."))
+		return
+	}
+
+	active, _ := planPatrolReport(wisps)
+	for _, wisp := range wisps {
+		var state string
+		switch {
+		case wisp.Err != nil:
+			state = style.Warning.Render(fmt.Sprintf("unknown (%v)", wisp.Err))
+		case active != nil && wisp.ID == active.ID:
+			state = style.Success.Render("active")
+		case wisp.Active():
+			state = style.Warning.Render("duplicate active")
+		default:
+			state = style.Warning.Render("stale (all steps closed)")
+		}
+		fmt.Fprintf(w, "  %s  %s [%s]  %s  %d/%d steps open  %s\n",
+			wisp.ID, wisp.Title, wisp.Status, formatPatrolWispAge(wisp),
+			wisp.OpenChildren, wisp.Children, state)
+	}
+
+	if active == nil {
+		fmt.Fprintf(w, "  %s\n", style.Dim.Render("No active patrol wisp. 'gt patrol report' would fail; start one with 'gt patrol new'."))
+	}
+}
+
+// printPatrolReportPlan renders what 'gt patrol report' would do, without
+// doing it.
+func printPatrolReportPlan(w io.Writer, cfg PatrolConfig, wisps []patrolWispInfo, summary, stepAudit string) {
+	fmt.Fprintf(w, "%s gt patrol report for %s\n", style.Bold.Render("Dry run:"), cfg.RoleName)
+
+	active, stale := planPatrolReport(wisps)
+	for _, wisp := range wisps {
+		if wisp.Err != nil {
+			fmt.Fprintf(w, "  Would skip %s: could not list its steps (%v)\n", wisp.ID, wisp.Err)
+		}
+	}
+	for _, wisp := range stale {
+		fmt.Fprintf(w, "  Would close stale patrol %s (%s, all steps closed)\n", wisp.ID, formatPatrolWispAge(wisp))
+	}
+
+	if active == nil {
+		fmt.Fprintf(w, "  Would fail: no active patrol found for %s\n", cfg.RoleName)
+		return
+	}
+
+	fmt.Fprintf(w, "  Would record summary on %s: %q\n", active.ID, summary)
+	if stepAudit != "" {
+		fmt.Fprintf(w, "  %s\n", stepAudit)
+	}
+	fmt.Fprintf(w, "  Would close %d open step(s) under %s, then %s itself (%s)\n",
+		active.OpenChildren, active.ID, active.ID, formatPatrolWispAge(*active))
+	fmt.Fprintf(w, "  Would start a new %s patrol\n", cfg.PatrolMolName)
+}
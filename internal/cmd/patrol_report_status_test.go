@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestNewPatrolWispInfo(t *testing.T) {
+	now := time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC)
+	issue := &beads.Issue{
+		ID:        "hq-wisp-1",
+		Title:     "mol-deacon-patrol",
+		Status:    "hooked",
+		CreatedAt: "2026-01-15T10:00:00Z",
+	}
+	children := []*beads.Issue{
+		{ID: "a", Status: "closed"},
+		{ID: "b", Status: "open"},
+		{ID: "c", Status: "in_progress"},
+	}
+
+	w := newPatrolWispInfo(issue, children, nil, now)
+
+	if w.Age != 30*time.Minute {
+		t.Errorf("Age = %s, want 30m", w.Age)
+	}
+	if w.Children != 3 || w.OpenChildren != 2 {
+		t.Errorf("Children/OpenChildren = %d/%d, want 3/2", w.Children, w.OpenChildren)
+	}
+	if !w.Active() {
+		t.Error("wisp with open steps should be active")
+	}
+
+	noTime := newPatrolWispInfo(&beads.Issue{ID: "x"}, nil, nil, now)
+	if noTime.Age != 0 || formatPatrolWispAge(noTime) != "age unknown" {
+		t.Errorf("missing created_at should give unknown age, got %s", noTime.Age)
+	}
+}
+
+func TestPlanPatrolReport(t *testing.T) {
+	wisps := []patrolWispInfo{
+		{ID: "broken", Err: errors.New("bd timeout")},
+		{ID: "stale-1", Children: 3},
+		{ID: "live", Children: 3, OpenChildren: 1},
+		{ID: "stale-2", Children: 2},
+	}
+
+	active, stale := planPatrolReport(wisps)
+	if active == nil || active.ID != "live" {
+		t.Fatalf("active = %v, want live", active)
+	}
+	if len(stale) != 1 || stale[0].ID != "stale-1" {
+		t.Errorf("stale = %v, want only stale-1 (scan stops at the active patrol)", stale)
+	}
+
+	var many []patrolWispInfo
+	for i := 0; i < maxStalePurgePerRun+2; i++ {
+		many = append(many, patrolWispInfo{ID: "s", Children: 1})
+	}
+	active, stale = planPatrolReport(many)
+	if active != nil {
+		t.Errorf("expected no active patrol, got %v", active)
+	}
+	if len(stale) != maxStalePurgePerRun {
+		t.Errorf("stale cleanup = %d, want cap %d", len(stale), maxStalePurgePerRun)
+	}
+}
+
+func TestPrintPatrolReportStatus(t *testing.T) {
+	cfg := PatrolConfig{RoleName: "witness", PatrolMolName: "mol-witness-patrol"}
+
+	var buf bytes.Buffer
+	printPatrolReportStatus(&buf, cfg, []patrolWispInfo{
+		{ID: "gt-old", Title: "mol-witness-patrol", Status: "hooked", Age: 3 * time.Hour, Children: 4},
+		{ID: "gt-new", Title: "mol-witness-patrol", Status: "hooked", Age: 5 * time.Minute, Children: 4, OpenChildren: 2},
+	})
+	out := buf.String()
+	for _, want := range []string{"gt-old", "stale", "3 hours", "gt-new", "active", "2/4 steps open"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("status output missing %q:\n%s", want, out)
+		}
+	}
+
+	buf.Reset()
+	printPatrolReportStatus(&buf, cfg, nil)
+	if !strings.Contains(buf.String(), "gt patrol new") {
+		t.Errorf("empty status should point at gt patrol new, got:\n%s", buf.String())
+	}
+}
+
+func TestPrintPatrolReportPlan(t *testing.T) {
+	cfg := PatrolConfig{RoleName: "refinery", PatrolMolName: "mol-refinery-patrol"}
+
+	var buf bytes.Buffer
+	printPatrolReportPlan(&buf, cfg, []patrolWispInfo{
+		{ID: "gt-stale", Children: 2},
+		{ID: "gt-live", Children: 5, OpenChildren: 3},
+	}, "all clear", "Steps: NOT REPORTED (?/5)")
+	out := buf.String()
+	for _, want := range []string{
+		"Would close stale patrol gt-stale",
+		`Would record summary on gt-live: "all clear"`,
+		"Would close 3 open step(s) under gt-live",
+		"Would start a new mol-refinery-patrol patrol",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("plan output missing %q:\n%s", want, out)
+		}
+	}
+
+	buf.Reset()
+	printPatrolReportPlan(&buf, cfg, []patrolWispInfo{{ID: "gt-stale", Children: 2}}, "x", "")
+	if !strings.Contains(buf.String(), "Would fail: no active patrol found for refinery") {
+		t.Errorf("plan without active patrol should report failure, got:\n%s", buf.String())
+	}
+}
@@ -1495,7 +1495,7 @@ func recoveryTargetRefs(bd *beads.Beads, issueID, activeMR, branch string, extra
 	var refs []string
 	lookupFailed := false
 	appendMRTarget := func(issue *beads.Issue) {
-		if fields := beads.ParseMRFields(issue); fields != nil && fields.Target != "" {
+		if fields := issue.MRFields(); fields != nil && fields.Target != "" {
 			refs = append(refs, fields.Target)
 		}
 	}
@@ -1529,7 +1529,7 @@ func recoveryTargetRefs(bd *beads.Beads, issueID, activeMR, branch string, extra
 }
 
 func appendAttachmentTargets(refs *[]string, bd *beads.Beads, issue *beads.Issue) {
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	if attachment == nil {
 		return
 	}
@@ -1539,7 +1539,7 @@ func appendAttachmentTargets(refs *[]string, bd *beads.Beads, issue *beads.Issue
 	}
 	if attachment.ConvoyID != "" && bd != nil {
 		if convoy, err := bd.Show(attachment.ConvoyID); err == nil {
-			if fields := beads.ParseConvoyFields(convoy); fields != nil && fields.BaseBranch != "" {
+			if fields := convoy.ConvoyFields(); fields != nil && fields.BaseBranch != "" {
 				*refs = append(*refs, fields.BaseBranch)
 			}
 		}
@@ -1604,14 +1604,14 @@ func isMQNotRequiredSource(bd issueShower, issueID string) bool {
 	if err != nil || issue == nil {
 		return false
 	}
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	if attachment == nil {
 		return false
 	}
 	if attachment.NoMerge || attachment.ReviewOnly {
 		return true
 	}
-	return strings.EqualFold(strings.TrimSpace(attachment.MergeStrategy), "local")
+	return strings.EqualFold(strings.TrimSpace(attachment.MergeStrategy), beads.MergeStrategyLocal)
 }
 
 // applyMQCheck mutates status based on merge-queue state for the polecat's
@@ -2005,7 +2005,7 @@ func nukeCleanupMolecules(workBeadID string, r *rig.Rig) {
 		return
 	}
 
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	if attachment == nil || attachment.AttachedMolecule == "" {
 		return // No molecule attached — nothing to clean up
 	}
@@ -413,7 +413,7 @@ func (s *SpawnedPolecatInfo) StartSession() (string, error) {
 
 	// Resolve account
 	accountsPath := constants.MayorAccountsPath(townRoot)
-	claudeConfigDir, _, err := config.ResolveAccountConfigDir(accountsPath, s.account)
+	claudeConfigDir, accountHandle, err := config.ResolveAccountConfigDir(accountsPath, s.account)
 	if err != nil {
 		return "", fmt.Errorf("resolving account: %w", err)
 	}
@@ -425,6 +425,7 @@ func (s *SpawnedPolecatInfo) StartSession() (string, error) {
 	fmt.Printf("Starting session for %s/%s...\n", s.RigName, s.PolecatName)
 	startOpts := polecat.SessionStartOptions{
 		RuntimeConfigDir: claudeConfigDir,
+		Account:          accountHandle,
 		Agent:            s.agent,
 	}
 	if err := polecatSessMgr.Start(s.PolecatName, startOpts); err != nil {
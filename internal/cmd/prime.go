@@ -34,6 +34,9 @@ var primeState bool
 var primeStateJSON bool
 var primeExplain bool
 var primeStructuredSessionStartOutput bool
+var primeRole string
+var primeRig string
+var primePolecat string
 
 // Prime's external injections are best-effort; role context should still
 // return when bd/mail is slow or wedged.
@@ -99,7 +102,16 @@ HOOK MODE (--hook):
   Gemini CLI / other runtimes (in .gemini/settings.json):
     "SessionStart": "export GT_SESSION_ID=$(uuidgen) GT_HOOK_SOURCE=startup && gt prime --hook"
     "PreCompress":  "export GT_HOOK_SOURCE=compact && gt prime --hook"
-    Set GT_SESSION_ID + GT_HOOK_SOURCE as env vars to skip the stdin read entirely.`,
+    Set GT_SESSION_ID + GT_HOOK_SOURCE as env vars to skip the stdin read entirely.
+
+ROLE OVERRIDE (--role):
+  For recovery and debugging, --role skips auto-detection and primes as the
+  given role. Witness and refinery require --rig; polecat and crew require
+  --rig and --polecat (the polecat or crew member name). Session state
+  (handoff markers, checkpoints) is still read from the current directory.
+
+    gt prime --role=witness --rig=gastown --state
+    gt prime --role=polecat --rig=gastown --polecat=alpha --dry-run`,
 	RunE: runPrime,
 }
 
@@ -114,6 +126,12 @@ func init() {
 		"Output state as JSON (requires --state)")
 	primeCmd.Flags().BoolVar(&primeExplain, "explain", false,
 		"Show why each section was included")
+	primeCmd.Flags().StringVar(&primeRole, "role", "",
+		"Prime as this role instead of auto-detecting (mayor, deacon, witness, refinery, polecat, crew)")
+	primeCmd.Flags().StringVar(&primeRig, "rig", "",
+		"Rig for --role (witness, refinery, polecat, crew)")
+	primeCmd.Flags().StringVar(&primePolecat, "polecat", "",
+		"Polecat or crew member name for --role")
 	rootCmd.AddCommand(primeCmd)
 }
 
@@ -142,6 +160,14 @@ func runPrime(cmd *cobra.Command, args []string) (retErr error) {
 	if err := ensureRoleWorktreeIntegrity(cwd, townRoot, roleInfo.Role); err != nil {
 		return err
 	}
+	if primeRole != "" {
+		// Worktree integrity above was checked for the detected role, which
+		// is what governs cwd; the override only changes the context output.
+		roleInfo, err = overridePrimeRole(roleInfo, townRoot, primeRole, primeRig, primePolecat)
+		if err != nil {
+			return err
+		}
+	}
 
 	if primeHookMode {
 		handlePrimeHookMode(townRoot, cwd)
@@ -230,7 +256,7 @@ func runPrime(cmd *cobra.Command, args []string) (retErr error) {
 	explain(hasSlungWork, "Autonomous mode: hooked/in-progress work detected")
 
 	outputMoleculeContext(ctx)
-	outputCheckpointContext(ctx)
+	outputCheckpointContext(liveCheckpoint(ctx))
 	runPrimeExternalTools(ctx, cwd)
 
 	if ctx.Role == RoleMayor {
@@ -287,6 +313,15 @@ func runPrimeCompactResume(ctx RoleContext) {
 	outputSessionMetadata(ctx)
 
 	fmt.Println("\n---")
+
+	// Patrol roles resume their loop, not a task. The hooked patrol wisp is
+	// not looked up here (that would hit Dolt); the directive tells the agent
+	// how to find its current step.
+	if ctx.Role == RoleWitness || ctx.Role == RoleRefinery {
+		outputContinuationDirective(ctx, nil, true)
+		return
+	}
+
 	fmt.Println()
 	fmt.Println("**Continue your current task.** If you've lost context, run `gt prime` for full reload.")
 
@@ -310,9 +345,60 @@ func validatePrimeFlags() error {
 	if primeStateJSON && !primeState {
 		return fmt.Errorf("--json requires --state")
 	}
+	if primeRole == "" && (primeRig != "" || primePolecat != "") {
+		return fmt.Errorf("--rig and --polecat require --role")
+	}
+	if primeRole != "" {
+		if _, err := parsePrimeRoleOverride(primeRole, primeRig, primePolecat); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// parsePrimeRoleOverride validates a --role/--rig/--polecat combination.
+func parsePrimeRoleOverride(role, rig, name string) (Role, error) {
+	r := Role(strings.ToLower(strings.TrimSpace(role)))
+	switch r {
+	case RoleMayor, RoleDeacon:
+		if rig != "" || name != "" {
+			return "", fmt.Errorf("--role=%s is town-level and takes no --rig or --polecat", r)
+		}
+	case RoleWitness, RoleRefinery:
+		if rig == "" {
+			return "", fmt.Errorf("--role=%s requires --rig", r)
+		}
+		if name != "" {
+			return "", fmt.Errorf("--role=%s takes no --polecat", r)
+		}
+	case RolePolecat, RoleCrew:
+		if rig == "" || name == "" {
+			return "", fmt.Errorf("--role=%s requires --rig and --polecat", r)
+		}
+	default:
+		return "", fmt.Errorf("invalid --role %q: must be mayor, deacon, witness, refinery, polecat, or crew", role)
+	}
+	return r, nil
+}
+
+// overridePrimeRole replaces the auto-detected role with an explicit one from
+// --role, keeping the detected workspace fields.
+func overridePrimeRole(detected RoleInfo, townRoot, role, rig, name string) (RoleInfo, error) {
+	r, err := parsePrimeRoleOverride(role, rig, name)
+	if err != nil {
+		return detected, err
+	}
+	info := detected
+	info.Role = r
+	info.Rig = rig
+	info.Polecat = name
+	info.Source = "explicit"
+	info.Home = getRoleHome(r, rig, name, townRoot)
+	info.Mismatch = false
+	info.EnvIncomplete = false
+	return info, nil
+}
+
 // resolvePrimeWorkspace finds the cwd and town root for prime.
 // Returns empty townRoot (not an error) when not in a workspace and not enabled.
 func resolvePrimeWorkspace() (cwd, townRoot string, err error) {
@@ -406,7 +492,7 @@ func signalAgentReady() {
 // Without this, the new session runs full prime with AUTONOMOUS WORK MODE,
 // causing the agent to re-initialize instead of continuing. (GH#1965)
 func isCompactResume() bool {
-	return primeHookSource == "compact" || primeHookSource == "resume" || primeHandoffReason == "compaction"
+	return primeHookSource == "compact" || primeHookSource == "resume" || primeHandoffReason == HandoffReasonCompaction
 }
 
 // warnRoleMismatch outputs a prominent warning if GT_ROLE disagrees with cwd detection.
@@ -701,7 +787,7 @@ func checkSlungWork(ctx RoleContext, hookedBead *beads.Issue) (bool, error) {
 		}
 	}
 
-	attachment := beads.ParseAttachmentFields(hookedBead)
+	attachment := hookedBead.Attachment()
 	hasWorkflow := hasWorkflowAttachment(attachment)
 
 	outputAutonomousDirective(ctx, hookedBead, hasWorkflow)
@@ -1286,6 +1372,37 @@ func getAgentBeadID(ctx RoleContext) string {
 	}
 }
 
+// parseAgentBeadID is the inverse of getAgentBeadID: it reconstructs the role,
+// rig and name from an agent bead ID. Both the full form (gt-gastown-witness,
+// bd-beads-polecat-lex) and the collapsed form used when the prefix equals the
+// rig (ff-witness, ff-polecat-nux) are accepted. TownRoot and WorkDir are left
+// empty; the bead prefix is not checked against the rig's configured prefix.
+func parseAgentBeadID(id string) (RoleContext, error) {
+	rig, role, name, ok := beads.ParseAgentBeadID(id)
+	if !ok {
+		return RoleContext{}, fmt.Errorf("not an agent bead ID: %q", id)
+	}
+
+	ctx := RoleContext{Role: Role(role), Rig: rig, Polecat: name}
+	switch ctx.Role {
+	case RoleMayor, RoleDeacon:
+		if rig != "" || name != "" {
+			return RoleContext{}, fmt.Errorf("agent bead %q: %s is town-level", id, role)
+		}
+	case RoleWitness, RoleRefinery:
+		if rig == "" || name != "" {
+			return RoleContext{}, fmt.Errorf("agent bead %q: %s needs a rig and no name", id, role)
+		}
+	case RolePolecat, RoleCrew:
+		if rig == "" || name == "" {
+			return RoleContext{}, fmt.Errorf("agent bead %q: %s needs a rig and a name", id, role)
+		}
+	default:
+		return RoleContext{}, fmt.Errorf("agent bead %q: unsupported role %q", id, role)
+	}
+	return ctx, nil
+}
+
 // ensureBeadsRedirect ensures the .beads/redirect file exists for worktree-based roles.
 // This handles cases where git clean or other operations delete the redirect file.
 // Uses the shared SetupRedirect helper which handles both tracked and local beads.
@@ -1355,7 +1472,7 @@ func injectWorkContext(ctx RoleContext, hookedBead *beads.Issue) {
 	if hookedBead != nil {
 		workRig = ctx.Rig
 		workBead = hookedBead.ID
-		if attachment := beads.ParseAttachmentFields(hookedBead); attachment != nil {
+		if attachment := hookedBead.Attachment(); attachment != nil {
 			workMol = attachment.AttachedMolecule
 		}
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/steveyegge/gastown/internal/cli"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/daemon"
 	"github.com/steveyegge/gastown/internal/deacon"
 	"github.com/steveyegge/gastown/internal/formula"
 	"github.com/steveyegge/gastown/internal/refinery"
@@ -418,9 +419,21 @@ func buildWitnessPatrolVars(ctx RoleContext) []string {
 	vars = append(vars, fmt.Sprintf("rig=%s", ctx.Rig))
 	prefix := beads.GetPrefixForRig(ctx.TownRoot, ctx.Rig)
 	vars = append(vars, fmt.Sprintf("prefix=%s", prefix))
+	vars = appendWispGCAgeVar(vars, ctx.TownRoot)
 	return vars
 }
 
+// appendWispGCAgeVar adds the wisp_gc_age formula var when the town sets
+// wisp_reaper.max_age, so patrol-run `bd mol wisp gc --age` follows the
+// daemon's reaper. Unconfigured towns keep the formula default (1h).
+func appendWispGCAgeVar(vars []string, townRoot string) []string {
+	age, ok := daemon.WispGCAge(daemon.LoadPatrolConfig(townRoot))
+	if !ok {
+		return vars
+	}
+	return append(vars, "wisp_gc_age="+age)
+}
+
 // buildRefineryPatrolVars loads rig MQ settings and returns --var key=value
 // strings for the refinery patrol formula.
 func buildRefineryPatrolVars(ctx RoleContext) []string {
@@ -442,6 +455,7 @@ func buildRefineryPatrolVars(ctx RoleContext) []string {
 	}
 	vars = append(vars, fmt.Sprintf("rig=%s", ctx.Rig))
 	vars = append(vars, fmt.Sprintf("target_branch=%s", defaultBranch))
+	vars = appendWispGCAgeVar(vars, ctx.TownRoot)
 
 	// MQ-specific vars: try settings/config.json first (legacy format), then
 	// fall back to the layered rig config (bead labels / wisp layer).
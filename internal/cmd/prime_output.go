@@ -655,7 +655,7 @@ func outputAttachmentStatus(ctx RoleContext) {
 	}
 
 	// Check first pinned bead for attachment
-	attachment := beads.ParseAttachmentFields(pinnedBeads[0])
+	attachment := pinnedBeads[0].Attachment()
 	if !hasWorkflowAttachment(attachment) {
 		// No attachment - interactive mode
 		return
@@ -699,7 +699,16 @@ func outputAttachmentStatus(ctx RoleContext) {
 // outputContinuationDirective displays a brief continuation prompt for post-compact/resume.
 // Unlike outputAutonomousDirective, this does NOT ask the agent to re-announce or
 // re-run startup protocol — it just reminds the agent what's on the hook. (GH#1965)
-func outputContinuationDirective(hookedBead *beads.Issue, hasMolecule bool) {
+//
+// Witnesses and refineries resume their patrol loop rather than hooked work;
+// hookedBead may be nil for them.
+func outputContinuationDirective(ctx RoleContext, hookedBead *beads.Issue, hasMolecule bool) {
+	switch ctx.Role {
+	case RoleWitness, RoleRefinery:
+		outputPatrolContinuationDirective(ctx, hookedBead)
+		return
+	}
+
 	fmt.Println()
 	fmt.Printf("%s\n\n", style.Bold.Render("## ▶ CONTINUE HOOKED WORK"))
 	fmt.Println("Your context was compacted/resumed. **Continue working on your hooked bead.**")
@@ -713,8 +722,35 @@ func outputContinuationDirective(hookedBead *beads.Issue, hasMolecule bool) {
 	fmt.Println()
 }
 
-// outputHandoffWarning outputs the post-handoff warning message.
-func outputHandoffWarning(prevSession string) {
+// outputPatrolContinuationDirective tells a witness or refinery that crashed or
+// was compacted mid-patrol to re-enter its patrol loop at the current step.
+// The patrol wisp survives the session, so starting a fresh patrol would leave
+// a duplicate behind.
+func outputPatrolContinuationDirective(ctx RoleContext, patrolBead *beads.Issue) {
+	roleName := "Witness"
+	if ctx.Role == RoleRefinery {
+		roleName = "Refinery"
+	}
+	c := cli.Name()
+
+	fmt.Println()
+	fmt.Printf("%s\n\n", style.Bold.Render("## ▶ RESUME "+strings.ToUpper(roleName)+" PATROL"))
+	fmt.Printf("Your context was compacted/resumed mid-patrol. **Re-enter your %s patrol loop.**\n", roleName)
+	fmt.Println("Do NOT re-announce or start a new patrol — your patrol wisp is still on the hook.")
+	fmt.Println()
+	if patrolBead != nil {
+		fmt.Printf("  Patrol: %s — %s\n", style.Bold.Render(patrolBead.ID), patrolBead.Title)
+	}
+	fmt.Printf("  1. Check your patrol: `%s patrol report --status`\n", c)
+	fmt.Println("  2. Resume from the current step: `bd mol current`")
+	fmt.Printf("  3. If no patrol is active → `%s patrol new`\n", c)
+	fmt.Println()
+}
+
+// outputHandoffWarning outputs the post-handoff warning message. The reason
+// comes from the handoff marker; an "idle" handoff gets its own resume framing
+// because the predecessor left nothing in flight to recover.
+func outputHandoffWarning(prevSession, reason string) {
 	fmt.Println()
 	fmt.Println(style.Bold.Render("╔══════════════════════════════════════════════════════════════════╗"))
 	fmt.Println(style.Bold.Render("║  ✅ HANDOFF COMPLETE - You are the NEW session                   ║"))
@@ -727,6 +763,15 @@ func outputHandoffWarning(prevSession string) {
 	fmt.Println(style.Bold.Render("⚠️  DO NOT run /handoff - that was your predecessor's action."))
 	fmt.Println("   The /handoff you see in context is NOT a request for you.")
 	fmt.Println()
+	if reason == HandoffReasonIdle {
+		fmt.Println("Your predecessor was cycled because it was **idle** - not because it crashed")
+		fmt.Println("or ran out of context. There is no interrupted step to recover or redo.")
+		fmt.Println()
+		fmt.Println("Start fresh: check your hook (`" + cli.Name() + " mol status`) and mail (`" + cli.Name() + " mail inbox`)")
+		fmt.Println("for new work. If both are empty, wait for work instead of inventing tasks.")
+		fmt.Println()
+		return
+	}
 	fmt.Println("Instead: Check your hook (`" + cli.Name() + " mol status`) and mail (`" + cli.Name() + " mail inbox`).")
 	fmt.Println()
 }
@@ -767,29 +812,11 @@ func outputState(ctx RoleContext, jsonOutput bool) {
 	}
 }
 
-// outputCheckpointContext reads and displays any previous session checkpoint.
-// This enables crash recovery by showing what the previous session was working on.
-func outputCheckpointContext(ctx RoleContext) {
-	// Only applies to polecats and crew workers
-	if ctx.Role != RolePolecat && ctx.Role != RoleCrew {
-		return
-	}
-
-	// Read checkpoint
-	cp, err := checkpoint.Read(ctx.WorkDir)
-	if err != nil {
-		// Silently ignore read errors
-		return
-	}
+// outputCheckpointContext displays a previous session's live checkpoint (see
+// liveCheckpoint). This enables crash recovery by showing what the previous
+// session was working on.
+func outputCheckpointContext(cp *checkpoint.Checkpoint) {
 	if cp == nil {
-		// No checkpoint exists
-		return
-	}
-
-	// Check if checkpoint is stale (older than 24 hours)
-	if cp.IsStale(24 * time.Hour) {
-		// Remove stale checkpoint
-		_ = checkpoint.Remove(ctx.WorkDir)
 		return
 	}
 
@@ -3,6 +3,7 @@ package cmd
 import (
 	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -12,7 +13,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/checkpoint"
-	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -280,28 +280,117 @@ type SessionState struct {
 	HookedBead    string `json:"hooked_bead,omitempty"`    // for autonomous
 }
 
-// detectSessionState returns the current session state without side effects.
+// checkWorkDirInTown reports whether a worker's WorkDir lies inside its
+// TownRoot. Handoff markers and checkpoints are read from and written to
+// WorkDir, so a WorkDir outside the town means they land in the wrong place.
+// Emits an explain warning when the check fails. Non-worker roles and
+// contexts missing either path are not checked.
+func checkWorkDirInTown(ctx RoleContext) bool {
+	if ctx.Role != RolePolecat && ctx.Role != RoleCrew {
+		return true
+	}
+	if ctx.WorkDir == "" || ctx.TownRoot == "" {
+		return true
+	}
+	workDir, townRoot := ctx.WorkDir, ctx.TownRoot
+	if resolved, err := filepath.EvalSymlinks(workDir); err == nil {
+		workDir = resolved
+	}
+	if resolved, err := filepath.EvalSymlinks(townRoot); err == nil {
+		townRoot = resolved
+	}
+	rel, err := filepath.Rel(townRoot, workDir)
+	inside := err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) && !filepath.IsAbs(rel)
+	explain(!inside, fmt.Sprintf("WARNING: %s work dir %s is outside town root %s; handoff markers and checkpoints may be written to the wrong place",
+		ctx.Role, ctx.WorkDir, ctx.TownRoot))
+	return inside
+}
+
+// checkpointBeadLookup resolves a checkpoint's hooked bead. Overridable in tests.
+var checkpointBeadLookup = func(ctx RoleContext, beadID string) (*beads.Issue, error) {
+	return beads.New(beads.ResolveHookDir(ctx.TownRoot, beadID, ctx.WorkDir)).Show(beadID)
+}
+
+// reconcileCheckpoint reports whether a checkpoint still describes live work.
+// A checkpoint whose hooked bead is closed or gone was left by a session that
+// finished its work; it is cleared (except in dry-run) so prime doesn't
+// narrate crash recovery against a dead bead. Lookup failures other than
+// not-found keep the checkpoint — better a stale recovery hint than lost state.
+func reconcileCheckpoint(ctx RoleContext, cp *checkpoint.Checkpoint) bool {
+	if cp.HookedBead == "" {
+		return true
+	}
+
+	var reason string
+	issue, err := checkpointBeadLookup(ctx, cp.HookedBead)
+	switch {
+	case errors.Is(err, beads.ErrNotFound), err == nil && issue == nil:
+		reason = "no longer exists"
+	case err != nil:
+		return true
+	case beads.IssueStatus(issue.Status).IsTerminal():
+		reason = "is " + issue.Status
+	default:
+		return true
+	}
+
+	if primeDryRun {
+		explain(true, fmt.Sprintf("Crash recovery: checkpoint bead %s %s, checkpoint NOT cleared in dry-run", cp.HookedBead, reason))
+		return false
+	}
+	_ = checkpoint.Clear(ctx.WorkDir)
+	explain(true, fmt.Sprintf("Crash recovery: checkpoint bead %s %s, cleared checkpoint", cp.HookedBead, reason))
+	return false
+}
+
+// liveCheckpoint returns the worker's checkpoint if it still describes live
+// work, or nil. Stale checkpoints are removed and the rest are reconciled
+// against their hooked bead, so callers get one answer from one lookup.
+func liveCheckpoint(ctx RoleContext) *checkpoint.Checkpoint {
+	// Only applies to polecats and crew workers
+	if ctx.Role != RolePolecat && ctx.Role != RoleCrew {
+		return nil
+	}
+
+	cp, err := checkpoint.Read(ctx.WorkDir)
+	if err != nil || cp == nil {
+		return nil
+	}
+	if cp.IsStale(24 * time.Hour) {
+		_ = checkpoint.Remove(ctx.WorkDir)
+		return nil
+	}
+	if !reconcileCheckpoint(ctx, cp) {
+		return nil
+	}
+	return cp
+}
+
+// detectSessionState returns the current session state. Its only side effect
+// is clearing a checkpoint that is stale or whose hooked bead is finished (see
+// liveCheckpoint).
 func detectSessionState(ctx RoleContext) SessionState {
 	state := SessionState{
 		State: "normal",
 		Role:  ctx.Role,
 	}
 
+	checkWorkDirInTown(ctx)
+
 	// Check for handoff marker (post-handoff state)
-	markerPath := filepath.Join(ctx.WorkDir, constants.DirRuntime, constants.FileHandoffMarker)
-	if data, err := os.ReadFile(markerPath); err == nil {
+	if marker, err := readHandoffMarker(ctx.WorkDir); !errors.Is(err, os.ErrNotExist) {
 		state.State = "post-handoff"
-		state.PrevSession = strings.TrimSpace(string(data))
+		if marker != nil {
+			state.PrevSession = marker.SessionID
+		}
 		return state
 	}
 
 	// Check for checkpoint (crash-recovery state) - only for polecat/crew
-	if ctx.Role == RolePolecat || ctx.Role == RoleCrew {
-		if cp, err := checkpoint.Read(ctx.WorkDir); err == nil && cp != nil && !cp.IsStale(24*time.Hour) {
-			state.State = "crash-recovery"
-			state.CheckpointAge = cp.Age().Round(time.Minute).String()
-			return state
-		}
+	if cp := liveCheckpoint(ctx); cp != nil {
+		state.State = "crash-recovery"
+		state.CheckpointAge = cp.Age().Round(time.Minute).String()
+		return state
 	}
 
 	// Check for hooked work (autonomous state).
@@ -365,51 +454,48 @@ func detectSessionState(ctx RoleContext) SessionState {
 // and incorrectly runs it again. The marker tells the new session: "handoff is DONE,
 // the /handoff you see in context was from YOUR PREDECESSOR, not a request for you."
 //
-// The marker format is: "session_id\nreason" (reason is optional, on second line).
-// When present, the reason is stored in primeHandoffReason for compact/resume detection.
-// This enables compaction-triggered handoff cycles to route through the lighter
-// compact/resume path instead of full re-initialization. (GH#1965)
+// The marker carries the predecessor's session ID and an optional reason (see
+// HandoffMarker). The reason is stored in primeHandoffReason for compact/resume
+// detection. This enables compaction-triggered handoff cycles to route through
+// the lighter compact/resume path instead of full re-initialization. (GH#1965)
+// A marker that exists but cannot be parsed still counts as post-handoff.
 func checkHandoffMarker(workDir string) {
-	markerPath := filepath.Join(workDir, constants.DirRuntime, constants.FileHandoffMarker)
-	data, err := os.ReadFile(markerPath)
-	if err != nil {
+	marker, err := readHandoffMarker(workDir)
+	if errors.Is(err, os.ErrNotExist) {
 		// No marker = not post-handoff, normal startup
 		return
 	}
-
-	// Parse marker: first line is session ID, optional second line is reason
-	lines := strings.SplitN(strings.TrimSpace(string(data)), "\n", 2)
-	prevSession := strings.TrimSpace(lines[0])
-	if len(lines) > 1 {
-		primeHandoffReason = strings.TrimSpace(lines[1])
+	if marker == nil {
+		marker = &HandoffMarker{}
+	}
+	if marker.Reason != "" {
+		primeHandoffReason = marker.Reason
 	}
 
 	// Remove the marker FIRST so we don't warn twice
-	_ = os.Remove(markerPath)
+	_ = os.Remove(handoffMarkerPath(workDir))
 
 	// Output prominent warning
-	outputHandoffWarning(prevSession)
+	outputHandoffWarning(marker.SessionID, marker.Reason)
 }
 
 // checkHandoffMarkerDryRun checks for handoff marker without removing it (for --dry-run).
 func checkHandoffMarkerDryRun(workDir string) {
-	markerPath := filepath.Join(workDir, constants.DirRuntime, constants.FileHandoffMarker)
-	data, err := os.ReadFile(markerPath)
-	if err != nil {
+	marker, err := readHandoffMarker(workDir)
+	if errors.Is(err, os.ErrNotExist) {
 		// No marker = not post-handoff, normal startup
 		explain(true, "Post-handoff: no handoff marker found")
 		return
 	}
-
-	// Parse marker: first line is session ID, optional second line is reason
-	lines := strings.SplitN(strings.TrimSpace(string(data)), "\n", 2)
-	prevSession := strings.TrimSpace(lines[0])
-	if len(lines) > 1 {
-		primeHandoffReason = strings.TrimSpace(lines[1])
+	if marker == nil {
+		marker = &HandoffMarker{}
+	}
+	if marker.Reason != "" {
+		primeHandoffReason = marker.Reason
 	}
 
-	explain(true, fmt.Sprintf("Post-handoff: marker found (predecessor: %s, reason: %s), marker NOT removed in dry-run", prevSession, primeHandoffReason))
+	explain(true, fmt.Sprintf("Post-handoff: marker found (predecessor: %s, reason: %s), marker NOT removed in dry-run", marker.SessionID, primeHandoffReason))
 
 	// Output the warning but don't remove marker
-	outputHandoffWarning(prevSession)
+	outputHandoffWarning(marker.SessionID, marker.Reason)
 }
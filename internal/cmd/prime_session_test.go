@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 )
@@ -100,3 +101,36 @@ func TestReadHookSessionID_AutoGeneratesFallback(t *testing.T) {
 		t.Errorf("auto-generated id %q doesn't look like a UUID (len=%d)", id, len(id))
 	}
 }
+
+// TestCheckWorkDirInTown_OutsideTownWarns verifies a worker whose WorkDir lies
+// outside TownRoot fails the check and emits an explain warning.
+func TestCheckWorkDirInTown_OutsideTownWarns(t *testing.T) {
+	townRoot := t.TempDir()
+	outside := t.TempDir()
+
+	oldExplain := primeExplain
+	primeExplain = true
+	defer func() { primeExplain = oldExplain }()
+
+	var ok bool
+	output := captureStdout(t, func() {
+		ok = checkWorkDirInTown(RoleContext{Role: RolePolecat, Rig: "gastown", Polecat: "nux", TownRoot: townRoot, WorkDir: outside})
+	})
+	if ok {
+		t.Error("checkWorkDirInTown() = true for WorkDir outside TownRoot, want false")
+	}
+	if !strings.Contains(output, "outside town root") {
+		t.Errorf("expected explain warning, got: %q", output)
+	}
+
+	inside := filepath.Join(townRoot, "gastown", "polecats", "nux")
+	if err := os.MkdirAll(inside, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	output = captureStdout(t, func() {
+		ok = checkWorkDirInTown(RoleContext{Role: RolePolecat, Rig: "gastown", Polecat: "nux", TownRoot: townRoot, WorkDir: inside})
+	})
+	if !ok || output != "" {
+		t.Errorf("checkWorkDirInTown() inside town = %v (output %q), want true with no warning", ok, output)
+	}
+}
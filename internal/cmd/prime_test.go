@@ -151,6 +151,57 @@ func TestGetAgentBeadID_UsesRigPrefix(t *testing.T) {
 	}
 }
 
+func TestParseAgentBeadID_RoundTrip(t *testing.T) {
+	townRoot := t.TempDir()
+	writeTestRoutes(t, townRoot, []beads.Route{
+		{Prefix: "bd-", Path: "beads/mayor/rig"},
+		{Prefix: "ff-", Path: "ff/mayor/rig"},
+		{Prefix: "mr-", Path: "my-rig/mayor/rig"},
+	})
+
+	ids := []string{
+		"hq-mayor",
+		"hq-deacon",
+		"bd-beads-witness",
+		"bd-beads-refinery",
+		"bd-beads-polecat-lex",
+		"bd-beads-crew-lex",
+		// Collapsed form: prefix == rig.
+		"ff-witness",
+		"ff-refinery",
+		"ff-polecat-nux",
+		"ff-crew-max",
+		// Hyphenated rig and a worker named like a role.
+		"mr-my-rig-polecat-witness",
+	}
+
+	for _, id := range ids {
+		t.Run(id, func(t *testing.T) {
+			ctx, err := parseAgentBeadID(id)
+			if err != nil {
+				t.Fatalf("parseAgentBeadID(%q): %v", id, err)
+			}
+			ctx.TownRoot = townRoot
+			if got := getAgentBeadID(ctx); got != id {
+				t.Fatalf("getAgentBeadID(parseAgentBeadID(%q)) = %q (ctx %+v)", id, got, ctx)
+			}
+		})
+	}
+}
+
+func TestParseAgentBeadID_Rejects(t *testing.T) {
+	for _, id := range []string{
+		"gt-abc123",    // ordinary issue
+		"gt-dog-alpha", // dogs have no getAgentBeadID mapping
+		"bd-beads-polecat",
+		"x",
+	} {
+		if ctx, err := parseAgentBeadID(id); err == nil {
+			t.Errorf("parseAgentBeadID(%q) = %+v, want error", id, ctx)
+		}
+	}
+}
+
 func TestRigBeadsRootPrefersRouteResolvedRigDir(t *testing.T) {
 	townRoot := t.TempDir()
 	writeTestRoutes(t, townRoot, []beads.Route{
@@ -327,6 +378,14 @@ func TestCheckHandoffMarkerDryRun_NoMarker(t *testing.T) {
 	}
 }
 
+// stubCheckpointBeadLookup replaces the checkpoint hooked-bead lookup for a test.
+func stubCheckpointBeadLookup(t *testing.T, issue *beads.Issue, err error) {
+	t.Helper()
+	old := checkpointBeadLookup
+	checkpointBeadLookup = func(RoleContext, string) (*beads.Issue, error) { return issue, err }
+	t.Cleanup(func() { checkpointBeadLookup = old })
+}
+
 // TestDetectSessionState tests detectSessionState for all states.
 func TestDetectSessionState(t *testing.T) {
 	t.Run("normal_state", func(t *testing.T) {
@@ -378,6 +437,7 @@ func TestDetectSessionState(t *testing.T) {
 	})
 
 	t.Run("crash_recovery_state", func(t *testing.T) {
+		stubCheckpointBeadLookup(t, &beads.Issue{ID: "bd-test123", Status: "hooked"}, nil)
 		workDir := t.TempDir()
 
 		// Create a checkpoint (simulating a crashed session)
@@ -408,6 +468,71 @@ func TestDetectSessionState(t *testing.T) {
 		}
 	})
 
+	t.Run("crash_recovery_open_bead", func(t *testing.T) {
+		stubCheckpointBeadLookup(t, &beads.Issue{ID: "bd-open1", Status: "in_progress"}, nil)
+		workDir := t.TempDir()
+
+		cp := &checkpoint.Checkpoint{
+			HookedBead: "bd-open1",
+			Timestamp:  time.Now().Add(-1 * time.Hour),
+		}
+		if err := checkpoint.Write(workDir, cp); err != nil {
+			t.Fatalf("write checkpoint: %v", err)
+		}
+
+		ctx := RoleContext{Role: RolePolecat, Rig: "beads", Polecat: "jade", WorkDir: workDir}
+		state := detectSessionState(ctx)
+
+		if state.State != "crash-recovery" {
+			t.Fatalf("expected state 'crash-recovery', got %q", state.State)
+		}
+		if cp, _ := checkpoint.Read(workDir); cp == nil {
+			t.Fatal("checkpoint for open bead should be kept")
+		}
+	})
+
+	for _, tc := range []struct {
+		name  string
+		issue *beads.Issue
+		err   error
+	}{
+		{name: "closed", issue: &beads.Issue{ID: "bd-done1", Status: "closed"}},
+		{name: "missing", err: beads.ErrNotFound},
+	} {
+		t.Run("crash_recovery_cleared_when_bead_"+tc.name, func(t *testing.T) {
+			stubCheckpointBeadLookup(t, tc.issue, tc.err)
+			workDir := t.TempDir()
+
+			cp := &checkpoint.Checkpoint{
+				HookedBead: "bd-done1",
+				Timestamp:  time.Now().Add(-1 * time.Hour),
+			}
+			if err := checkpoint.Write(workDir, cp); err != nil {
+				t.Fatalf("write checkpoint: %v", err)
+			}
+
+			oldExplain := primeExplain
+			primeExplain = true
+			t.Cleanup(func() { primeExplain = oldExplain })
+
+			ctx := RoleContext{Role: RolePolecat, Rig: "beads", Polecat: "jade", WorkDir: workDir}
+			var state SessionState
+			output := captureStdout(t, func() {
+				state = detectSessionState(ctx)
+			})
+
+			if state.State != "normal" {
+				t.Fatalf("expected state 'normal', got %q", state.State)
+			}
+			if cp, _ := checkpoint.Read(workDir); cp != nil {
+				t.Fatal("checkpoint for finished bead should be cleared")
+			}
+			if !strings.Contains(output, "cleared checkpoint") {
+				t.Errorf("expected explain note about cleared checkpoint, got: %s", output)
+			}
+		})
+	}
+
 	t.Run("crash_recovery_only_for_workers", func(t *testing.T) {
 		workDir := t.TempDir()
 
@@ -503,6 +628,57 @@ func TestDetectSessionState(t *testing.T) {
 	})
 }
 
+func TestLiveCheckpoint(t *testing.T) {
+	t.Run("open_bead_looked_up_once", func(t *testing.T) {
+		lookups := 0
+		old := checkpointBeadLookup
+		checkpointBeadLookup = func(RoleContext, string) (*beads.Issue, error) {
+			lookups++
+			return &beads.Issue{ID: "bd-open1", Status: "in_progress"}, nil
+		}
+		t.Cleanup(func() { checkpointBeadLookup = old })
+
+		workDir := t.TempDir()
+		if err := checkpoint.Write(workDir, &checkpoint.Checkpoint{
+			HookedBead: "bd-open1",
+			Timestamp:  time.Now().Add(-1 * time.Hour),
+		}); err != nil {
+			t.Fatalf("write checkpoint: %v", err)
+		}
+
+		ctx := RoleContext{Role: RolePolecat, Rig: "beads", Polecat: "jade", WorkDir: workDir}
+		output := captureStdout(t, func() {
+			outputCheckpointContext(liveCheckpoint(ctx))
+		})
+
+		if lookups != 1 {
+			t.Errorf("hooked bead looked up %d times, want 1", lookups)
+		}
+		if !strings.Contains(output, "bd-open1") {
+			t.Errorf("expected checkpoint context for bd-open1, got: %s", output)
+		}
+	})
+
+	t.Run("stale_checkpoint_removed", func(t *testing.T) {
+		stubCheckpointBeadLookup(t, &beads.Issue{ID: "bd-open1", Status: "in_progress"}, nil)
+		workDir := t.TempDir()
+		if err := checkpoint.Write(workDir, &checkpoint.Checkpoint{
+			HookedBead: "bd-open1",
+			Timestamp:  time.Now().Add(-48 * time.Hour),
+		}); err != nil {
+			t.Fatalf("write checkpoint: %v", err)
+		}
+
+		ctx := RoleContext{Role: RoleCrew, Rig: "beads", Polecat: "jade", WorkDir: workDir}
+		if cp := liveCheckpoint(ctx); cp != nil {
+			t.Fatalf("liveCheckpoint = %+v, want nil for stale checkpoint", cp)
+		}
+		if cp, _ := checkpoint.Read(workDir); cp != nil {
+			t.Fatal("stale checkpoint should be removed")
+		}
+	})
+}
+
 // TestOutputState tests outputState function output formats.
 func TestOutputState(t *testing.T) {
 	t.Run("text_output", func(t *testing.T) {
@@ -762,6 +938,37 @@ func TestIsCompactResume(t *testing.T) {
 	}
 }
 
+// TestOutputHandoffWarning_IdleReason verifies an idle handoff gets its own
+// resume framing, distinct from a plain handoff and a compaction cycle.
+func TestOutputHandoffWarning_IdleReason(t *testing.T) {
+	outputs := make(map[string]string)
+	for _, reason := range []string{"", "compaction", "idle"} {
+		outputs[reason] = captureStdout(t, func() {
+			outputHandoffWarning("gt-crew-max", reason)
+		})
+	}
+
+	idle := outputs["idle"]
+	if !strings.Contains(idle, "**idle**") || !strings.Contains(idle, "no interrupted step") {
+		t.Errorf("idle handoff output lacks idle framing:\n%s", idle)
+	}
+	for _, reason := range []string{"", "compaction"} {
+		out := outputs[reason]
+		if out == idle {
+			t.Errorf("reason %q output is identical to idle output", reason)
+		}
+		if strings.Contains(out, "**idle**") {
+			t.Errorf("reason %q output mentions idle:\n%s", reason, out)
+		}
+	}
+	// Every handoff still warns against re-running /handoff.
+	for reason, out := range outputs {
+		if !strings.Contains(out, "DO NOT run /handoff") || !strings.Contains(out, "gt-crew-max") {
+			t.Errorf("reason %q output missing handoff warning or predecessor:\n%s", reason, out)
+		}
+	}
+}
+
 func TestHookSessionBeaconLines(t *testing.T) {
 	origStructured := primeStructuredSessionStartOutput
 	defer func() {
@@ -905,7 +1112,7 @@ func TestOutputContinuationDirective(t *testing.T) {
 			Title: "Test bead title",
 		}
 		output := captureStdout(t, func() {
-			outputContinuationDirective(bead, false)
+			outputContinuationDirective(RoleContext{Role: RolePolecat}, bead, false)
 		})
 
 		// Should contain continuation directive
@@ -931,13 +1138,46 @@ func TestOutputContinuationDirective(t *testing.T) {
 			Title: "Molecule bead",
 		}
 		output := captureStdout(t, func() {
-			outputContinuationDirective(bead, true)
+			outputContinuationDirective(RoleContext{Role: RolePolecat}, bead, true)
 		})
 
 		if !strings.Contains(output, "bd mol current") {
 			t.Fatalf("expected molecule hint in output, got: %s", output)
 		}
 	})
+
+	t.Run("witness_resumes_patrol", func(t *testing.T) {
+		bead := &beads.Issue{
+			ID:    "gt-wisp-w1",
+			Title: "mol-witness-patrol",
+		}
+		output := captureStdout(t, func() {
+			outputContinuationDirective(RoleContext{Role: RoleWitness, Rig: "gastown"}, bead, true)
+		})
+
+		if !strings.Contains(output, "RESUME WITNESS PATROL") {
+			t.Fatalf("expected witness resume header, got: %s", output)
+		}
+		if !strings.Contains(output, "Re-enter your Witness patrol loop") || !strings.Contains(output, "gt-wisp-w1") {
+			t.Fatalf("expected patrol re-entry with patrol wisp, got: %s", output)
+		}
+		if strings.Contains(output, "CONTINUE HOOKED WORK") {
+			t.Fatalf("witness should NOT get the polecat hooked-work text, got: %s", output)
+		}
+	})
+
+	t.Run("refinery_without_bead", func(t *testing.T) {
+		output := captureStdout(t, func() {
+			outputContinuationDirective(RoleContext{Role: RoleRefinery, Rig: "gastown"}, nil, false)
+		})
+
+		if !strings.Contains(output, "RESUME REFINERY PATROL") {
+			t.Fatalf("expected refinery resume header, got: %s", output)
+		}
+		if strings.Contains(output, "Patrol:") {
+			t.Fatalf("no patrol line expected without a bead, got: %s", output)
+		}
+	})
 }
 
 func TestCheckSlungWork_StandaloneFormulaUsesWorkflowOutput(t *testing.T) {
@@ -1152,6 +1392,25 @@ func TestCompactResumeReminder_NonPolecatNoGtDone(t *testing.T) {
 	}
 }
 
+// TestCompactResumeReminder_WitnessResumesPatrol verifies that a witness
+// resumed mid-patrol is told to re-enter its patrol loop, not continue a task.
+func TestCompactResumeReminder_WitnessResumesPatrol(t *testing.T) {
+	ctx := RoleContext{Role: RoleWitness, Rig: "gastown"}
+	primeHookSource = "resume"
+	defer func() { primeHookSource = "" }()
+
+	output := captureStdout(t, func() {
+		runPrimeCompactResume(ctx)
+	})
+
+	if !strings.Contains(output, "RESUME WITNESS PATROL") {
+		t.Fatalf("compact/resume for witness should resume the patrol, got:\n%s", output)
+	}
+	if strings.Contains(output, "Continue your current task") {
+		t.Fatalf("witness should not get the generic task continuation, got:\n%s", output)
+	}
+}
+
 func TestEnsureBeadsRedirect_WitnessCreatesRedirect(t *testing.T) {
 	townRoot := t.TempDir()
 	rigRoot := filepath.Join(townRoot, "testrig")
@@ -1443,3 +1702,66 @@ func TestErrHookUnresolvable_IsErrors(t *testing.T) {
 		t.Fatalf("errors.Is should report wrapped err matches ErrHookUnresolvable")
 	}
 }
+
+func TestParsePrimeRoleOverride(t *testing.T) {
+	tests := []struct {
+		role, rig, name string
+		want            Role
+		wantErr         string
+	}{
+		{role: "mayor", want: RoleMayor},
+		{role: "Deacon", want: RoleDeacon},
+		{role: "mayor", rig: "gastown", wantErr: "town-level"},
+		{role: "witness", rig: "gastown", want: RoleWitness},
+		{role: "refinery", wantErr: "requires --rig"},
+		{role: "witness", rig: "gastown", name: "alpha", wantErr: "takes no --polecat"},
+		{role: "polecat", rig: "gastown", name: "alpha", want: RolePolecat},
+		{role: "polecat", rig: "gastown", wantErr: "requires --rig and --polecat"},
+		{role: "crew", name: "joe", wantErr: "requires --rig and --polecat"},
+		{role: "crew", rig: "gastown", name: "joe", want: RoleCrew},
+		{role: "overseer", wantErr: "invalid --role"},
+	}
+
+	for _, tt := range tests {
+		got, err := parsePrimeRoleOverride(tt.role, tt.rig, tt.name)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parsePrimeRoleOverride(%q, %q, %q) error = %v, want containing %q", tt.role, tt.rig, tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("parsePrimeRoleOverride(%q, %q, %q) = %q, %v; want %q", tt.role, tt.rig, tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestOverridePrimeRoleReplacesDetectedContext(t *testing.T) {
+	townRoot := t.TempDir()
+	detected := RoleInfo{
+		Role:     RoleCrew,
+		Source:   "env",
+		Rig:      "otherrig",
+		Polecat:  "joe",
+		Mismatch: true,
+		TownRoot: townRoot,
+		WorkDir:  townRoot,
+	}
+
+	info, err := overridePrimeRole(detected, townRoot, "polecat", "gastown", "alpha")
+	if err != nil {
+		t.Fatalf("overridePrimeRole: %v", err)
+	}
+	if info.Role != RolePolecat || info.Rig != "gastown" || info.Polecat != "alpha" {
+		t.Errorf("override = %s %s/%s, want polecat gastown/alpha", info.Role, info.Rig, info.Polecat)
+	}
+	if info.Source != "explicit" || info.Mismatch {
+		t.Errorf("override source=%q mismatch=%v, want explicit and no mismatch", info.Source, info.Mismatch)
+	}
+	if want := getRoleHome(RolePolecat, "gastown", "alpha", townRoot); info.Home != want {
+		t.Errorf("home = %q, want %q", info.Home, want)
+	}
+	if info.WorkDir != townRoot {
+		t.Errorf("work dir = %q, want detected %q", info.WorkDir, townRoot)
+	}
+}
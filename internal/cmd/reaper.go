@@ -14,16 +14,17 @@ import (
 )
 
 var (
-	reaperDB       string
-	reaperHost     string
-	reaperPort     int
-	reaperMaxAge   string
-	reaperPurgeAge string
-	reaperMailAge  string
-	reaperStaleAge string
-	reaperDBDelay  string
-	reaperDryRun   bool
-	reaperJSON     bool
+	reaperDB         string
+	reaperHost       string
+	reaperPort       int
+	reaperMaxAge     string
+	reaperPurgeAge   string
+	reaperMailAge    string
+	reaperStaleAge   string
+	reaperDBDelay    string
+	reaperDryRun     bool
+	reaperJSON       bool
+	reaperLabelStale bool
 )
 
 func reaperDatabaseNames() []string {
@@ -405,12 +406,18 @@ Excludes P0/P1 priority, epics, and issues with active dependencies.
 When --db is provided, auto-closes in a single database. When omitted,
 auto-discovers all databases on the Dolt server and auto-closes in each one.
 
-Returns the count of closed issues. Use --dry-run to preview.`,
+Returns the count of closed issues. Use --dry-run to preview.
+
+With --label-stale, eligible issues are labeled gt:stale and left open for
+review instead of being closed. Issues already labeled are skipped.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		staleAge, err := time.ParseDuration(reaperStaleAge)
 		if err != nil {
 			return fmt.Errorf("invalid --stale-age: %w", err)
 		}
+		if reaperLabelStale {
+			return runReaperLabelStale(staleAge)
+		}
 
 		databases := reaperDatabaseNames()
 
@@ -478,6 +485,71 @@ Returns the count of closed issues. Use --dry-run to preview.`,
 	},
 }
 
+// runReaperLabelStale is the --label-stale mode of 'gt reaper auto-close'.
+func runReaperLabelStale(staleAge time.Duration) error {
+	var results []*reaper.LabelStaleResult
+	for i, dbName := range reaperDatabaseNames() {
+		if err := waitBeforeReaperDatabase(i); err != nil {
+			return err
+		}
+		if err := reaper.ValidateDBName(dbName); err != nil {
+			fmt.Fprintf(os.Stderr, "skip invalid db: %s\n", dbName)
+			continue
+		}
+
+		db, err := reaper.OpenDB(reaperHost, reaperPort, dbName, 10*time.Second, 10*time.Second)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: connect error: %v\n", dbName, err)
+			continue
+		}
+
+		if ok, err := reaper.HasReaperSchema(db); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: schema check error: %v\n", dbName, err)
+			db.Close()
+			continue
+		} else if !ok {
+			db.Close()
+			continue
+		}
+
+		result, err := reaper.LabelStale(db, dbName, staleAge, reaperDryRun)
+		db.Close()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: label error: %v\n", dbName, err)
+			continue
+		}
+		results = append(results, result)
+	}
+
+	if reaperJSON {
+		fmt.Println(reaper.FormatJSON(results))
+		return nil
+	}
+
+	var totalLabeled int
+	for _, r := range results {
+		verb := "labeled"
+		if r.DryRun {
+			verb = "[DRY RUN] would label"
+		}
+		for _, entry := range r.LabeledEntries {
+			fmt.Printf("  %s %s (%dd stale, db:%s)\n",
+				entry.ID, entry.Title, entry.AgeDays, entry.Database)
+		}
+		fmt.Printf("%s: %s %d stale issues %s\n", r.Database, verb, r.Labeled, reaper.StaleLabel)
+		totalLabeled += r.Labeled
+	}
+	if len(results) > 1 {
+		prefix := ""
+		if reaperDryRun {
+			prefix = "[DRY RUN] "
+		}
+		fmt.Printf("\n%sLabel summary (%d databases): labeled %d stale issues\n",
+			prefix, len(results), totalLabeled)
+	}
+	return nil
+}
+
 var reaperRunCmd = &cobra.Command{
 	Use:   "run",
 	Short: "Run full reaper cycle across all databases",
@@ -630,6 +702,7 @@ func init() {
 	for _, cmd := range []*cobra.Command{reaperScanCmd, reaperAutoCloseCmd, reaperRunCmd} {
 		cmd.Flags().StringVar(&reaperStaleAge, "stale-age", "720h", "Max issue staleness before auto-close (30d)")
 	}
+	reaperAutoCloseCmd.Flags().BoolVar(&reaperLabelStale, "label-stale", false, "Label stale issues "+reaper.StaleLabel+" instead of closing them")
 
 	reaperCmd.AddCommand(reaperDatabasesCmd)
 	reaperCmd.AddCommand(reaperScanCmd)
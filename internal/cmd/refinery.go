@@ -86,7 +86,10 @@ var refineryStatusCmd = &cobra.Command{
 	Long: `Show the status of a rig's Refinery.
 
 Displays running state, current work, queue length, and statistics.
-If rig is not specified, infers it from the current directory.`,
+If rig is not specified, infers it from the current directory.
+
+With --json, emits a stable object for dashboards:
+  {"running", "rig_name", "session", "queue_length", "conflict_count"}`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runRefineryStatus,
 }
@@ -360,10 +363,22 @@ func runRefineryStop(cmd *cobra.Command, args []string) error {
 
 // RefineryStatusOutput is the JSON output format for refinery status.
 type RefineryStatusOutput struct {
-	Running     bool   `json:"running"`
-	RigName     string `json:"rig_name"`
-	Session     string `json:"session,omitempty"`
-	QueueLength int    `json:"queue_length"`
+	Running       bool   `json:"running"`
+	RigName       string `json:"rig_name"`
+	Session       string `json:"session,omitempty"`
+	QueueLength   int    `json:"queue_length"`
+	ConflictCount int    `json:"conflict_count"`
+}
+
+// countConflictMRs returns how many queued MRs are blocked on conflict resolution.
+func countConflictMRs(queue []refinery.QueueItem) int {
+	n := 0
+	for _, item := range queue {
+		if item.MR != nil && item.MR.HasConflict() {
+			n++
+		}
+	}
+	return n
 }
 
 func runRefineryStatus(cmd *cobra.Command, args []string) error {
@@ -384,13 +399,16 @@ func runRefineryStatus(cmd *cobra.Command, args []string) error {
 	// Get queue from beads
 	queue, _ := mgr.Queue()
 	queueLen := len(queue)
+	conflicts := countConflictMRs(queue)
 
 	// JSON output
 	if refineryStatusJSON {
 		output := RefineryStatusOutput{
-			Running:     running,
-			RigName:     rigName,
-			QueueLength: queueLen,
+			Running:       running,
+			RigName:       rigName,
+			Session:       mgr.SessionName(),
+			QueueLength:   queueLen,
+			ConflictCount: conflicts,
 		}
 		if sessionInfo != nil {
 			output.Session = sessionInfo.Name
@@ -413,6 +431,9 @@ func runRefineryStatus(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("\n  Queue: %d pending\n", queueLen)
+	if conflicts > 0 {
+		fmt.Printf("  Conflicts: %d awaiting resolution\n", conflicts)
+	}
 
 	return nil
 }
@@ -688,7 +709,7 @@ func runRefineryUnclaimed(cmd *cobra.Command, args []string) error {
 		if issue.Assignee != "" {
 			continue
 		}
-		fields := beads.ParseMRFields(issue)
+		fields := issue.MRFields()
 		if fields == nil {
 			continue
 		}
@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
+
+	"github.com/steveyegge/gastown/internal/refinery"
 )
 
 func TestRefineryStartAgentFlag(t *testing.T) {
@@ -82,3 +85,40 @@ func TestRefineryStartForegroundFlagHidden(t *testing.T) {
 		t.Fatalf("refinery start help should not advertise --foreground:\n%s", refineryStartCmd.Long)
 	}
 }
+
+func TestCountConflictMRs(t *testing.T) {
+	queue := []refinery.QueueItem{
+		{Position: 1, MR: &refinery.MergeRequest{ID: "gt-mr1"}},
+		{Position: 2, MR: &refinery.MergeRequest{ID: "gt-mr2", ConflictTaskID: "gt-task1"}},
+		{Position: 3, MR: nil},
+		{Position: 4, MR: &refinery.MergeRequest{ID: "gt-mr3", ConflictTaskID: "gt-task2"}},
+	}
+	if got := countConflictMRs(queue); got != 2 {
+		t.Errorf("countConflictMRs() = %d, want 2", got)
+	}
+	if got := countConflictMRs(nil); got != 0 {
+		t.Errorf("countConflictMRs(nil) = %d, want 0", got)
+	}
+}
+
+func TestRefineryStatusOutputJSONKeys(t *testing.T) {
+	data, err := json.Marshal(RefineryStatusOutput{
+		Running:       true,
+		RigName:       "gastown",
+		Session:       "gt-refinery",
+		QueueLength:   3,
+		ConflictCount: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"running", "rig_name", "session", "queue_length", "conflict_count"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("status JSON missing %q: %s", key, data)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -234,15 +235,20 @@ If no rig is specified, infers the rig from the current directory.
 
 Displays:
 - Rig information (name, path, beads prefix)
+- Operational state (operational/parked/docked) and whether sling and
+  other dispatch paths will accept work for the rig, with the reason
 - Witness status (running/stopped, uptime)
 - Refinery status (running/stopped, uptime, queue size)
 - Polecats (name, state, assigned issue, session status)
 - Crew members (name, branch, session status, git status)
 
+With --json, prints only the rig information and state, without the
+worker sections.
+
 Examples:
   gt rig status           # Infer rig from current directory
   gt rig status gastown
-  gt rig status beads`,
+  gt rig status beads --json`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runRigStatus,
 }
@@ -326,6 +332,7 @@ var (
 	rigRestartForce      bool
 	rigRestartNuclear    bool
 	rigListJSON          bool
+	rigStatusJSON        bool
 	rigRemoveForce       bool
 )
 
@@ -362,6 +369,7 @@ func init() {
 	rigCmd.AddCommand(rigStopCmd)
 
 	rigListCmd.Flags().BoolVar(&rigListJSON, "json", false, "Output as JSON")
+	rigStatusCmd.Flags().BoolVar(&rigStatusJSON, "json", false, "Output rig state as JSON")
 
 	rigRemoveCmd.Flags().BoolVarP(&rigRemoveForce, "force", "f", false, "Kill running tmux sessions before removing (may lose uncommitted work)")
 
@@ -1884,25 +1892,18 @@ func runRigStatus(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	state := collectRigState(townRoot, r)
+	if rigStatusJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(state)
+	}
+
 	t := tmux.NewTmux()
 
 	// Header
 	fmt.Printf("%s\n", style.Bold.Render(rigName))
-
-	// Operational state
-	opState, opSource := getRigOperationalState(townRoot, rigName)
-	if opState == "OPERATIONAL" {
-		fmt.Printf("  Status: %s\n", style.Success.Render(opState))
-	} else if opState == "PARKED" {
-		fmt.Printf("  Status: %s (%s)\n", style.Warning.Render(opState), opSource)
-	} else if opState == "DOCKED" {
-		fmt.Printf("  Status: %s (%s)\n", style.Dim.Render(opState), opSource)
-	}
-
-	fmt.Printf("  Path: %s\n", r.Path)
-	if r.Config != nil && r.Config.Prefix != "" {
-		fmt.Printf("  Beads prefix: %s-\n", r.Config.Prefix)
-	}
+	printRigState(os.Stdout, state)
 	fmt.Println()
 
 	// --- Parallel data gathering phase ---
@@ -2341,19 +2342,72 @@ func runRigRestart(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// RigState is a rig's identity and availability, as shown by gt rig status.
+type RigState struct {
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Prefix string `json:"beads_prefix,omitempty"`
+	// State is "operational", "parked", or "docked" (getRigOperationalState).
+	State       string `json:"state"`
+	StateSource string `json:"state_source"`
+	// DispatchBlocked and DispatchReason are IsRigParkedOrDocked's verdict:
+	// what sling, convoy launch and polecat spawn enforce.
+	DispatchBlocked bool   `json:"dispatch_blocked"`
+	DispatchReason  string `json:"dispatch_reason,omitempty"`
+}
+
+// collectRigState gathers r's operational state and dispatch verdict.
+func collectRigState(townRoot string, r *rig.Rig) RigState {
+	opState, opSource := getRigOperationalState(townRoot, r.Name)
+	blocked, reason := IsRigParkedOrDocked(townRoot, r.Name)
+	state := RigState{
+		Name:            r.Name,
+		Path:            r.Path,
+		State:           strings.ToLower(opState),
+		StateSource:     opSource,
+		DispatchBlocked: blocked,
+		DispatchReason:  reason,
+	}
+	if r.Config != nil {
+		state.Prefix = r.Config.Prefix
+	}
+	return state
+}
+
+// printRigState writes the state lines of gt rig status.
+func printRigState(w io.Writer, s RigState) {
+	opState := strings.ToUpper(s.State)
+	switch s.State {
+	case "parked":
+		fmt.Fprintf(w, "  Status: %s (%s)\n", style.Warning.Render(opState), s.StateSource)
+	case "docked":
+		fmt.Fprintf(w, "  Status: %s (%s)\n", style.Dim.Render(opState), s.StateSource)
+	default:
+		fmt.Fprintf(w, "  Status: %s\n", style.Success.Render(opState))
+	}
+	if s.DispatchBlocked {
+		fmt.Fprintf(w, "  Dispatch: %s (rig is %s)\n", style.Warning.Render("blocked"), s.DispatchReason)
+	} else {
+		fmt.Fprintf(w, "  Dispatch: %s\n", style.Success.Render("accepting work"))
+	}
+
+	fmt.Fprintf(w, "  Path: %s\n", s.Path)
+	if s.Prefix != "" {
+		fmt.Fprintf(w, "  Beads prefix: %s-\n", s.Prefix)
+	}
+}
+
 // getRigOperationalState returns the operational state and source for a rig.
 // It checks the wisp layer first (local/ephemeral), then rig bead labels (global).
 // Returns state ("OPERATIONAL", "PARKED", or "DOCKED") and source ("local", "global - synced", or "default").
 func getRigOperationalState(townRoot, rigName string) (state string, source string) {
 	// Check wisp layer first (local/ephemeral overrides)
 	wispConfig := wisp.NewConfig(townRoot, rigName)
-	if status := wispConfig.GetString("status"); status != "" {
-		switch strings.ToLower(status) {
-		case "parked":
-			return "PARKED", "local"
-		case "docked":
-			return "DOCKED", "local"
-		}
+	switch wispConfig.Status() {
+	case wisp.StatusParked:
+		return "PARKED", "local"
+	case wisp.StatusDocked:
+		return "DOCKED", "local"
 	}
 
 	// Check rig bead labels (global/synced)
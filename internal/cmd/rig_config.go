@@ -191,7 +191,16 @@ func runRigConfigSet(cmd *cobra.Command, args []string) error {
 		} else if i, err := strconv.Atoi(value); err == nil {
 			typedValue = i
 		}
-		if err := wispCfg.Set(key, typedValue); err != nil {
+		if key == wisp.StatusKey {
+			// Status gates daemon auto-restart; a typo must not leave the rig running.
+			status, err := wisp.ParseStatus(value)
+			if err != nil {
+				return err
+			}
+			if err := wispCfg.SetStatus(status); err != nil {
+				return fmt.Errorf("setting %s: %w", key, err)
+			}
+		} else if err := wispCfg.Set(key, typedValue); err != nil {
 			return fmt.Errorf("setting %s: %w", key, err)
 		}
 		fmt.Printf("%s Set %s=%s in wisp layer for rig %s\n", style.Success.Render("✓"), key, value, rigName)
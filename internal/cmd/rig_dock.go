@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"fmt"
-	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
@@ -167,6 +166,7 @@ func runRigDock(cmd *cobra.Command, args []string) error {
 	}); err != nil {
 		return fmt.Errorf("setting docked label: %w", err)
 	}
+	invalidateRigBeadCache()
 
 	// Remove rig from daemon.json patrol config so daemon stops spawning
 	// witness/refinery sessions for this rig on every heartbeat cycle.
@@ -245,6 +245,7 @@ func runRigUndock(cmd *cobra.Command, args []string) error {
 	}); err != nil {
 		return fmt.Errorf("removing docked label: %w", err)
 	}
+	invalidateRigBeadCache()
 
 	// Re-add rig to daemon.json patrol config so daemon resumes spawning
 	// witness/refinery sessions for this rig.
@@ -265,20 +266,22 @@ func runRigUndock(cmd *cobra.Command, args []string) error {
 
 // IsRigDocked checks if a rig is docked by checking for the status:docked label
 // on the rig identity bead. This function is exported for use by the daemon.
+//
+// prefix is the caller's idea of the rig's beads prefix. If no identity bead
+// exists under it, the rig's registered prefix (rigs.json, then config.json)
+// is tried before concluding the rig is not docked, so a caller defaulting to
+// "gt" for a rig that uses another prefix can't let a docked rig accept work.
 func IsRigDocked(townRoot, rigName, prefix string) bool {
-	// Construct the rig beads path
-	rigPath := filepath.Join(townRoot, rigName)
-	beadsPath := filepath.Join(rigPath, "mayor", "rig")
-	if _, err := os.Stat(beadsPath); err != nil {
-		beadsPath = rigPath
-	}
-
-	bd := beads.New(beadsPath)
-	rigBeadID := beads.RigBeadIDWithPrefix(prefix, rigName)
-
-	rigBead, err := bd.Show(rigBeadID)
+	rigBead, err := showRigBead(townRoot, rigName, prefix)
 	if err != nil {
-		return false
+		actual := rigBeadsPrefix(townRoot, filepath.Join(townRoot, rigName), rigName)
+		if actual == "" || actual == prefix {
+			return false
+		}
+		style.PrintWarning("rig %s uses beads prefix %q, not %q; checking dock state with the rig's own prefix", rigName, actual, prefix)
+		if rigBead, err = showRigBead(townRoot, rigName, actual); err != nil {
+			return false
+		}
 	}
 
 	for _, label := range rigBead.Labels {
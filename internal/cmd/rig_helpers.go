@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
@@ -65,34 +66,93 @@ func getRig(rigName string) (string, *rig.Rig, error) {
 	return townRoot, r, nil
 }
 
-// hasRigBeadLabel checks if a rig's identity bead has a specific label.
-// Returns false if the rig config or bead can't be loaded (safe default).
-func hasRigBeadLabel(townRoot, rigName, label string) bool {
+// rigBeadCache memoizes rig identity bead lookups for the lifetime of one gt
+// invocation, so dispatch paths that check several labels on a rig (or the
+// same rig several times) spawn one bd show per rig instead of one per check.
+// Only successful lookups are cached, so a transient bd failure doesn't pin
+// a rig as unlabeled for the rest of the command.
+// Commands that change rig bead labels call invalidateRigBeadCache.
+var rigBeadCache = struct {
+	sync.Mutex
+	entries map[string]*beads.Issue
+}{entries: make(map[string]*beads.Issue)}
+
+// rigBeadLookupPath returns the directory bd runs in for a rig's identity
+// bead: mayor/rig when it exists, else the rig root.
+func rigBeadLookupPath(townRoot, rigName string) string {
 	rigPath := filepath.Join(townRoot, rigName)
-	prefix := rigBeadsPrefix(townRoot, rigPath, rigName)
-	if prefix == "" {
-		return false
-	}
-
 	beadsPath := filepath.Join(rigPath, "mayor", "rig")
 	if _, err := os.Stat(beadsPath); err != nil {
-		beadsPath = rigPath
+		return rigPath
 	}
+	return beadsPath
+}
 
-	bd := beads.New(beadsPath)
+// showRigBead returns the rig's identity bead for the given prefix, consulting
+// rigBeadCache first.
+func showRigBead(townRoot, rigName, prefix string) (*beads.Issue, error) {
+	beadsPath := rigBeadLookupPath(townRoot, rigName)
 	rigBeadID := beads.RigBeadIDWithPrefix(prefix, rigName)
+	key := beadsPath + "\x00" + rigBeadID
+
+	rigBeadCache.Lock()
+	bead, ok := rigBeadCache.entries[key]
+	rigBeadCache.Unlock()
+	if ok {
+		return bead, nil
+	}
 
-	rigBead, err := bd.Show(rigBeadID)
+	bead, err := beads.New(beadsPath).Show(rigBeadID)
 	if err != nil {
-		return false
+		return nil, err
 	}
+	rigBeadCache.Lock()
+	rigBeadCache.entries[key] = bead
+	rigBeadCache.Unlock()
+	return bead, nil
+}
 
+// invalidateRigBeadCache drops all cached identity beads so the next label
+// check sees a label just added or removed.
+func invalidateRigBeadCache() {
+	rigBeadCache.Lock()
+	defer rigBeadCache.Unlock()
+	clear(rigBeadCache.entries)
+}
+
+// hasRigBeadLabels reports which of labels are set on the rig's identity
+// bead, fetching the bead once. Every requested label has an entry in the
+// result. An error means the prefix or bead couldn't be resolved; the map is
+// then all false.
+func hasRigBeadLabels(townRoot, rigName string, labels ...string) (map[string]bool, error) {
+	found := make(map[string]bool, len(labels))
+	for _, l := range labels {
+		found[l] = false
+	}
+
+	rigPath := filepath.Join(townRoot, rigName)
+	prefix := rigBeadsPrefix(townRoot, rigPath, rigName)
+	if prefix == "" {
+		return found, fmt.Errorf("no beads prefix for rig %q", rigName)
+	}
+
+	rigBead, err := showRigBead(townRoot, rigName, prefix)
+	if err != nil {
+		return found, err
+	}
 	for _, l := range rigBead.Labels {
-		if l == label {
-			return true
+		if _, ok := found[l]; ok {
+			found[l] = true
 		}
 	}
-	return false
+	return found, nil
+}
+
+// hasRigBeadLabel checks if a rig's identity bead has a specific label.
+// Returns false if the rig config or bead can't be loaded (safe default).
+func hasRigBeadLabel(townRoot, rigName, label string) bool {
+	found, _ := hasRigBeadLabels(townRoot, rigName, label)
+	return found[label]
 }
 
 // IsRigParkedOrDocked checks if a rig is parked or docked by any mechanism
@@ -108,40 +168,23 @@ func hasRigBeadLabel(townRoot, rigName, label string) bool {
 func IsRigParkedOrDocked(townRoot, rigName string) (bool, string) {
 	// Check wisp layer first (fast, local) — only relevant for parked state
 	wispCfg := wisp.NewConfig(townRoot, rigName)
-	if wispCfg.GetString(RigStatusKey) == RigStatusParked {
+	if wispCfg.Status() == wisp.StatusParked {
 		return true, "parked"
 	}
 
 	// Single bead lookup for both parked and docked labels.
-	// Look up the beads prefix from rigs.json (the rig registry), with fallback
+	// The beads prefix comes from rigs.json (the rig registry), with fallback
 	// to the rig's own config.json for isolated/test scenarios.
-	rigPath := filepath.Join(townRoot, rigName)
-	prefix := rigBeadsPrefix(townRoot, rigPath, rigName)
-	if prefix == "" {
-		return false, ""
-	}
-
-	beadsPath := filepath.Join(rigPath, "mayor", "rig")
-	if _, err := os.Stat(beadsPath); err != nil {
-		beadsPath = rigPath
-	}
-
-	bd := beads.New(beadsPath)
-	rigBeadID := beads.RigBeadIDWithPrefix(prefix, rigName)
-	rigBead, err := bd.Show(rigBeadID)
+	found, err := hasRigBeadLabels(townRoot, rigName, "status:parked", RigDockedLabel)
 	if err != nil {
 		return false, ""
 	}
-
-	for _, l := range rigBead.Labels {
-		if l == "status:parked" {
-			return true, "parked"
-		}
-		if l == RigDockedLabel {
-			return true, "docked"
-		}
+	if found["status:parked"] {
+		return true, "parked"
+	}
+	if found[RigDockedLabel] {
+		return true, "docked"
 	}
-
 	return false, ""
 }
 
@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// writeCountingRigBD installs a fake bd on PATH whose "show" returns the rig
+// identity bead beadID carrying labels (and nothing for other IDs), and
+// appends one line per call to the returned log file.
+func writeCountingRigBD(t *testing.T, beadID, labels string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake bd script requires a POSIX shell")
+	}
+	binDir := t.TempDir()
+	logPath := filepath.Join(t.TempDir(), "bd.log")
+	script := `#!/bin/sh
+echo "$*" >> "` + logPath + `"
+case "$1" in
+  show)
+    case "$2" in
+      ` + beadID + `) echo '[{"id":"` + beadID + `","title":"gastown","status":"open","issue_type":"rig","labels":[` + labels + `]}]' ;;
+      *) echo '[]' ;;
+    esac
+    ;;
+  *) exit 1 ;;
+esac
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write fake bd: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return logPath
+}
+
+// setupPrefixedRig creates a town with a "gastown" rig whose config.json
+// declares the given beads prefix.
+func setupPrefixedRig(t *testing.T, prefix string) string {
+	t.Helper()
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "gastown")
+	if err := os.MkdirAll(rigPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	cfg := `{"type":"rig","version":1,"name":"gastown","beads":{"prefix":"` + prefix + `"}}`
+	if err := os.WriteFile(filepath.Join(rigPath, "config.json"), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return townRoot
+}
+
+func countBDShows(t *testing.T, logPath string) int {
+	t.Helper()
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0
+		}
+		t.Fatal(err)
+	}
+	return strings.Count(string(data), "show ")
+}
+
+func TestHasRigBeadLabels_SingleFetch(t *testing.T) {
+	invalidateRigBeadCache()
+	t.Cleanup(invalidateRigBeadCache)
+
+	townRoot := setupPrefixedRig(t, "gt")
+	logPath := writeCountingRigBD(t, "gt-rig-gastown", `"gt:rig","status:docked","owner:mayor"`)
+
+	found, err := hasRigBeadLabels(townRoot, "gastown", "status:parked", RigDockedLabel, "owner:mayor")
+	if err != nil {
+		t.Fatalf("hasRigBeadLabels: %v", err)
+	}
+	want := map[string]bool{"status:parked": false, RigDockedLabel: true, "owner:mayor": true}
+	for label, w := range want {
+		if found[label] != w {
+			t.Errorf("found[%q] = %v, want %v", label, found[label], w)
+		}
+	}
+	if len(found) != len(want) {
+		t.Errorf("found has %d entries, want %d: %v", len(found), len(want), found)
+	}
+
+	// Later checks on the same rig reuse the fetched bead.
+	if !hasRigBeadLabel(townRoot, "gastown", RigDockedLabel) {
+		t.Error("hasRigBeadLabel(status:docked) = false, want true")
+	}
+	if blocked, reason := IsRigParkedOrDocked(townRoot, "gastown"); !blocked || reason != "docked" {
+		t.Errorf("IsRigParkedOrDocked = %v %q, want docked", blocked, reason)
+	}
+	if !IsRigDocked(townRoot, "gastown", "gt") {
+		t.Error("IsRigDocked = false, want true")
+	}
+	if n := countBDShows(t, logPath); n != 1 {
+		t.Errorf("bd show ran %d times, want 1", n)
+	}
+
+	invalidateRigBeadCache()
+	hasRigBeadLabel(townRoot, "gastown", RigDockedLabel)
+	if n := countBDShows(t, logPath); n != 2 {
+		t.Errorf("after invalidation bd show ran %d times, want 2", n)
+	}
+}
+
+func TestIsRigDocked_WrongPrefixFallsBackToRigConfig(t *testing.T) {
+	invalidateRigBeadCache()
+	t.Cleanup(invalidateRigBeadCache)
+
+	townRoot := setupPrefixedRig(t, "bd")
+	writeCountingRigBD(t, "bd-rig-gastown", `"gt:rig","status:docked"`)
+
+	if !IsRigDocked(townRoot, "gastown", "gt") {
+		t.Error("IsRigDocked with wrong prefix = false, want true (rig is docked under its real prefix)")
+	}
+	if !IsRigDocked(townRoot, "gastown", "bd") {
+		t.Error("IsRigDocked with correct prefix = false, want true")
+	}
+}
+
+func TestIsRigDocked_NoBeadUnderAnyPrefix(t *testing.T) {
+	invalidateRigBeadCache()
+	t.Cleanup(invalidateRigBeadCache)
+
+	townRoot := setupPrefixedRig(t, "gt")
+	logPath := writeCountingRigBD(t, "other-rig-gastown", `"status:docked"`)
+
+	if IsRigDocked(townRoot, "gastown", "gt") {
+		t.Error("IsRigDocked = true, want false when the rig has no identity bead")
+	}
+	// The passed prefix matches the rig's own, so there is nothing to retry.
+	if n := countBDShows(t, logPath); n != 1 {
+		t.Errorf("bd show ran %d times, want 1", n)
+	}
+}
+
+func TestShowRigBead_DoesNotCacheFailures(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake bd script requires a POSIX shell")
+	}
+	invalidateRigBeadCache()
+	t.Cleanup(invalidateRigBeadCache)
+
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "gastown"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first show fails, as if Dolt were briefly unreachable; later
+	// shows succeed.
+	binDir := t.TempDir()
+	logPath := filepath.Join(t.TempDir(), "bd.log")
+	failedOnce := filepath.Join(t.TempDir(), "failed-once")
+	script := `#!/bin/sh
+echo "$*" >> "` + logPath + `"
+case "$*" in
+  *show*) ;;
+  *) exit 1 ;;
+esac
+if [ ! -f "` + failedOnce + `" ]; then
+  touch "` + failedOnce + `"
+  echo "connection refused" >&2
+  exit 1
+fi
+echo '[{"id":"gt-rig-gastown","title":"gastown","status":"open","issue_type":"rig","labels":["status:docked"]}]'
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write fake bd: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	if _, err := showRigBead(townRoot, "gastown", "gt"); err == nil {
+		t.Fatal("first showRigBead succeeded, want the stubbed failure")
+	}
+	bead, err := showRigBead(townRoot, "gastown", "gt")
+	if err != nil {
+		t.Fatalf("showRigBead after failure: %v", err)
+	}
+	if bead == nil || bead.ID != "gt-rig-gastown" {
+		t.Fatalf("showRigBead = %+v, want gt-rig-gastown", bead)
+	}
+	if _, err := showRigBead(townRoot, "gastown", "gt"); err != nil {
+		t.Fatalf("cached showRigBead: %v", err)
+	}
+	if n := countBDShows(t, logPath); n != 2 {
+		t.Errorf("bd show ran %d times, want 2 (failure retried, success cached)", n)
+	}
+}
@@ -13,10 +13,10 @@ import (
 )
 
 // RigStatusKey is the wisp config key for rig operational status.
-const RigStatusKey = "status"
+const RigStatusKey = wisp.StatusKey
 
 // RigStatusParked is the value indicating a rig is parked.
-const RigStatusParked = "parked"
+const RigStatusParked = string(wisp.StatusParked)
 
 var rigParkCmd = &cobra.Command{
 	Use:   "park <rig>...",
@@ -123,7 +123,7 @@ func parkOneRig(rigName string) error {
 
 	// Set parked status in wisp layer
 	wispCfg := wisp.NewConfig(townRoot, rigName)
-	if err := wispCfg.Set(RigStatusKey, RigStatusParked); err != nil {
+	if err := wispCfg.SetStatus(wisp.StatusParked); err != nil {
 		return fmt.Errorf("setting parked status: %w", err)
 	}
 
@@ -183,7 +183,7 @@ func unparkOneRig(rigName string) error {
 func IsRigParked(townRoot, rigName string) bool {
 	// Check wisp layer first (fast, local)
 	wispCfg := wisp.NewConfig(townRoot, rigName)
-	if wispCfg.GetString(RigStatusKey) == RigStatusParked {
+	if wispCfg.Status() == wisp.StatusParked {
 		return true
 	}
 
@@ -1,13 +1,18 @@
 package cmd
 
 import (
+	"bytes"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/wisp"
 )
 
 func TestIsAgentSessionHealthy_DeadPane(t *testing.T) {
@@ -202,3 +207,30 @@ func TestFindRigSessions_NoSessions(t *testing.T) {
 		t.Errorf("expected 0 sessions, got %d: %v", len(got), got)
 	}
 }
+
+func TestCollectRigState_Parked(t *testing.T) {
+	townRoot := t.TempDir()
+	r := &rig.Rig{Name: "gastown", Path: filepath.Join(townRoot, "gastown"), Config: &config.BeadsConfig{Prefix: "gt"}}
+	if err := wisp.NewConfig(townRoot, r.Name).SetStatus(wisp.StatusParked); err != nil {
+		t.Fatal(err)
+	}
+
+	s := collectRigState(townRoot, r)
+	if s.State != "parked" || s.StateSource != "local" {
+		t.Errorf("state = %q (%q), want parked (local)", s.State, s.StateSource)
+	}
+	if !s.DispatchBlocked || s.DispatchReason != "parked" {
+		t.Errorf("dispatch = %v %q, want blocked by parked", s.DispatchBlocked, s.DispatchReason)
+	}
+	if s.Prefix != "gt" || s.Path != r.Path {
+		t.Errorf("prefix/path = %q %q", s.Prefix, s.Path)
+	}
+
+	var buf bytes.Buffer
+	printRigState(&buf, s)
+	for _, want := range []string{"PARKED (local)", "blocked", "rig is parked", "Beads prefix: gt-"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, buf.String())
+		}
+	}
+}
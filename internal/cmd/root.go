@@ -22,6 +22,9 @@ import (
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
+// noColor disables styled output regardless of TTY or NO_COLOR detection.
+var noColor bool
+
 var rootCmd = &cobra.Command{
 	Use:               "gt", // Updated in init() based on GT_COMMAND
 	Short:             "Gas Town - Multi-agent workspace manager",
@@ -77,6 +80,7 @@ var beadsExemptCommands = map[string]bool{
 	"health":        true, // Health check doesn't require beads
 	"upgrade":       true, // Post-install migration orchestrator
 	"heartbeat":     true, // Heartbeat state update — must be fast and dependency-free
+	"routes":        true, // Reads routes.jsonl directly; must work to diagnose routing
 }
 
 // Commands exempt from the town root branch warning.
@@ -113,6 +117,7 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 
 	// Initialize CLI theme (dark/light mode support)
 	initCLITheme()
+	applyNoColorFlag()
 
 	// gt done can autosave and push; prove ownership before shared pre-run writes.
 	if isDoneCommand(cmd) {
@@ -214,6 +219,15 @@ func initCLITheme() {
 	ui.ApplyThemeMode()
 }
 
+// applyNoColorFlag turns off styling when --no-color is set. The explicit flag
+// wins over env-based detection (NO_COLOR, CLICOLOR_FORCE, TTY); without it the
+// detected setting is left alone.
+func applyNoColorFlag() {
+	if noColor {
+		style.SetColorEnabled(false)
+	}
+}
+
 // touchPolecatHeartbeat touches the session heartbeat file for polecat agents.
 // Called from persistentPreRun on every gt command. The heartbeat signals that
 // the agent process is alive and actively running gt commands. Used by
@@ -382,8 +396,8 @@ func init() {
 	rootCmd.SetHelpCommandGroupID(GroupDiag)
 	rootCmd.SetCompletionCommandGroupID(GroupConfig)
 
-	// Global flags can be added here
-	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file")
+	// Global flags
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colored output (even on a TTY)")
 }
 
 // buildCommandPath walks the command hierarchy to build the full command path.
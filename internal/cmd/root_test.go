@@ -8,6 +8,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/style"
 )
 
 func TestCheckHelpFlag(t *testing.T) {
@@ -254,3 +255,51 @@ func TestPersistentPreRunMalformedAgentRegistry(t *testing.T) {
 		t.Fatalf("GetProcessNames(claude) after malformed registry = %v, want builtin [node claude ...]", got)
 	}
 }
+
+func TestNoColorFlagDisablesStyling(t *testing.T) {
+	// NOTE: cannot use t.Parallel() — mutates global style state.
+	origEnabled := style.ColorEnabled()
+	origNoColor := noColor
+	t.Cleanup(func() {
+		style.SetColorEnabled(origEnabled)
+		noColor = origNoColor
+	})
+
+	flag := rootCmd.PersistentFlags().Lookup("no-color")
+	if flag == nil {
+		t.Fatal("root command should define a persistent --no-color flag")
+	}
+
+	// Simulate env-based detection enabling color (e.g. CLICOLOR_FORCE on a TTY);
+	// the explicit flag must still win.
+	style.SetColorEnabled(true)
+	if err := flag.Value.Set("true"); err != nil {
+		t.Fatalf("setting --no-color: %v", err)
+	}
+	t.Cleanup(func() { _ = flag.Value.Set("false") })
+
+	applyNoColorFlag()
+
+	if style.ColorEnabled() {
+		t.Error("--no-color should disable styling")
+	}
+	if got := style.Bold.Render("plain"); got != "plain" {
+		t.Errorf("Bold.Render() = %q, want unstyled text with --no-color", got)
+	}
+}
+
+func TestNoColorFlagUnsetLeavesDetection(t *testing.T) {
+	origEnabled := style.ColorEnabled()
+	origNoColor := noColor
+	t.Cleanup(func() {
+		style.SetColorEnabled(origEnabled)
+		noColor = origNoColor
+	})
+
+	noColor = false
+	style.SetColorEnabled(true)
+	applyNoColorFlag()
+	if !style.ColorEnabled() {
+		t.Error("without --no-color, detected color setting should be preserved")
+	}
+}
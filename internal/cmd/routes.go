@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var routesJSON bool
+
+var routesCmd = &cobra.Command{
+	Use:     "routes",
+	GroupID: GroupDiag,
+	Short:   "Show bead ID prefix routes",
+	Long: `Show the town's bead routing table (.beads/routes.jsonl).
+
+Each route maps a bead ID prefix (e.g. "gt-") to the directory whose .beads
+database holds those beads. Cross-rig commands use these routes to find beads.
+
+Problems are flagged inline:
+  missing    the route's path does not exist on disk
+  duplicate  the prefix is registered more than once (first entry wins)
+
+Use 'gt doctor --fix' to repair missing or redirect-dependent routes.
+
+Examples:
+  gt routes
+  gt routes --json`,
+	Args: cobra.NoArgs,
+	RunE: runRoutes,
+}
+
+func init() {
+	routesCmd.Flags().BoolVar(&routesJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(routesCmd)
+}
+
+// routeInfo is one routes.jsonl entry annotated with on-disk checks.
+type routeInfo struct {
+	Prefix    string `json:"prefix"`
+	Path      string `json:"path"`
+	Resolved  string `json:"resolved"`
+	Exists    bool   `json:"exists"`
+	Duplicate bool   `json:"duplicate,omitempty"`
+}
+
+func runRoutes(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	// LoadRoutes (not ReadRoutes) so duplicate prefixes stay visible.
+	routes, err := beads.LoadRoutes(beads.GetTownBeadsPath(townRoot))
+	if err != nil {
+		return fmt.Errorf("loading routes: %w", err)
+	}
+
+	infos := inspectRoutes(townRoot, routes)
+
+	if routesJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(infos)
+	}
+
+	printRoutes(os.Stdout, infos)
+	return nil
+}
+
+// inspectRoutes resolves each route against townRoot and flags paths that are
+// missing on disk and prefixes that appear more than once.
+func inspectRoutes(townRoot string, routes []beads.Route) []routeInfo {
+	count := make(map[string]int, len(routes))
+	for _, r := range routes {
+		count[r.Prefix]++
+	}
+
+	infos := make([]routeInfo, 0, len(routes))
+	for _, r := range routes {
+		resolved := r.Path
+		if !filepath.IsAbs(resolved) {
+			resolved = filepath.Join(townRoot, r.Path)
+		}
+		info, statErr := os.Stat(resolved)
+		infos = append(infos, routeInfo{
+			Prefix:    r.Prefix,
+			Path:      r.Path,
+			Resolved:  resolved,
+			Exists:    statErr == nil && info.IsDir(),
+			Duplicate: count[r.Prefix] > 1,
+		})
+	}
+	return infos
+}
+
+func printRoutes(w io.Writer, infos []routeInfo) {
+	if len(infos) == 0 {
+		fmt.Fprintln(w, "No routes configured. Run 'gt doctor --fix' to create the town routes.")
+		return
+	}
+
+	width := 0
+	for _, r := range infos {
+		if len(r.Prefix) > width {
+			width = len(r.Prefix)
+		}
+	}
+
+	problems := 0
+	fmt.Fprintf(w, "%s\n\n", style.Bold.Render("Routes"))
+	for _, r := range infos {
+		icon := style.SuccessPrefix
+		var flags []string
+		if !r.Exists {
+			flags = append(flags, "missing")
+		}
+		if r.Duplicate {
+			flags = append(flags, "duplicate")
+		}
+		if len(flags) > 0 {
+			icon = style.WarningPrefix
+			problems++
+		}
+		fmt.Fprintf(w, "  %s %-*s → %s", icon, width, r.Prefix, r.Path)
+		for _, f := range flags {
+			fmt.Fprintf(w, " %s", style.Warning.Render("["+f+"]"))
+		}
+		fmt.Fprintln(w)
+	}
+
+	if problems > 0 {
+		fmt.Fprintf(w, "\n%s\n", style.Dim.Render(fmt.Sprintf("%d route(s) need attention. See 'gt doctor' for details.", problems)))
+	}
+}
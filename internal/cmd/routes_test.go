@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestInspectRoutes(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "gastown", "mayor", "rig"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	infos := inspectRoutes(townRoot, []beads.Route{
+		{Prefix: "hq-", Path: "."},
+		{Prefix: "gt-", Path: "gastown/mayor/rig"},
+		{Prefix: "bd-", Path: "beads/mayor/rig"},
+		{Prefix: "gt-", Path: "old/mayor/rig"},
+	})
+
+	if len(infos) != 4 {
+		t.Fatalf("got %d routes, want 4", len(infos))
+	}
+	if !infos[0].Exists || infos[0].Duplicate {
+		t.Errorf("hq- = %+v, want existing, not duplicate", infos[0])
+	}
+	if !infos[1].Exists || !infos[1].Duplicate {
+		t.Errorf("gt- = %+v, want existing duplicate", infos[1])
+	}
+	if infos[2].Exists {
+		t.Errorf("bd- = %+v, want missing", infos[2])
+	}
+	if infos[2].Resolved != filepath.Join(townRoot, "beads/mayor/rig") {
+		t.Errorf("bd- resolved = %s", infos[2].Resolved)
+	}
+
+	var buf bytes.Buffer
+	printRoutes(&buf, infos)
+	out := buf.String()
+	for _, want := range []string{"hq-", "gastown/mayor/rig", "[missing]", "[duplicate]", "3 route(s) need attention"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestPrintRoutes_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	printRoutes(&buf, nil)
+	if !strings.Contains(buf.String(), "No routes configured") {
+		t.Errorf("unexpected output: %q", buf.String())
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
@@ -51,9 +53,14 @@ var schedulerStatusCmd = &cobra.Command{
 }
 
 var schedulerListCmd = &cobra.Command{
-	Use:   "list",
+	Use:   "list [rig]",
 	Short: "List all scheduled beads with titles, rig, blocked status",
-	RunE:  runSchedulerList,
+	Long: `List scheduled beads grouped by target rig, oldest first (by enqueued_at).
+
+Pass a rig name to show only that rig's scheduled work. Parked or docked rigs
+are flagged; 'gt scheduler run' will not dispatch to them.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSchedulerList,
 }
 
 var schedulerPauseCmd = &cobra.Command{
@@ -119,11 +126,12 @@ func init() {
 
 // scheduledBeadInfo holds info about a scheduled bead for display.
 type scheduledBeadInfo struct {
-	ID        string `json:"id"`
-	Title     string `json:"title"`
-	Status    string `json:"status"`
-	TargetRig string `json:"target_rig"`
-	Blocked   bool   `json:"blocked,omitempty"`
+	ID         string `json:"id"`
+	Title      string `json:"title"`
+	Status     string `json:"status"`
+	TargetRig  string `json:"target_rig"`
+	EnqueuedAt string `json:"enqueued_at,omitempty"`
+	Blocked    bool   `json:"blocked,omitempty"`
 }
 
 func runSchedulerStatus(cmd *cobra.Command, args []string) error {
@@ -221,6 +229,10 @@ func runSchedulerList(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("listing scheduled beads: %w", err)
 	}
+	if len(args) > 0 {
+		scheduled = filterScheduledBeadsByRig(scheduled, args[0])
+	}
+	sortScheduledBeads(scheduled)
 
 	if schedulerListJSON {
 		enc := json.NewEncoder(os.Stdout)
@@ -229,25 +241,43 @@ func runSchedulerList(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(scheduled) == 0 {
+		if len(args) > 0 {
+			fmt.Printf("No beads scheduled for %s.\n", args[0])
+			return nil
+		}
 		fmt.Println("No beads scheduled.")
 		fmt.Println("Enable deferred dispatch with: gt config set scheduler.max_polecats <N>")
 		return nil
 	}
 
 	byRig := make(map[string][]scheduledBeadInfo)
+	var rigs []string
 	for _, b := range scheduled {
+		if _, ok := byRig[b.TargetRig]; !ok {
+			rigs = append(rigs, b.TargetRig)
+		}
 		byRig[b.TargetRig] = append(byRig[b.TargetRig], b)
 	}
+	sort.Strings(rigs)
 
 	fmt.Printf("%s (%d beads)\n\n", style.Bold.Render("Scheduled Work"), len(scheduled))
-	for rig, beads := range byRig {
-		fmt.Printf("  %s (%d):\n", style.Bold.Render(rig), len(beads))
+	for _, rig := range rigs {
+		beads := byRig[rig]
+		header := fmt.Sprintf("  %s (%d):", style.Bold.Render(rig), len(beads))
+		if blocked, reason := IsRigParkedOrDocked(townRoot, rig); blocked {
+			header += style.Dim.Render(fmt.Sprintf(" rig %s, not dispatching", reason))
+		}
+		fmt.Println(header)
 		for _, b := range beads {
 			indicator := "○"
 			if b.Blocked {
 				indicator = "⏸"
 			}
-			fmt.Printf("    %s %s: %s\n", indicator, b.ID, b.Title)
+			line := fmt.Sprintf("    %s %s: %s", indicator, b.ID, b.Title)
+			if t, err := time.Parse(time.RFC3339, b.EnqueuedAt); err == nil {
+				line += style.Dim.Render(" (enqueued " + formatAge(t) + ")")
+			}
+			fmt.Println(line)
 		}
 		fmt.Println()
 	}
@@ -255,6 +285,46 @@ func runSchedulerList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// filterScheduledBeadsByRig keeps only beads targeting rig.
+func filterScheduledBeadsByRig(scheduled []scheduledBeadInfo, rig string) []scheduledBeadInfo {
+	var result []scheduledBeadInfo
+	for _, b := range scheduled {
+		if b.TargetRig == rig {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// sortScheduledBeads orders beads the way the scheduler dispatches them:
+// oldest-enqueued first, beads without a parseable enqueued_at last, ties
+// broken on ID. See scheduledEnqueueLess.
+func sortScheduledBeads(scheduled []scheduledBeadInfo) {
+	sort.SliceStable(scheduled, func(i, j int) bool {
+		return scheduledEnqueueLess(scheduled[i].EnqueuedAt, scheduled[i].ID, scheduled[j].EnqueuedAt, scheduled[j].ID)
+	})
+}
+
+// scheduledEnqueueLess is the single ordering shared by `gt scheduler list`
+// and dispatch, so the list shows beads in the order they will be dispatched.
+// Beads sort oldest-enqueued first; a missing or unparseable enqueued_at
+// sorts last; ties break on work bead ID.
+func scheduledEnqueueLess(enqueuedI, idI, enqueuedJ, idJ string) bool {
+	ti, errI := time.Parse(time.RFC3339, enqueuedI)
+	tj, errJ := time.Parse(time.RFC3339, enqueuedJ)
+	switch {
+	case errI != nil && errJ != nil:
+		return idI < idJ
+	case errI != nil:
+		return false
+	case errJ != nil:
+		return true
+	case !ti.Equal(tj):
+		return ti.Before(tj)
+	}
+	return idI < idJ
+}
+
 func runSchedulerPause(cmd *cobra.Command, args []string) error {
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
@@ -414,11 +484,12 @@ func scheduledBeadInfoFromWork(ctxTitle string, fields *capacity.SlingContextFie
 		}
 	}
 	return scheduledBeadInfo{
-		ID:        fields.WorkBeadID,
-		Title:     title,
-		Status:    status,
-		TargetRig: fields.TargetRig,
-		Blocked:   !ready,
+		ID:         fields.WorkBeadID,
+		Title:      title,
+		Status:     status,
+		TargetRig:  fields.TargetRig,
+		EnqueuedAt: fields.EnqueuedAt,
+		Blocked:    !ready,
 	}, true
 }
 
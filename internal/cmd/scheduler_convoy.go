@@ -127,6 +127,7 @@ func runConvoyScheduleByID(convoyID string, opts convoyScheduleOpts) error {
 			NoConvoy:    true, // Already tracked by this convoy
 			Force:       opts.Force,
 			HookRawBead: opts.HookRawBead,
+			NoBoot:      opts.NoBoot,
 		})
 		if err != nil {
 			fmt.Printf("  %s %s: %v\n", style.Dim.Render("✗"), c.ID, err)
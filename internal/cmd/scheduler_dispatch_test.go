@@ -3,10 +3,12 @@ package cmd
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/scheduler/capacity"
 )
@@ -141,3 +143,77 @@ func TestRunSchedulerClearFailsOnContextScanFailure(t *testing.T) {
 		t.Fatalf("error = %q, want sling context scan failure", err.Error())
 	}
 }
+
+func TestSortScheduledBeadsByEnqueuedAt(t *testing.T) {
+	scheduled := []scheduledBeadInfo{
+		{ID: "gt-c", TargetRig: "gastown", EnqueuedAt: "2026-01-02T10:00:00Z"},
+		{ID: "gt-none", TargetRig: "gastown"},
+		{ID: "gt-a", TargetRig: "gastown", EnqueuedAt: "2026-01-01T09:00:00Z"},
+		{ID: "bd-b", TargetRig: "beads", EnqueuedAt: "2026-01-01T12:00:00Z"},
+		{ID: "gt-b", TargetRig: "gastown", EnqueuedAt: "2026-01-01T12:00:00Z"},
+	}
+
+	sortScheduledBeads(scheduled)
+
+	var got []string
+	for _, b := range scheduled {
+		got = append(got, b.ID)
+	}
+	want := []string{"gt-a", "bd-b", "gt-b", "gt-c", "gt-none"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+
+	gastown := filterScheduledBeadsByRig(scheduled, "gastown")
+	if len(gastown) != 4 || gastown[0].ID != "gt-a" || gastown[3].ID != "gt-none" {
+		t.Errorf("filterScheduledBeadsByRig(gastown) = %+v", gastown)
+	}
+}
+
+func TestSchedulerListOrderMatchesDispatchOrder(t *testing.T) {
+	// Context IDs deliberately sort opposite to work bead IDs so a
+	// tie-break on the wrong key shows up as an order mismatch.
+	work := []struct {
+		contextID, workID, enqueuedAt string
+	}{
+		{"hq-ctx-1", "gt-z", "2026-01-02T10:00:00Z"},
+		{"hq-ctx-2", "gt-none", ""},
+		{"hq-ctx-3", "gt-a", "2026-01-01T09:00:00Z"},
+		{"hq-ctx-4", "gt-c", "2026-01-01T12:00:00Z"},
+		{"hq-ctx-5", "gt-b", "2026-01-01T12:00:00Z"},
+		{"hq-ctx-6", "gt-bad", "not-a-time"},
+	}
+
+	var listed []scheduledBeadInfo
+	var candidates []scheduledContextAssessment
+	for _, w := range work {
+		fields := &capacity.SlingContextFields{WorkBeadID: w.workID, TargetRig: "gastown", EnqueuedAt: w.enqueuedAt}
+		info, ok := scheduledBeadInfoFromWork("", fields, beadStatusInfo{}, false, true)
+		if !ok {
+			t.Fatalf("scheduledBeadInfoFromWork(%s) dropped the bead", w.workID)
+		}
+		listed = append(listed, info)
+		candidates = append(candidates, scheduledContextAssessment{
+			context: slingContextRecord{issue: &beads.Issue{ID: w.contextID}},
+			fields:  fields,
+		})
+	}
+
+	sortScheduledBeads(listed)
+	sortScheduledContextCandidates(candidates)
+
+	var listOrder, dispatchOrder []string
+	for _, b := range listed {
+		listOrder = append(listOrder, b.ID)
+	}
+	for _, c := range candidates {
+		dispatchOrder = append(dispatchOrder, c.fields.WorkBeadID)
+	}
+	if !reflect.DeepEqual(listOrder, dispatchOrder) {
+		t.Fatalf("list order = %v, dispatch order = %v", listOrder, dispatchOrder)
+	}
+	want := []string{"gt-a", "gt-b", "gt-c", "gt-z", "gt-bad", "gt-none"}
+	if !reflect.DeepEqual(dispatchOrder, want) {
+		t.Errorf("dispatch order = %v, want %v", dispatchOrder, want)
+	}
+}
@@ -128,6 +128,7 @@ func runEpicScheduleByID(epicID string, opts epicScheduleOpts) error {
 			Force:       opts.Force,
 			HookRawBead: opts.HookRawBead,
 			NoConvoy:    true, // Epic is the organizing structure
+			NoBoot:      opts.NoBoot,
 		})
 		if err != nil {
 			fmt.Printf("  %s %s: %v\n", style.Dim.Render("✗"), c.ID, err)
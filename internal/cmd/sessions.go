@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var sessionsListJSON bool
+
+var sessionsCmd = &cobra.Command{
+	Use:     "sessions",
+	GroupID: GroupAgents,
+	Short:   "Inspect all Gas Town tmux sessions",
+	RunE:    requireSubcommand,
+	Long: `Inspect every Gas Town tmux session, not just polecats.
+
+Unlike 'gt session', which manages individual polecat sessions, this lists
+town-level agents (mayor, deacon, dogs) and rig-level agents (witness,
+refinery, crew, polecats) with their parsed identity and liveness.`,
+}
+
+var sessionsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all Gas Town sessions with parsed identity and liveness",
+	Long: `List all tmux sessions that belong to Gas Town.
+
+Each session is parsed into its role, rig, and agent name. Liveness uses the
+session heartbeat when present, falling back to probing the pane process.
+Non-session names (convoy, molecule, merge-request IDs) are skipped.
+
+JSON fields: session, role, rig, name, alive, heartbeat_age (seconds since
+the last heartbeat; omitted when the session has none).
+
+Examples:
+  gt sessions list
+  gt sessions list --json`,
+	Args: cobra.NoArgs,
+	RunE: runSessionsList,
+}
+
+func init() {
+	sessionsListCmd.Flags().BoolVar(&sessionsListJSON, "json", false, "Output as JSON")
+
+	sessionsCmd.AddCommand(sessionsListCmd)
+	rootCmd.AddCommand(sessionsCmd)
+}
+
+// sessionsListItem is one Gas Town session in 'gt sessions list' output.
+type sessionsListItem struct {
+	Session      string `json:"session"`
+	Role         string `json:"role"`
+	Rig          string `json:"rig,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Alive        bool   `json:"alive"`
+	HeartbeatAge *int64 `json:"heartbeat_age,omitempty"`
+}
+
+func runSessionsList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	t := tmux.NewTmux()
+	names, err := t.ListSessions()
+	if err != nil {
+		names = nil // No tmux server means no sessions.
+	}
+
+	items := collectSessionsList(names, townRoot, time.Now(), func(name string) bool {
+		return polecat.IsSessionProcessDead(t, name, townRoot)
+	})
+
+	if sessionsListJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No Gas Town sessions.")
+		return nil
+	}
+
+	fmt.Printf("%s\n\n", style.Bold.Render("Gas Town Sessions"))
+	for _, it := range items {
+		status := style.Bold.Render("●")
+		if !it.Alive {
+			status = style.Dim.Render("○")
+		}
+		who := it.Role
+		if it.Rig != "" {
+			who = it.Rig + "/" + who
+		}
+		if it.Name != "" {
+			who += " " + it.Name
+		}
+		hb := ""
+		if it.HeartbeatAge != nil {
+			hb = style.Dim.Render(fmt.Sprintf("  heartbeat %s ago", formatDuration(time.Duration(*it.HeartbeatAge)*time.Second)))
+		}
+		fmt.Printf("  %s %-24s %s%s\n", status, it.Session, who, hb)
+	}
+	return nil
+}
+
+// collectSessionsList filters tmux session names down to Gas Town agent
+// sessions and annotates each with its parsed identity, liveness (via dead),
+// and heartbeat age. Results are sorted by session name.
+func collectSessionsList(names []string, townRoot string, now time.Time, dead func(name string) bool) []sessionsListItem {
+	items := make([]sessionsListItem, 0, len(names))
+	for _, name := range names {
+		if !session.IsKnownSession(name) {
+			continue
+		}
+		identity, err := session.ParseSessionName(name)
+		if err != nil {
+			continue
+		}
+		item := sessionsListItem{
+			Session: name,
+			Role:    string(identity.Role),
+			Rig:     identity.Rig,
+			Name:    identity.Name,
+			Alive:   !dead(name),
+		}
+		if hb := polecat.ReadSessionHeartbeat(townRoot, name); hb != nil {
+			age := int64(now.Sub(hb.Timestamp).Seconds())
+			item.HeartbeatAge = &age
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Session < items[j].Session })
+	return items
+}
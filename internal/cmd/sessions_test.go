@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+func TestCollectSessionsList(t *testing.T) {
+	reg := session.NewPrefixRegistry()
+	reg.Register("gt", "gastown")
+	old := session.DefaultRegistry()
+	session.SetDefaultRegistry(reg)
+	t.Cleanup(func() { session.SetDefaultRegistry(old) })
+
+	townRoot := t.TempDir()
+	polecat.TouchSessionHeartbeat(townRoot, "gt-furiosa")
+
+	names := []string{
+		"gt-furiosa",
+		"hq-mayor",
+		"hq-cv-abc",   // convoy ID, not a session
+		"dotfiles",    // not a Gas Town session
+		"gt-crew-max", // crew
+	}
+	dead := func(name string) bool { return name == "gt-crew-max" }
+
+	items := collectSessionsList(names, townRoot, time.Now().Add(90*time.Second), dead)
+	if len(items) != 3 {
+		t.Fatalf("got %d items, want 3: %+v", len(items), items)
+	}
+
+	// Sorted by session name.
+	if items[0].Session != "gt-crew-max" || items[1].Session != "gt-furiosa" || items[2].Session != "hq-mayor" {
+		t.Errorf("unexpected order: %s, %s, %s", items[0].Session, items[1].Session, items[2].Session)
+	}
+
+	crew := items[0]
+	if crew.Role != "crew" || crew.Rig != "gastown" || crew.Name != "max" || crew.Alive {
+		t.Errorf("crew item = %+v", crew)
+	}
+
+	pc := items[1]
+	if pc.Role != "polecat" || pc.Name != "furiosa" || !pc.Alive {
+		t.Errorf("polecat item = %+v", pc)
+	}
+	if pc.HeartbeatAge == nil || *pc.HeartbeatAge < 89 || *pc.HeartbeatAge > 91 {
+		t.Errorf("polecat heartbeat_age = %v, want ~90", pc.HeartbeatAge)
+	}
+
+	if items[2].HeartbeatAge != nil {
+		t.Errorf("mayor has no heartbeat file, got heartbeat_age %d", *items[2].HeartbeatAge)
+	}
+}
+
+func TestSessionsListItemJSON(t *testing.T) {
+	data, err := json.Marshal(sessionsListItem{Session: "hq-mayor", Role: "mayor", Alive: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"session", "role", "alive"} {
+		if _, ok := parsed[key]; !ok {
+			t.Errorf("JSON missing %q: %s", key, data)
+		}
+	}
+	if _, ok := parsed["heartbeat_age"]; ok {
+		t.Errorf("heartbeat_age should be omitted when unknown: %s", data)
+	}
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+func TestShutdownKillOrder(t *testing.T) {
+	originalRegistry := session.DefaultRegistry()
+	t.Cleanup(func() { session.SetDefaultRegistry(originalRegistry) })
+	reg := session.NewPrefixRegistry()
+	reg.Register("gt", "gastown")
+	session.SetDefaultRegistry(reg)
+
+	mayor := session.MayorSessionName()
+	deacon := session.DeaconSessionName()
+	boot := session.BootSessionName()
+	sessions := []string{
+		deacon,
+		"gt-witness",
+		mayor,
+		"gt-refinery",
+		boot,
+		"gt-nux",
+		"gt-crew-max",
+	}
+
+	got := shutdownKillOrder(sessions, mayor, deacon)
+	want := []string{"gt-nux", "gt-crew-max", "gt-refinery", "gt-witness", mayor, boot, deacon}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("shutdownKillOrder() = %v, want %v", got, want)
+	}
+}
+
+// TestPrintShutdownDryRun verifies the dry-run preview lists sessions in kill
+// order. printShutdownDryRun takes no tmux handle, so it cannot kill anything.
+func TestPrintShutdownDryRun(t *testing.T) {
+	originalRegistry := session.DefaultRegistry()
+	t.Cleanup(func() { session.SetDefaultRegistry(originalRegistry) })
+	reg := session.NewPrefixRegistry()
+	reg.Register("gt", "gastown")
+	session.SetDefaultRegistry(reg)
+
+	mayor := session.MayorSessionName()
+	deacon := session.DeaconSessionName()
+
+	output := captureStdout(t, func() {
+		printShutdownDryRun([]string{deacon, mayor, "gt-witness", "gt-nux"}, mayor, deacon)
+	})
+
+	if !strings.Contains(output, "no sessions will be stopped") {
+		t.Errorf("dry-run output missing no-op notice:\n%s", output)
+	}
+	for i, want := range []string{"1. gt-nux", "2. gt-witness", "3. " + mayor, "4. " + deacon} {
+		if !strings.Contains(output, want) {
+			t.Errorf("dry-run output missing step %d %q:\n%s", i+1, want, output)
+		}
+	}
+}
+
+func TestWaitForShutdownHandoff(t *testing.T) {
+	t.Run("completes without interrupts", func(t *testing.T) {
+		interrupts := make(chan os.Signal, 2)
+		var got shutdownWaitResult
+		captureStdout(t, func() {
+			got = waitForShutdownHandoff(50*time.Millisecond, 20*time.Millisecond, time.Second, interrupts)
+		})
+		if got != shutdownWaitCompleted {
+			t.Errorf("result = %v, want shutdownWaitCompleted", got)
+		}
+	})
+
+	t.Run("single interrupt skips the wait", func(t *testing.T) {
+		interrupts := make(chan os.Signal, 2)
+		interrupts <- os.Interrupt
+		start := time.Now()
+		var got shutdownWaitResult
+		captureStdout(t, func() {
+			got = waitForShutdownHandoff(time.Minute, time.Minute, 50*time.Millisecond, interrupts)
+		})
+		if got != shutdownWaitSkipped {
+			t.Errorf("result = %v, want shutdownWaitSkipped", got)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("interrupted wait took %v, want well under the 1m wait", elapsed)
+		}
+	})
+
+	t.Run("second interrupt aborts", func(t *testing.T) {
+		interrupts := make(chan os.Signal, 2)
+		interrupts <- os.Interrupt
+		interrupts <- os.Interrupt
+		var got shutdownWaitResult
+		captureStdout(t, func() {
+			got = waitForShutdownHandoff(time.Minute, time.Minute, time.Minute, interrupts)
+		})
+		if got != shutdownWaitAborted {
+			t.Errorf("result = %v, want shutdownWaitAborted", got)
+		}
+	})
+}
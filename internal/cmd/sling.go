@@ -12,10 +12,13 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/lock"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/nudge"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/telemetry"
 	"github.com/steveyegge/gastown/internal/witness"
@@ -133,6 +136,7 @@ var (
 	slingBaseBranch    string // --base-branch: override base branch for polecat worktree
 	slingResumeBranch  string // --branch: resume an existing branch instead of creating a fresh one
 	slingResumePR      int    // --pr: resume the head branch of an existing PR (resolves via gh)
+	slingTargetBranch  string // --target-branch: branch the MR targets at gt done
 	slingRalph         bool   // --ralph: enable Ralph Wiggum loop mode for multi-step workflows
 	slingFormula       string // --formula: override formula for dispatch (default: mol-polecat-work)
 	slingCrew          string // --crew: target a crew member in the specified rig
@@ -158,10 +162,11 @@ func init() {
 	slingCmd.Flags().BoolVar(&slingHookRawBead, "hook-raw-bead", false, "Hook raw bead without default formula (expert mode)")
 	slingCmd.Flags().BoolVar(&slingNoMerge, "no-merge", false, "Skip merge queue on completion (keep work on feature branch for review)")
 	slingCmd.Flags().StringVar(&slingMerge, "merge", "", "Merge strategy: direct (push to main), mr (merge queue, default), local (keep on branch)")
-	slingCmd.Flags().BoolVar(&slingNoBoot, "no-boot", false, "Skip rig boot after polecat spawn (avoids witness/refinery lock contention)")
+	slingCmd.Flags().BoolVar(&slingNoBoot, "no-boot", false, "Skip rig boot after polecat spawn (avoids witness/refinery lock contention; rig/polecat targets only)")
 	slingCmd.Flags().IntVar(&slingMaxConcurrent, "max-concurrent", 0, "Throttle spawn rate: spawn N polecats, pause, then spawn N more (0 = no throttle). Does not limit total concurrent polecats")
 	slingCmd.Flags().StringVar(&slingBaseBranch, "base-branch", "", "Override base branch for polecat worktree (e.g., 'develop', 'release/v2')")
 	slingCmd.Flags().StringVar(&slingResumeBranch, "branch", "", "Resume work on an existing branch instead of creating a fresh polecat branch (use to fix an existing PR)")
+	slingCmd.Flags().StringVar(&slingTargetBranch, "target-branch", "", "Branch the merge request should target when the work is done (default: rig default branch)")
 	slingCmd.Flags().IntVar(&slingResumePR, "pr", 0, "Resume work on the head branch of an existing PR (resolved via 'gh pr view'). Mutually exclusive with --branch.")
 	slingCmd.Flags().BoolVar(&slingRalph, "ralph", false, "Enable Ralph Wiggum loop mode (fresh context per step, for multi-step workflows)")
 	slingCmd.Flags().StringVar(&slingFormula, "formula", "", "Formula to apply (default: mol-polecat-work for polecat targets)")
@@ -228,14 +233,15 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 
 	// Validate --merge flag if provided
 	if slingMerge != "" {
-		switch slingMerge {
-		case "direct", "mr", "local":
-			// Valid
-		default:
+		if err := validateConvoyMergeStrategy(slingMerge); err != nil {
 			return fmt.Errorf("invalid --merge value %q: must be direct, mr, or local", slingMerge)
 		}
 	}
 
+	if err := capacity.ValidateOwnedConvoy(slingOwned, slingNoConvoy); err != nil {
+		return err
+	}
+
 	// Validate --branch / --pr resume flags (gh#3602).
 	// These flags reuse an existing branch/PR head instead of creating a fresh
 	// polecat branch, letting a polecat continue work on an existing PR.
@@ -245,6 +251,11 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 	if (slingResumeBranch != "" || slingResumePR != 0) && slingBaseBranch != "" {
 		return fmt.Errorf("--base-branch cannot be combined with --branch or --pr (resume implies starting on the existing branch)")
 	}
+	if slingTargetBranch != "" {
+		if err := validateBranchName(slingTargetBranch); err != nil {
+			return fmt.Errorf("invalid --target-branch: %w", err)
+		}
+	}
 	if slingResumePR != 0 {
 		resolved, err := resolvePRBranch(slingResumePR)
 		if err != nil {
@@ -295,6 +306,14 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 	}
 	townBeadsDir := filepath.Join(townRoot, ".beads")
 
+	// Validate --account up front so a typo fails before anything is
+	// scheduled or spawned, rather than at session start.
+	if slingAccount != "" {
+		if err := config.ValidateAccount(constants.MayorAccountsPath(townRoot), slingAccount); err != nil {
+			return fmt.Errorf("invalid --account: %w", err)
+		}
+	}
+
 	// Normalize target arguments: trim trailing slashes from target to handle tab-completion
 	// artifacts like "gt sling sl-123 slingshot/" → "gt sling sl-123 slingshot"
 	// This makes sling more forgiving without breaking existing functionality.
@@ -386,6 +405,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 				Merge:        slingMerge,
 				BaseBranch:   slingBaseBranch,
 				ResumeBranch: slingResumeBranch,
+				TargetBranch: slingTargetBranch,
 				NoConvoy:     slingNoConvoy,
 				Owned:        slingOwned,
 				DryRun:       slingDryRun,
@@ -395,6 +415,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 				Account:      slingAccount,
 				Agent:        slingAgent,
 				HookRawBead:  slingHookRawBead,
+				NoBoot:       slingNoBoot,
 				Ralph:        slingRalph,
 			})
 		}
@@ -427,6 +448,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 			Merge:        slingMerge,
 			BaseBranch:   slingBaseBranch,
 			ResumeBranch: slingResumeBranch,
+			TargetBranch: slingTargetBranch,
 			NoConvoy:     slingNoConvoy,
 			Owned:        slingOwned,
 			DryRun:       slingDryRun,
@@ -436,6 +458,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 			Account:      slingAccount,
 			Agent:        slingAgent,
 			HookRawBead:  slingHookRawBead,
+			NoBoot:       slingNoBoot,
 			Ralph:        slingRalph,
 		})
 	}
@@ -472,6 +495,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 				Merge:        slingMerge,
 				BaseBranch:   slingBaseBranch,
 				ResumeBranch: slingResumeBranch,
+				TargetBranch: slingTargetBranch,
 				NoConvoy:     slingNoConvoy,
 				Owned:        slingOwned,
 				DryRun:       slingDryRun,
@@ -481,6 +505,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 				Account:      slingAccount,
 				Agent:        slingAgent,
 				HookRawBead:  slingHookRawBead,
+				NoBoot:       slingNoBoot,
 				Ralph:        slingRalph,
 			})
 		}
@@ -513,6 +538,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 						HookRawBead: slingHookRawBead,
 						Force:       slingForce,
 						DryRun:      slingDryRun,
+						NoBoot:      slingNoBoot,
 					})
 				}
 				return runConvoySlingByID(args[0], convoyScheduleOpts{
@@ -532,6 +558,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 						HookRawBead: slingHookRawBead,
 						Force:       slingForce,
 						DryRun:      slingDryRun,
+						NoBoot:      slingNoBoot,
 					})
 				}
 				return runEpicSlingByID(args[0], epicScheduleOpts{
@@ -769,6 +796,10 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 	if slingResumeBranch != "" {
 		slingVars = append(slingVars, fmt.Sprintf("resume_branch=%s", slingResumeBranch))
 	}
+	// Inject target_branch var so gt done files the MR against --target-branch.
+	if slingTargetBranch != "" {
+		slingVars = append(slingVars, fmt.Sprintf("target_branch=%s", slingTargetBranch))
+	}
 
 	// Cross-rig guard: prevent slinging beads to polecats in the wrong rig (gt-myecw).
 	// Polecats work in their rig's worktree and cannot fix code owned by another rig.
@@ -846,8 +877,9 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 	var convoyID string
 	if !slingNoConvoy && formulaName == "" {
 		if slingDryRun {
-			fmt.Printf("Would create convoy 'Work: %s' if needed\n", info.Title)
-			fmt.Printf("Would add tracking relation to %s if needed\n", beadID)
+			if _, err := createAutoConvoy(beadID, info.Title, slingOwned, slingMerge, slingBaseBranch, true); err != nil {
+				fmt.Printf("%s Could not plan auto-convoy: %v\n", style.Dim.Render("Warning:"), err)
+			}
 			if slingMerge != "" {
 				fmt.Printf("Would set convoy merge strategy: %s\n", slingMerge)
 			}
@@ -855,7 +887,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 			existingConvoy := isTrackedByConvoy(beadID)
 			if existingConvoy == "" {
 				var err error
-				convoyID, err = createAutoConvoy(beadID, info.Title, slingOwned, slingMerge, slingBaseBranch)
+				convoyID, err = createAutoConvoy(beadID, info.Title, slingOwned, slingMerge, slingBaseBranch, false)
 				if err != nil {
 					// Log warning but don't fail - convoy is optional
 					fmt.Printf("%s Could not create auto-convoy: %v\n", style.Dim.Render("Warning:"), err)
@@ -1211,7 +1243,7 @@ func rawWorkflowFieldValues(info *beadInfo) (noMerge, reviewOnly bool, attachedA
 		return false, false, ""
 	}
 	issue := &beads.Issue{Description: info.Description}
-	fields := beads.ParseAttachmentFields(issue)
+	fields := issue.Attachment()
 	if fields == nil {
 		return false, false, ""
 	}
@@ -1224,7 +1256,7 @@ func restoreRollbackRawWorkflowFields(beadID, townRoot, hookWorkDir string, info
 	}
 	originalNoMerge, originalReviewOnly, originalAttachedAt := rawWorkflowFieldValues(originalInfo)
 	issue := &beads.Issue{Description: info.Description}
-	fields := beads.ParseAttachmentFields(issue)
+	fields := issue.Attachment()
 	if fields == nil {
 		if !originalNoMerge && !originalReviewOnly {
 			return false, nil
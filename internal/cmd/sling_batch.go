@@ -154,6 +154,7 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 			Vars:             slingVars,
 			Merge:            slingMerge,
 			BaseBranch:       slingBaseBranch,
+			TargetBranch:     slingTargetBranch,
 			Account:          slingAccount,
 			Agent:            slingAgent,
 			NoConvoy:         slingNoConvoy,
@@ -9,6 +9,8 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
 )
 
 // TestCreateBatchConvoy_CreatesOneConvoyTrackingAllBeads verifies that
@@ -73,7 +75,7 @@ exit 0
 	}
 
 	beadIDs := []string{"gt-aaa", "gt-bbb", "gt-ccc"}
-	convoyID, _, err := createBatchConvoy(beadIDs, "gastown", false, "mr", "")
+	convoyID, _, err := createBatchConvoy(beadIDs, "gastown", false, "mr", "", false)
 	if err != nil {
 		t.Fatalf("createBatchConvoy() error: %v", err)
 	}
@@ -182,7 +184,7 @@ exit 0
 		t.Fatalf("chdir: %v", err)
 	}
 
-	_, _, err = createBatchConvoy([]string{"gt-aaa"}, "gastown", true, "direct", "")
+	_, _, err = createBatchConvoy([]string{"gt-aaa"}, "gastown", true, "direct", "", false)
 	if err != nil {
 		t.Fatalf("createBatchConvoy() error: %v", err)
 	}
@@ -241,7 +243,7 @@ exit 0
 		t.Fatalf("chdir: %v", err)
 	}
 
-	_, _, err = createBatchConvoy([]string{"gt-aaa", "gt-bbb"}, "gastown", false, "direct", "")
+	_, _, err = createBatchConvoy([]string{"gt-aaa", "gt-bbb"}, "gastown", false, "direct", "", false)
 	if err != nil {
 		t.Fatalf("createBatchConvoy() error: %v", err)
 	}
@@ -262,7 +264,7 @@ exit 0
 // TestCreateBatchConvoy_EmptyBeadIDs verifies that createBatchConvoy returns
 // an error when called with no bead IDs.
 func TestCreateBatchConvoy_EmptyBeadIDs(t *testing.T) {
-	_, _, err := createBatchConvoy(nil, "gastown", false, "", "")
+	_, _, err := createBatchConvoy(nil, "gastown", false, "", "", false)
 	if err == nil {
 		t.Fatal("expected error for empty bead IDs, got nil")
 	}
@@ -311,7 +313,7 @@ exit 0
 		t.Fatalf("chdir: %v", err)
 	}
 
-	_, _, err = createBatchConvoy([]string{"gt-a", "gt-b", "gt-c", "gt-d", "gt-e"}, "myrig", false, "", "")
+	_, _, err = createBatchConvoy([]string{"gt-a", "gt-b", "gt-c", "gt-d", "gt-e"}, "myrig", false, "", "", false)
 	if err != nil {
 		t.Fatalf("createBatchConvoy() error: %v", err)
 	}
@@ -403,7 +405,7 @@ case "$cmd" in
 	}
 
 	// Should NOT return error — partial tracking is acceptable
-	convoyID, tracked, err := createBatchConvoy([]string{"gt-aaa", "gt-bbb", "gt-ccc"}, "gastown", false, "", "")
+	convoyID, tracked, err := createBatchConvoy([]string{"gt-aaa", "gt-bbb", "gt-ccc"}, "gastown", false, "", "", false)
 	if err != nil {
 		t.Fatalf("createBatchConvoy() should not error on partial dep failure: %v", err)
 	}
@@ -859,9 +861,11 @@ func TestConvoyInfo_IsOwnedDirect(t *testing.T) {
 		want bool
 	}{
 		{"nil receiver", nil, false},
-		{"owned + direct", &ConvoyInfo{Owned: true, MergeStrategy: "direct"}, true},
-		{"owned + mr", &ConvoyInfo{Owned: true, MergeStrategy: "mr"}, false},
-		{"not owned + direct", &ConvoyInfo{Owned: false, MergeStrategy: "direct"}, false},
+		{"owned + direct", &ConvoyInfo{Owned: true, MergeStrategy: beads.MergeStrategyDirect}, true},
+		{"owned + mr", &ConvoyInfo{Owned: true, MergeStrategy: beads.MergeStrategyMR}, false},
+		{"owned + local", &ConvoyInfo{Owned: true, MergeStrategy: beads.MergeStrategyLocal}, false},
+		{"owned + empty", &ConvoyInfo{Owned: true, MergeStrategy: ""}, false},
+		{"not owned + direct", &ConvoyInfo{Owned: false, MergeStrategy: beads.MergeStrategyDirect}, false},
 		{"not owned + empty", &ConvoyInfo{Owned: false, MergeStrategy: ""}, false},
 	}
 	for _, tc := range cases {
@@ -874,6 +878,30 @@ func TestConvoyInfo_IsOwnedDirect(t *testing.T) {
 	}
 }
 
+func TestConvoyInfo_EffectiveStrategy(t *testing.T) {
+	cases := []struct {
+		name string
+		info *ConvoyInfo
+		want string
+	}{
+		{"nil receiver", nil, beads.DefaultMergeStrategy},
+		{"empty", &ConvoyInfo{}, beads.DefaultMergeStrategy},
+		{"direct", &ConvoyInfo{MergeStrategy: beads.MergeStrategyDirect}, beads.MergeStrategyDirect},
+		{"mr", &ConvoyInfo{MergeStrategy: beads.MergeStrategyMR}, beads.MergeStrategyMR},
+		{"local", &ConvoyInfo{MergeStrategy: beads.MergeStrategyLocal}, beads.MergeStrategyLocal},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.info.EffectiveStrategy(); got != tc.want {
+				t.Errorf("EffectiveStrategy() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+	if beads.DefaultMergeStrategy != beads.MergeStrategyMR {
+		t.Errorf("DefaultMergeStrategy = %q, want %q", beads.DefaultMergeStrategy, beads.MergeStrategyMR)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // createAutoConvoy tests
 // ---------------------------------------------------------------------------
@@ -946,7 +974,7 @@ exit 0
 	}
 	t.Cleanup(func() { addTrackingRelationFn = oldAddTracking })
 
-	convoyID, err := createAutoConvoy("gt-aaa", "Fix the widget", false, "mr", "")
+	convoyID, err := createAutoConvoy("gt-aaa", "Fix the widget", false, "mr", "", false)
 	if err != nil {
 		t.Fatalf("createAutoConvoy() error: %v", err)
 	}
@@ -980,7 +1008,7 @@ exit 0
 // TestCreateAutoConvoy_FlagLikeTitleReturnsError verifies that a title starting
 // with "--" is rejected.
 func TestCreateAutoConvoy_FlagLikeTitleReturnsError(t *testing.T) {
-	_, err := createAutoConvoy("gt-aaa", "--verbose", false, "", "")
+	_, err := createAutoConvoy("gt-aaa", "--verbose", false, "", "", false)
 	if err == nil {
 		t.Fatal("expected error for flag-like title, got nil")
 	}
@@ -1007,7 +1035,7 @@ exit 0
 		t.Fatalf("rewrite bd stub: %v", err)
 	}
 
-	_, err := createAutoConvoy("gt-aaa", "My task", true, "direct", "")
+	_, err := createAutoConvoy("gt-aaa", "My task", true, "direct", "", false)
 	if err != nil {
 		t.Fatalf("createAutoConvoy() error: %v", err)
 	}
@@ -1055,7 +1083,7 @@ exit 0
 		t.Fatalf("rewrite bd stub: %v", err)
 	}
 
-	convoyID, err := createAutoConvoy("gt-aaa", "My task", false, "", "")
+	convoyID, err := createAutoConvoy("gt-aaa", "My task", false, "", "", false)
 	if err != nil {
 		t.Fatalf("expected no error (dep fail is non-fatal), got: %v", err)
 	}
@@ -1387,7 +1415,7 @@ case "$cmd" in
 		t.Fatalf("chdir: %v", err)
 	}
 
-	convoyID, tracked, err := createBatchConvoy([]string{"gt-aaa", "gt-bbb", "gt-ccc"}, "gastown", false, "", "")
+	convoyID, tracked, err := createBatchConvoy([]string{"gt-aaa", "gt-bbb", "gt-ccc"}, "gastown", false, "", "", false)
 	if err != nil {
 		t.Fatalf("createBatchConvoy() error: %v", err)
 	}
@@ -1988,3 +2016,63 @@ esac
 		t.Errorf("getConvoyInfoForIssue returned %+v, want nil for phantom convoy", got)
 	}
 }
+
+// TestConvoyCreation_DryRunRunsNoBd verifies that createAutoConvoy and
+// createBatchConvoy in dry-run mode print the bd commands they would run
+// without invoking bd or the tracking helper.
+func TestConvoyCreation_DryRunRunsNoBd(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows")
+	}
+
+	bdScript := `#!/bin/sh
+echo "CMD:$*" >> "LOGPATH"
+exit 0
+`
+	townRoot, logPath := setupTownWithBdStub(t, "")
+	bdScript = strings.ReplaceAll(bdScript, "LOGPATH", logPath)
+	if err := os.WriteFile(filepath.Join(townRoot, "bin", "bd"), []byte(bdScript), 0755); err != nil {
+		t.Fatalf("rewrite bd stub: %v", err)
+	}
+
+	oldAddTracking := addTrackingRelationFn
+	addTrackingRelationFn = func(townRoot, convoyID, issueID string) error {
+		t.Errorf("tracking helper called in dry-run for %s -> %s", convoyID, issueID)
+		return nil
+	}
+	t.Cleanup(func() { addTrackingRelationFn = oldAddTracking })
+
+	var autoID, batchID string
+	var tracked []string
+	output := captureStdout(t, func() {
+		var err error
+		autoID, err = createAutoConvoy("gt-aaa", "My task", false, "direct", "", true)
+		if err != nil {
+			t.Errorf("createAutoConvoy() dry-run error: %v", err)
+		}
+		batchID, tracked, err = createBatchConvoy([]string{"gt-bbb", "gt-ccc"}, "gastown", false, "", "", true)
+		if err != nil {
+			t.Errorf("createBatchConvoy() dry-run error: %v", err)
+		}
+	})
+
+	if _, err := os.Stat(logPath); err == nil {
+		logBytes, _ := os.ReadFile(logPath)
+		t.Fatalf("dry-run invoked bd:\n%s", logBytes)
+	}
+	if !strings.HasPrefix(autoID, "hq-cv-") || !strings.HasPrefix(batchID, "hq-cv-") {
+		t.Errorf("dry-run convoy IDs = %q, %q, want hq-cv- prefix", autoID, batchID)
+	}
+	if strings.Join(tracked, ",") != "gt-bbb,gt-ccc" {
+		t.Errorf("dry-run tracked = %v, want all beads", tracked)
+	}
+	for _, want := range []string{
+		"Would run: bd create --type=task --id=" + autoID,
+		"Would run: bd dep add " + autoID + " gt-aaa --type=tracks",
+		"Would run: bd dep add " + batchID + " gt-ccc --type=tracks",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("dry-run output missing %q:\n%s", want, output)
+		}
+	}
+}
@@ -119,7 +119,16 @@ type ConvoyInfo struct {
 // IsOwnedDirect returns true if the convoy is owned with direct merge strategy.
 // This is the key check for skipping witness/refinery merge pipeline.
 func (c *ConvoyInfo) IsOwnedDirect() bool {
-	return c != nil && c.Owned && c.MergeStrategy == "direct"
+	return c != nil && c.Owned && c.MergeStrategy == beads.MergeStrategyDirect
+}
+
+// EffectiveStrategy returns the convoy's merge strategy, normalizing an
+// unset strategy (or a nil ConvoyInfo) to beads.DefaultMergeStrategy.
+func (c *ConvoyInfo) EffectiveStrategy() string {
+	if c == nil || c.MergeStrategy == "" {
+		return beads.DefaultMergeStrategy
+	}
+	return c.MergeStrategy
 }
 
 // getConvoyInfoForIssue checks if an issue is tracked by a convoy and returns its info.
@@ -202,7 +211,7 @@ func getConvoyInfoFromIssue(issueID, cwd string) *ConvoyInfo {
 }
 
 func getConvoyInfoFromSourceIssue(issue *beads.Issue) *ConvoyInfo {
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	if attachment == nil || attachment.ConvoyID == "" {
 		return nil
 	}
@@ -214,6 +223,21 @@ func getConvoyInfoFromSourceIssue(issue *beads.Issue) *ConvoyInfo {
 	}
 }
 
+// trackedStatusIcon maps a tracked bead status to its display icon:
+// ● open, ✓ closed, ◆ hooked/pinned, ○ anything else.
+func trackedStatusIcon(status string) string {
+	switch status {
+	case "open":
+		return "●"
+	case "closed":
+		return "✓"
+	case "hooked", "pinned":
+		return "◆"
+	default:
+		return "○"
+	}
+}
+
 // printConvoyConflict prints detailed information about a bead that is already
 // tracked by another convoy, including all beads in that convoy with their
 // statuses, and recommended actions the user can take.
@@ -256,15 +280,7 @@ func printConvoyConflict(beadID, convoyID string) {
 			if t.ID == beadID {
 				marker = "→"
 			}
-			statusIcon := "○"
-			switch t.Status {
-			case "open":
-				statusIcon = "●"
-			case "closed":
-				statusIcon = "✓"
-			case "hooked", "pinned":
-				statusIcon = "◆"
-			}
+			statusIcon := trackedStatusIcon(t.Status)
 			title := t.Title
 			if title == "" {
 				title = "(no title)"
@@ -297,7 +313,9 @@ func printConvoyConflict(beadID, convoyID string) {
 // dep add failed should not reference a convoy that has no knowledge of it.
 // If owned is true, the convoy is marked with gt:owned label.
 // beadIDs must be non-empty. The convoy title uses the rig name and bead count.
-func createBatchConvoy(beadIDs []string, rigName string, owned bool, mergeStrategy, baseBranch string) (string, []string, error) {
+// If dryRun is true, the bd commands are printed instead of run and the
+// returned convoy ID is never created; every bead is reported as tracked.
+func createBatchConvoy(beadIDs []string, rigName string, owned bool, mergeStrategy, baseBranch string, dryRun bool) (string, []string, error) {
 	if len(beadIDs) == 0 {
 		return "", nil, fmt.Errorf("no beads to track")
 	}
@@ -330,6 +348,11 @@ func createBatchConvoy(beadIDs []string, rigName string, owned bool, mergeStrate
 		createArgs = append(createArgs, "--force")
 	}
 
+	if dryRun {
+		printConvoyDryRun(createArgs, convoyID, beadIDs)
+		return convoyID, append([]string(nil), beadIDs...), nil
+	}
+
 	// Use BdCmd with WithAutoCommit to ensure convoy is persisted even when
 	// gt sling has set BD_DOLT_AUTO_COMMIT=off globally (gt-9xum2 root cause fix).
 	if out, err := BdCmd(createArgs...).Dir(townBeads).WithAutoCommit().CombinedOutput(); err != nil {
@@ -354,9 +377,12 @@ func createBatchConvoy(beadIDs []string, rigName string, owned bool, mergeStrate
 // createAutoConvoy creates an auto-convoy for a single issue and tracks it.
 // If owned is true, the convoy is marked with the gt:owned label for caller-managed lifecycle.
 // mergeStrategy is optional: "direct", "mr", or "local" (empty = default mr).
-// Returns the created convoy ID.
-func createAutoConvoy(beadID, beadTitle string, owned bool, mergeStrategy, baseBranch string) (_ string, retErr error) {
-	defer func() { telemetry.RecordConvoyCreate(context.Background(), beadID, retErr) }()
+// Returns the created convoy ID. If dryRun is true, the bd commands are
+// printed instead of run and the returned convoy ID is never created.
+func createAutoConvoy(beadID, beadTitle string, owned bool, mergeStrategy, baseBranch string, dryRun bool) (_ string, retErr error) {
+	if !dryRun {
+		defer func() { telemetry.RecordConvoyCreate(context.Background(), beadID, retErr) }()
+	}
 	// Guard against flag-like titles propagating into convoy names (gt-e0kx5)
 	if beads.IsFlagLikeTitle(beadTitle) {
 		return "", fmt.Errorf("refusing to create convoy: bead title %q looks like a CLI flag", beadTitle)
@@ -393,6 +419,11 @@ func createAutoConvoy(beadID, beadTitle string, owned bool, mergeStrategy, baseB
 		createArgs = append(createArgs, "--force")
 	}
 
+	if dryRun {
+		printConvoyDryRun(createArgs, convoyID, []string{beadID})
+		return convoyID, nil
+	}
+
 	// Use BdCmd with WithAutoCommit to ensure convoy is persisted even when
 	// gt sling has set BD_DOLT_AUTO_COMMIT=off globally (gt-9xum2 root cause fix).
 	if out, err := BdCmd(createArgs...).Dir(townBeads).WithAutoCommit().CombinedOutput(); err != nil {
@@ -406,3 +437,33 @@ func createAutoConvoy(beadID, beadTitle string, owned bool, mergeStrategy, baseB
 
 	return convoyID, nil
 }
+
+// printConvoyDryRun prints the bd commands a convoy creation would run:
+// the create itself and one tracking dep add per bead.
+func printConvoyDryRun(createArgs []string, convoyID string, beadIDs []string) {
+	fmt.Printf("Would run: %s\n", formatBdCommand(createArgs))
+	for _, beadID := range beadIDs {
+		fmt.Printf("Would run: %s\n", formatBdCommand([]string{"dep", "add", convoyID, beadID, "--type=tracks"}))
+	}
+}
+
+// formatBdCommand renders a bd invocation for display. Arguments with
+// anything beyond shell-safe characters are single-quoted, so the line can be
+// copied into a POSIX shell as-is.
+func formatBdCommand(args []string) string {
+	parts := make([]string, 0, len(args)+1)
+	parts = append(parts, "bd")
+	for _, arg := range args {
+		parts = append(parts, shellQuoteArg(arg))
+	}
+	return strings.Join(parts, " ")
+}
+
+// shellQuoteArg returns arg unchanged when every character is shell-safe,
+// and otherwise wraps it in single quotes, escaping embedded single quotes.
+func shellQuoteArg(arg string) string {
+	if arg != "" && strings.Trim(arg, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_=./:,@%+") == "" {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
@@ -227,3 +227,17 @@ func TestSQLLegacyExternalDepTargetClauseEscapesUnderscore(t *testing.T) {
 		t.Fatalf("sqlLegacyExternalDepTargetClause() = %q, want %q", got, want)
 	}
 }
+
+func TestFormatBdCommand_ShellQuotesArgs(t *testing.T) {
+	got := formatBdCommand([]string{
+		"create",
+		"--id=hq-cv-abc",
+		"--title=Work: fix $HOME `x`",
+		"--description=it's\tdone",
+		"",
+	})
+	want := `bd create --id=hq-cv-abc '--title=Work: fix $HOME ` + "`x`" + `' '--description=it'\''s	done' ''`
+	if got != want {
+		t.Errorf("formatBdCommand() =\n  %s\nwant\n  %s", got, want)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/telemetry"
 )
 
 // SlingParams captures everything needed to sling one bead to a rig.
@@ -28,6 +29,7 @@ type SlingParams struct {
 	Merge        string   // --merge (convoy strategy)
 	BaseBranch   string   // --base-branch
 	ResumeBranch string   // --branch / --pr (resume existing PR branch, gh#3602)
+	TargetBranch string   // --target-branch (MR target at gt done)
 	Account      string   // --account
 	Agent        string   // --agent
 	NoConvoy     bool     // --no-convoy
@@ -55,6 +57,7 @@ type SlingResult struct {
 	Success          bool
 	ErrMsg           string
 	AttachedMolecule string
+	ConvoyID         string // Auto-convoy created by this dispatch ("" if none)
 }
 
 // executeSling performs the unified per-bead polecat/rig dispatch.
@@ -84,7 +87,12 @@ type SlingResult struct {
 //  10. Store fields in bead (dispatcher, args, attached_molecule, no_merge)
 //  11. Create Dolt branch
 //  12. Start polecat session
-func executeSling(params SlingParams) (*SlingResult, error) {
+func executeSling(params SlingParams) (result *SlingResult, retErr error) {
+	defer func() {
+		convoyCreated := result != nil && result.ConvoyID != ""
+		telemetry.RecordSlingDispatch(context.Background(), params.BeadID, params.RigName, convoyCreated, retErr)
+	}()
+
 	townRoot := params.TownRoot
 	if townRoot == "" {
 		var err error
@@ -110,7 +118,7 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 	}
 	workBeadsDir := resolveSlingMutationBeadsDir(beadsDir, townRoot, params.BeadID)
 
-	result := &SlingResult{
+	result = &SlingResult{
 		BeadID: params.BeadID,
 	}
 
@@ -273,11 +281,12 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 		existingConvoy := isTrackedByConvoy(params.BeadID)
 		if existingConvoy == "" {
 			var err error
-			convoyID, err = createAutoConvoy(params.BeadID, info.Title, params.Owned, params.Merge, params.BaseBranch)
+			convoyID, err = createAutoConvoy(params.BeadID, info.Title, params.Owned, params.Merge, params.BaseBranch, false)
 			if err != nil {
 				fmt.Printf("  %s Could not create auto-convoy: %v\n", style.Dim.Render("Warning:"), err)
 			} else {
 				fmt.Printf("  %s Created convoy %s\n", style.Bold.Render("→"), convoyID)
+				result.ConvoyID = convoyID
 			}
 		} else {
 			fmt.Printf("  %s Already tracked by convoy %s\n", style.Dim.Render("○"), existingConvoy)
@@ -305,13 +314,18 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 	beadToHook := params.BeadID
 	attachedMoleculeID := ""
 	var allVars []string
-	varsForAttachment := append([]string(nil), params.Vars...)
+	userVars := append([]string(nil), params.Vars...)
+	// Record target_branch so gt done files the MR against --target-branch.
+	if params.TargetBranch != "" {
+		userVars = append(userVars, fmt.Sprintf("target_branch=%s", params.TargetBranch))
+	}
+	varsForAttachment := append([]string(nil), userVars...)
 	formulaVarsForAttachment := strings.Join(varsForAttachment, "\n")
 	if params.FormulaName != "" && formulaCooked {
 		// Auto-inject rig command vars as defaults (user --var flags override)
 		rigCmdVars := loadRigCommandVars(townRoot, params.RigName)
 		// Build per-bead vars: rig defaults first, then user vars (higher priority)
-		allVars = append(rigCmdVars, params.Vars...)
+		allVars = append(rigCmdVars, userVars...)
 		if spawnInfo.BaseBranch != "" && spawnInfo.BaseBranch != "main" {
 			allVars = append(allVars, fmt.Sprintf("base_branch=%s", spawnInfo.BaseBranch))
 		}
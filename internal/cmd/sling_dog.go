@@ -266,7 +266,7 @@ func dogWorksOnHook(d *dog.Dog, work string, hooked *beads.Issue) bool {
 	if !dogWorksOn(d, work) || d.WorkStartedAt.IsZero() {
 		return false
 	}
-	fields := beads.ParseAttachmentFields(hooked)
+	fields := hooked.Attachment()
 	if fields == nil || fields.AttachedAt == "" {
 		return false
 	}
@@ -143,7 +143,7 @@ func newestHookedFormula(hookedBeads []*beads.Issue, formulaName string) *beads.
 	var newestAt time.Time
 	var newestHasAt bool
 	for _, bead := range hookedBeads {
-		fields := beads.ParseAttachmentFields(bead)
+		fields := bead.Attachment()
 		if fields == nil || fields.AttachedFormula != formulaName {
 			continue
 		}
@@ -193,7 +193,7 @@ func reusableHookedDogFormula(hookedBeads []*beads.Issue, formulaName string, re
 		if dogName == "" || strings.Contains(dogName, "/") {
 			continue
 		}
-		fields := beads.ParseAttachmentFields(bead)
+		fields := bead.Attachment()
 		if fields == nil || fields.AttachedFormula != formulaName {
 			continue
 		}
@@ -415,7 +415,7 @@ func runSlingFormula(ctx context.Context, args []string) (err error) {
 	}
 	if shouldReuseExistingFormula(existing, delayedDogInfo, slingForce) {
 		existingMode := ""
-		if fields := beads.ParseAttachmentFields(existing); fields != nil {
+		if fields := existing.Attachment(); fields != nil {
 			existingMode = fields.Mode
 		}
 		if existingMode != mode {
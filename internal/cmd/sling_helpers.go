@@ -211,7 +211,7 @@ func collectExistingMolecules(info *beadInfo) []string {
 
 	// Also check description's attached_molecule (may differ from bonds)
 	issue := &beads.Issue{Description: info.Description}
-	fields := beads.ParseAttachmentFields(issue)
+	fields := issue.Attachment()
 	if fields != nil && fields.AttachedMolecule != "" && !seen[fields.AttachedMolecule] {
 		seen[fields.AttachedMolecule] = true
 		molecules = append(molecules, fields.AttachedMolecule)
@@ -666,7 +666,7 @@ func storeFieldsInBeadFromTownRoot(townRoot, beadID string, updates beadFieldUpd
 	}
 
 	// Get or create attachment fields
-	fields := beads.ParseAttachmentFields(issue)
+	fields := issue.Attachment()
 	if fields == nil {
 		fields = &beads.AttachmentFields{}
 	}
@@ -1559,7 +1559,7 @@ func lookupPriorAttempt(beadsDir, issueID string) []string {
 
 	// Use the most recent MR (last in list) as the prior attempt.
 	prior := mrs[len(mrs)-1]
-	fields := beads.ParseMRFields(prior)
+	fields := prior.MRFields()
 	if fields == nil || fields.Branch == "" {
 		return nil
 	}
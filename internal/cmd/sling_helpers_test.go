@@ -110,6 +110,12 @@ func TestWakeRigAgentsDoesNotNudgeRefinery(t *testing.T) {
 func TestNudgeRefineryNoOpWithoutLog(t *testing.T) {
 	// Ensure test log is NOT set so we exercise the real tmux path
 	t.Setenv("GT_TEST_NUDGE_LOG", "")
+	// Run from a scratch town so the MQ_SUBMIT file event stays out of the tree.
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(townRoot)
 
 	// Should not panic even though no tmux session exists
 	nudgeRefinery("nonexistent-rig", "test message")
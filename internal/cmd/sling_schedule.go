@@ -51,6 +51,7 @@ type ScheduleOptions struct {
 	Merge        string   // Merge strategy: direct/mr/local
 	BaseBranch   string   // Override base branch for polecat worktree
 	ResumeBranch string   // Resume an existing branch (gh#3602); mutually exclusive with BaseBranch
+	TargetBranch string   // Branch the MR should target at gt done (default: rig default branch)
 	NoConvoy     bool     // Skip auto-convoy creation
 	Owned        bool     // Mark auto-convoy as caller-managed lifecycle
 	DryRun       bool     // Show what would be done without acting
@@ -60,6 +61,7 @@ type ScheduleOptions struct {
 	Account      string   // Claude Code account handle
 	Agent        string   // Agent override (e.g., "gemini", "codex")
 	HookRawBead  bool     // Hook raw bead without default formula
+	NoBoot       bool     // Skip waking rig agents after dispatch
 	Ralph        bool     // Ralph Wiggum loop mode
 }
 
@@ -134,23 +136,6 @@ func scheduleBead(beadID, rigName string, opts ScheduleOptions) error {
 		}
 	}
 
-	if opts.DryRun {
-		fmt.Printf("Would schedule %s → %s\n", beadID, rigName)
-		fmt.Printf("  Would create sling context bead\n")
-		if !opts.NoConvoy {
-			fmt.Printf("  Would create auto-convoy\n")
-		}
-		return nil
-	}
-
-	// Cook formula after dry-run check to avoid side effects
-	if opts.Formula != "" {
-		workDir := beads.ResolveHookDir(townRoot, beadID, "")
-		if err := CookFormula(opts.Formula, workDir, townRoot); err != nil {
-			return fmt.Errorf("formula %q failed to cook: %w", opts.Formula, err)
-		}
-	}
-
 	// Build sling context fields
 	fields := &capacity.SlingContextFields{
 		Version:    1,
@@ -176,6 +161,9 @@ func scheduleBead(beadID, rigName string, opts ScheduleOptions) error {
 	if opts.ResumeBranch != "" {
 		fields.ResumeBranch = opts.ResumeBranch
 	}
+	if opts.TargetBranch != "" {
+		fields.TargetBranch = opts.TargetBranch
+	}
 	fields.NoMerge = opts.NoMerge
 	fields.ReviewOnly = opts.ReviewOnly
 	if opts.Account != "" {
@@ -185,11 +173,36 @@ func scheduleBead(beadID, rigName string, opts ScheduleOptions) error {
 		fields.Agent = opts.Agent
 	}
 	fields.HookRawBead = opts.HookRawBead
+	fields.NoBoot = opts.NoBoot
 	if opts.Ralph {
 		fields.Mode = "ralph"
 	}
 	fields.Owned = opts.Owned
 
+	if err := capacity.ValidateOwnedConvoy(opts.Owned, opts.NoConvoy); err != nil {
+		return err
+	}
+	if err := fields.Validate(); err != nil {
+		return fmt.Errorf("invalid sling context for %s: %w", beadID, err)
+	}
+
+	if opts.DryRun {
+		fmt.Printf("Would schedule %s → %s\n", beadID, rigName)
+		fmt.Printf("  Would create sling context bead\n")
+		if !opts.NoConvoy {
+			fmt.Printf("  Would create auto-convoy\n")
+		}
+		return nil
+	}
+
+	// Cook formula after dry-run check to avoid side effects
+	if opts.Formula != "" {
+		workDir := beads.ResolveHookDir(townRoot, beadID, "")
+		if err := CookFormula(opts.Formula, workDir, townRoot); err != nil {
+			return fmt.Errorf("formula %q failed to cook: %w", opts.Formula, err)
+		}
+	}
+
 	// Create sling context bead in the target rig's beads dir so the rig's
 	// witness discovers it during patrol. (GH#3468)
 	ctxBead, err := rigBeads.CreateSlingContext(info.Title, beadID, fields)
@@ -201,7 +214,7 @@ func scheduleBead(beadID, rigName string, opts ScheduleOptions) error {
 	if !opts.NoConvoy {
 		existingConvoy := isTrackedByConvoy(beadID)
 		if existingConvoy == "" {
-			convoyID, err := createAutoConvoy(beadID, info.Title, opts.Owned, opts.Merge, opts.BaseBranch)
+			convoyID, err := createAutoConvoy(beadID, info.Title, opts.Owned, opts.Merge, opts.BaseBranch, false)
 			if err != nil {
 				fmt.Printf("%s Could not create auto-convoy: %v\n", style.Dim.Render("Warning:"), err)
 			} else {
@@ -249,6 +262,7 @@ func runBatchSchedule(beadIDs []string, rigName, townRoot string) error {
 			Merge:        slingMerge,
 			BaseBranch:   slingBaseBranch,
 			ResumeBranch: slingResumeBranch,
+			TargetBranch: slingTargetBranch,
 			DryRun:       false,
 			Force:        slingForce,
 			NoMerge:      slingNoMerge,
@@ -256,6 +270,7 @@ func runBatchSchedule(beadIDs []string, rigName, townRoot string) error {
 			Account:      slingAccount,
 			Agent:        slingAgent,
 			HookRawBead:  slingHookRawBead,
+			NoBoot:       slingNoBoot,
 			Ralph:        slingRalph,
 		})
 		if err != nil {
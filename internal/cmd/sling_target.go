@@ -142,6 +142,13 @@ type ResolvedTarget struct {
 	IsSelfSling       bool
 }
 
+// errNoBootTarget reports --no-boot used with a target that never spawns a
+// polecat. The flag only skips waking the rig's agents after a polecat spawn,
+// so it has no effect on self, dog, crew, or other non-worker targets.
+func errNoBootTarget(target string) error {
+	return fmt.Errorf("--no-boot only applies to rig or polecat targets, not %q", target)
+}
+
 // resolveTarget resolves a target specification to agent, pane, and working directory.
 // Handles: "." or empty (self), dog targets, rig targets (auto-spawn polecat),
 // existing agents (with dead polecat fallback).
@@ -150,6 +157,9 @@ func resolveTarget(target string, opts ResolveTargetOptions) (*ResolvedTarget, e
 
 	// Empty target or "." = self-sling
 	if target == "" || target == "." {
+		if opts.NoBoot {
+			return nil, errNoBootTarget("self")
+		}
 		agentID, pane, workDir, err := resolveSelfTarget()
 		if err != nil {
 			if target == "." {
@@ -166,6 +176,9 @@ func resolveTarget(target string, opts ResolveTargetOptions) (*ResolvedTarget, e
 
 	// Dog target
 	if dogName, isDog := IsDogTarget(target); isDog {
+		if opts.NoBoot {
+			return nil, errNoBootTarget(target)
+		}
 		if opts.DryRun {
 			if dogName == "" {
 				fmt.Printf("Would dispatch to idle dog in kennel\n")
@@ -299,6 +312,9 @@ func resolveTarget(target string, opts ResolveTargetOptions) (*ResolvedTarget, e
 		}
 		return nil, fmt.Errorf("resolving target: %w", err)
 	}
+	if opts.NoBoot && !isPolecatTarget(agentID) {
+		return nil, errNoBootTarget(target)
+	}
 	if opts.BeadID != "" && isPolecatTarget(agentID) {
 		parts := strings.Split(agentID, "/")
 		if len(parts) >= 3 && parts[1] == "polecats" {
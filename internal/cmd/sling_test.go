@@ -1555,6 +1555,32 @@ func TestResolveTargetCreateDoesNotSpawnCrewShorthandWhenPaneMissing(t *testing.
 	}
 }
 
+func TestResolveTargetNoBootRejectsNonWorkerTargets(t *testing.T) {
+	prevResolve := resolveTargetAgentFn
+	t.Cleanup(func() { resolveTargetAgentFn = prevResolve })
+	resolveTargetAgentFn = func(target string) (string, string, string, error) {
+		return "gastown/crew/max", "%1", "/tmp/crew", nil
+	}
+
+	for _, target := range []string{"", ".", "deacon/dogs", "gastown/crew/max"} {
+		_, err := resolveTarget(target, ResolveTargetOptions{NoBoot: true, DryRun: true})
+		if err == nil || !strings.Contains(err.Error(), "--no-boot only applies to rig or polecat targets") {
+			t.Errorf("resolveTarget(%q, NoBoot) error = %v, want --no-boot target error", target, err)
+		}
+	}
+
+	resolveTargetAgentFn = func(target string) (string, string, string, error) {
+		return "gastown/polecats/toast", "%2", "/tmp/toast", nil
+	}
+	got, err := resolveTarget("gastown/toast", ResolveTargetOptions{NoBoot: true})
+	if err != nil {
+		t.Fatalf("resolveTarget(polecat, NoBoot): %v", err)
+	}
+	if got.Agent != "gastown/polecats/toast" {
+		t.Fatalf("Agent = %q, want gastown/polecats/toast", got.Agent)
+	}
+}
+
 func TestTargetRigDatabaseLookupFailsClosedWithoutTownRoot(t *testing.T) {
 	err := verifyBeadExistsInTargetRigDatabase("gt-r2405", "gastown", "")
 	if err == nil {
@@ -2207,7 +2233,7 @@ exit /b 0
 
 	slingVars = []string{"version=1.2.3", "channel=stable"}
 	slingDryRun = false
-	slingNoBoot = true
+	slingNoBoot = false // --no-boot is rejected for self targets
 	slingRalph = true
 
 	if err := runSlingFormula(context.Background(), []string{"mol-anything"}); err != nil {
@@ -2300,7 +2326,7 @@ exit /b 0
 	})
 
 	slingDryRun = false
-	slingNoBoot = true
+	slingNoBoot = false // --no-boot is rejected for self targets
 	slingForce = false
 	findHookedFormulaSingletonFn = func(workDir, targetAgent, formulaName string) (*beads.Issue, error) {
 		return &beads.Issue{ID: "gt-wisp-existing"}, nil
@@ -2376,7 +2402,7 @@ exit /b 0
 	})
 
 	slingDryRun = false
-	slingNoBoot = true
+	slingNoBoot = false // --no-boot is rejected for self targets
 	slingForce = false
 	slingRalph = false
 	findHookedFormulaSingletonFn = func(workDir, targetAgent, formulaName string) (*beads.Issue, error) {
@@ -4604,8 +4630,11 @@ exit /b 0
 			t.Fatalf("dry-run invoked forbidden bd command %q in log:\n%s", fields[0], logBytes)
 		}
 	}
-	if !strings.Contains(stdout, "Would create convoy 'Work: Dry run issue' if needed") {
-		t.Fatalf("dry-run output missing conditional convoy plan:\n%s", stdout)
+	if !strings.Contains(stdout, "'--title=Work: Dry run issue'") {
+		t.Fatalf("dry-run output missing convoy create plan:\n%s", stdout)
+	}
+	if strings.Contains(stdout, "Would create convoy") {
+		t.Fatalf("dry-run output repeats the convoy plan:\n%s", stdout)
 	}
 	if !strings.Contains(stdout, "Would run: bd update gt-abc123 --status=hooked --assignee=gastown/crew/max") {
 		t.Fatalf("dry-run output missing hook plan:\n%s", stdout)
@@ -4950,3 +4979,25 @@ func TestResolveTargetSelfSlingByPane(t *testing.T) {
 		}
 	})
 }
+
+func TestRunSlingRejectsOwnedWithNoConvoy(t *testing.T) {
+	t.Setenv("GT_ROLE", "mayor")
+	t.Setenv("GT_POLECAT", "")
+
+	prevNoConvoy := slingNoConvoy
+	prevOwned := slingOwned
+	prevMerge := slingMerge
+	t.Cleanup(func() {
+		slingNoConvoy = prevNoConvoy
+		slingOwned = prevOwned
+		slingMerge = prevMerge
+	})
+	slingNoConvoy = true
+	slingOwned = true
+	slingMerge = ""
+
+	err := runSling(nil, []string{"gt-abc123", "gastown"})
+	if err == nil || !strings.Contains(err.Error(), "--no-convoy") {
+		t.Fatalf("runSling = %v, want --owned/--no-convoy rejection", err)
+	}
+}
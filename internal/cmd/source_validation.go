@@ -59,7 +59,7 @@ func validateMergeRequestSource(mr *beads.Issue, expectedIssueID string, expecte
 	if mr == nil {
 		return fmt.Errorf("merge request is missing")
 	}
-	fields := beads.ParseMRFields(mr)
+	fields := mr.MRFields()
 	if fields == nil || strings.TrimSpace(fields.SourceIssue) == "" {
 		return fmt.Errorf("merge request %s has missing source_issue", mr.ID)
 	}
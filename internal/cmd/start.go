@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -37,8 +38,22 @@ import (
 // already had time to shut down.
 const defaultOrphanGraceSecs = 5
 
+// shutdownAbortWindow is how long graceful shutdown waits after a first
+// Ctrl-C for a second one that aborts the shutdown entirely.
+const shutdownAbortWindow = 3 * time.Second
+
+// shutdownWaitResult reports how the graceful shutdown wait ended.
+type shutdownWaitResult int
+
+const (
+	shutdownWaitCompleted shutdownWaitResult = iota // full wait elapsed
+	shutdownWaitSkipped                             // interrupted once: kill now
+	shutdownWaitAborted                             // interrupted twice: do not kill
+)
+
 var (
 	startAll                    bool
+	startAttach                 bool
 	startAgentOverride          string
 	startCrewRig                string
 	startCrewAccount            string
@@ -53,6 +68,7 @@ var (
 	shutdownNuclear             bool
 	shutdownCleanupOrphans      bool
 	shutdownCleanupOrphansGrace int
+	shutdownDryRun              bool
 )
 
 var startCmd = &cobra.Command{
@@ -71,6 +87,10 @@ Crew shortcut:
   If a path like "rig/crew/name" is provided, starts that crew workspace.
   This is equivalent to 'gt start crew rig/name'.
 
+Use --attach to attach to the Mayor session once startup finishes
+(equivalent to running 'gt mayor attach'). It is ignored when not run
+from an interactive terminal.
+
 To stop Gas Town, use 'gt shutdown'.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runStart,
@@ -100,7 +120,11 @@ Shutdown levels (progressively more aggressive):
   --polecats-only - Only stop polecats (leaves infrastructure running)
 
 Use --force or --yes to skip confirmation prompt.
+Use --dry-run to preview the sessions and kill order without stopping anything.
 Use --graceful to allow agents time to save state before killing.
+  During the graceful wait, Ctrl-C skips the rest of the wait and stops
+  sessions immediately. A second Ctrl-C within a few seconds aborts the
+  shutdown without stopping anything.
 Use --nuclear to force cleanup even if polecats have uncommitted work (DANGER).
 Use --cleanup-orphans to use a longer grace period for orphan cleanup (default 60s).
 Use --cleanup-orphans-grace-secs to set that grace period.
@@ -133,6 +157,8 @@ Examples:
 func init() {
 	startCmd.Flags().BoolVarP(&startAll, "all", "a", false,
 		"Also start Witnesses and Refineries for all rigs")
+	startCmd.Flags().BoolVar(&startAttach, "attach", false,
+		"Attach to the Mayor session after starting")
 	startCmd.Flags().StringVar(&startAgentOverride, "agent", "", "Agent alias to run Mayor/Deacon with (overrides town default)")
 	startCmd.Flags().StringVar(&startCostTier, "cost-tier", "", "Ephemeral cost tier for this session (standard/economy/budget)")
 
@@ -159,6 +185,8 @@ func init() {
 		"Use longer grace period (--cleanup-orphans-grace-secs) for orphan cleanup instead of default 5s")
 	shutdownCmd.Flags().IntVar(&shutdownCleanupOrphansGrace, "cleanup-orphans-grace-secs", 60,
 		"Grace period in seconds between SIGTERM and SIGKILL when cleaning orphans (default 60)")
+	shutdownCmd.Flags().BoolVarP(&shutdownDryRun, "dry-run", "n", false,
+		"Show the sessions and kill order without stopping anything")
 
 	rootCmd.AddCommand(startCmd)
 	rootCmd.AddCommand(shutdownCmd)
@@ -285,8 +313,22 @@ func runStart(cmd *cobra.Command, args []string) error {
 		return coreErr
 	}
 
+	return finishStart(cmd)
+}
+
+// finishStart reports a successful start and, with --attach on an
+// interactive terminal, hands the terminal to the Mayor session.
+func finishStart(cmd *cobra.Command) error {
 	fmt.Println()
 	fmt.Printf("%s Gas Town is running\n", style.Bold.Render("✓"))
+
+	if startAttach {
+		if isStdinTerminal() {
+			return attachMayorAfterStart(cmd, nil)
+		}
+		fmt.Printf("  %s --attach ignored: not an interactive terminal\n", style.Dim.Render("○"))
+	}
+
 	fmt.Println()
 	fmt.Printf("  Attach to Mayor:  %s\n", style.Dim.Render("gt mayor attach"))
 	fmt.Printf("  Attach to Deacon: %s\n", style.Dim.Render("gt deacon attach"))
@@ -295,6 +337,10 @@ func runStart(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// attachMayorAfterStart attaches to the Mayor for gt start --attach.
+// Overridden in tests.
+var attachMayorAfterStart = runMayorAttach
+
 // startCoreAgents starts Mayor and Deacon sessions in parallel using the Manager pattern.
 // The mutex is used to synchronize output with other parallel startup operations.
 func startCoreAgents(townRoot string, agentOverride string, mu *sync.Mutex) error {
@@ -542,6 +588,11 @@ func runShutdown(cmd *cobra.Command, args []string) error {
 	}
 	fmt.Println()
 
+	if shutdownDryRun {
+		printShutdownDryRun(toStop, getMayorSessionName(), getDeaconSessionName())
+		return nil
+	}
+
 	// Confirmation prompt
 	if !shutdownYes && !shutdownForce {
 		fmt.Printf("Proceed with shutdown? [y/N] ")
@@ -603,6 +654,19 @@ func categorizeSessions(sessions []string) (toStop, preserved []string) {
 	return
 }
 
+// shutdownCancelledMsg retracts the Phase 2 shutdown request when the wait is
+// aborted.
+const shutdownCancelledMsg = "[SHUTDOWN CANCELLED] Gas Town is no longer shutting down. Do not /exit; continue your work."
+
+// notifyShutdownCancelled sends shutdownCancelledMsg to each session,
+// best-effort.
+func notifyShutdownCancelled(t *tmux.Tmux, gtSessions []string) {
+	fmt.Printf("  %s Sending shutdown cancellation to %d agent(s)...\n", style.Bold.Render("→"), len(gtSessions))
+	for _, sess := range gtSessions {
+		_ = t.SendKeys(sess, shutdownCancelledMsg) // best-effort notification
+	}
+}
+
 func runGracefulShutdown(t *tmux.Tmux, gtSessions []string, townRoot string) error {
 	fmt.Printf("Graceful shutdown of Gas Town (waiting up to %ds)...\n\n", shutdownWait)
 
@@ -624,18 +688,22 @@ func runGracefulShutdown(t *tmux.Tmux, gtSessions []string, townRoot string) err
 
 	// Phase 3: Wait for agents to complete handoff
 	fmt.Printf("\nPhase 3: Waiting %ds for agents to complete handoff...\n", shutdownWait)
-	fmt.Printf("  %s\n", style.Dim.Render("(Press Ctrl-C to force immediate shutdown)"))
-
-	// Wait with countdown
-	for remaining := shutdownWait; remaining > 0; remaining -= 5 {
-		if remaining < shutdownWait {
-			fmt.Printf("  %s %ds remaining...\n", style.Dim.Render("⏳"), remaining)
-		}
-		sleepTime := 5
-		if remaining < 5 {
-			sleepTime = remaining
-		}
-		time.Sleep(time.Duration(sleepTime) * time.Second)
+	fmt.Printf("  %s\n", style.Dim.Render("(Press Ctrl-C to force immediate shutdown, twice to abort)"))
+
+	interrupts := make(chan os.Signal, 2)
+	signal.Notify(interrupts, os.Interrupt)
+	result := waitForShutdownHandoff(time.Duration(shutdownWait)*time.Second, 5*time.Second, shutdownAbortWindow, interrupts)
+	signal.Stop(interrupts)
+
+	switch result {
+	case shutdownWaitAborted:
+		// Agents were already told to hand off and exit; tell them to carry
+		// on instead, though any that already exited stay down.
+		notifyShutdownCancelled(t, gtSessions)
+		fmt.Printf("\n%s Shutdown aborted; sessions were not terminated, but agents that already exited after the shutdown request stay down\n", style.Bold.Render("✗"))
+		return nil
+	case shutdownWaitSkipped:
+		fmt.Printf("  %s Wait interrupted, stopping sessions now\n", style.Bold.Render("→"))
 	}
 
 	// Phase 4: Kill sessions in correct order
@@ -676,6 +744,39 @@ func runGracefulShutdown(t *tmux.Tmux, gtSessions []string, townRoot string) err
 	return nil
 }
 
+// waitForShutdownHandoff waits up to wait, printing the remaining time every
+// tick. A value on interrupts ends the wait early: if a second value arrives
+// within abortWindow the result is shutdownWaitAborted, otherwise
+// shutdownWaitSkipped.
+func waitForShutdownHandoff(wait, tick, abortWindow time.Duration, interrupts <-chan os.Signal) shutdownWaitResult {
+	deadline := time.NewTimer(wait)
+	defer deadline.Stop()
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	remaining := wait
+	for {
+		select {
+		case <-deadline.C:
+			return shutdownWaitCompleted
+		case <-ticker.C:
+			remaining -= tick
+			if remaining > 0 {
+				fmt.Printf("  %s %ds remaining...\n", style.Dim.Render("⏳"), int(remaining.Seconds()))
+			}
+		case <-interrupts:
+			fmt.Printf("\n  %s Interrupted. Press Ctrl-C again within %ds to abort shutdown...\n",
+				style.Bold.Render("!"), int(abortWindow.Seconds()))
+			select {
+			case <-interrupts:
+				return shutdownWaitAborted
+			case <-time.After(abortWindow):
+				return shutdownWaitSkipped
+			}
+		}
+	}
+}
+
 func runImmediateShutdown(t *tmux.Tmux, gtSessions []string, townRoot string) error {
 	fmt.Println("Shutting down Gas Town...")
 
@@ -733,6 +834,41 @@ func runImmediateShutdown(t *tmux.Tmux, gtSessions []string, townRoot string) er
 // if the session no longer exists after the kill attempt).
 func killSessionsInOrder(t *tmux.Tmux, sessions []string, mayorSession, deaconSession string) int {
 	stopped := 0
+
+	// Helper to kill a session and verify it was stopped
+	killAndVerify := func(sess string) bool {
+		// Check if session exists before attempting to kill
+		exists, _ := t.HasSession(sess)
+		if !exists {
+			return false // Session already gone
+		}
+
+		// Attempt to kill the session and its processes
+		_ = t.KillSessionWithProcesses(sess)
+
+		// Verify the session is actually gone (ignore error, check existence)
+		// KillSessionWithProcesses might return an error even if it successfully
+		// killed the processes and the session auto-closed
+		stillExists, _ := t.HasSession(sess)
+		if !stillExists {
+			fmt.Printf("  %s %s stopped\n", style.Bold.Render("✓"), sess)
+			return true
+		}
+		return false
+	}
+
+	for _, sess := range shutdownKillOrder(sessions, mayorSession, deaconSession) {
+		if killAndVerify(sess) {
+			stopped++
+		}
+	}
+
+	return stopped
+}
+
+// shutdownKillOrder returns sessions in the order killSessionsInOrder stops
+// them: workers, refineries, witnesses, then Mayor, Boot, and Deacon.
+func shutdownKillOrder(sessions []string, mayorSession, deaconSession string) []string {
 	bootSession := session.BootSessionName()
 
 	// Build a set for O(1) lookup of town-level sessions
@@ -766,67 +902,41 @@ func killSessionsInOrder(t *tmux.Tmux, sessions []string, mayorSession, deaconSe
 		}
 	}
 
-	// Helper to kill a session and verify it was stopped
-	killAndVerify := func(sess string) bool {
-		// Check if session exists before attempting to kill
-		exists, _ := t.HasSession(sess)
-		if !exists {
-			return false // Session already gone
-		}
+	order := make([]string, 0, len(sessions))
+	order = append(order, polecats...)
+	order = append(order, refineries...)
+	order = append(order, witnesses...)
 
-		// Attempt to kill the session and its processes
-		_ = t.KillSessionWithProcesses(sess)
-
-		// Verify the session is actually gone (ignore error, check existence)
-		// KillSessionWithProcesses might return an error even if it successfully
-		// killed the processes and the session auto-closed
-		stillExists, _ := t.HasSession(sess)
-		if !stillExists {
-			fmt.Printf("  %s %s stopped\n", style.Bold.Render("✓"), sess)
-			return true
-		}
-		return false
-	}
-
-	// 1. Stop polecats and crew first (workers)
-	for _, sess := range polecats {
-		if killAndVerify(sess) {
-			stopped++
-		}
-	}
-
-	// 2. Stop refineries (work processors)
-	for _, sess := range refineries {
-		if killAndVerify(sess) {
-			stopped++
-		}
-	}
-
-	// 3. Stop witnesses (monitors)
-	for _, sess := range witnesses {
-		if killAndVerify(sess) {
-			stopped++
+	// Town sessions: Mayor, Boot, Deacon (matching TownSessions() order)
+	for _, sess := range []string{mayorSession, bootSession, deaconSession} {
+		if sessionSet[sess] {
+			order = append(order, sess)
 		}
 	}
+	return order
+}
 
-	// 4. Stop town sessions: Mayor, Boot, Deacon (matching TownSessions() order)
-	if sessionSet[mayorSession] {
-		if killAndVerify(mayorSession) {
-			stopped++
-		}
+// printShutdownDryRun prints the order in which shutdown would stop sessions
+// and the cleanup steps that would follow, without acting.
+func printShutdownDryRun(toStop []string, mayorSession, deaconSession string) {
+	fmt.Println("Dry run: no sessions will be stopped.")
+	fmt.Println()
+	fmt.Println("Kill order:")
+	for i, sess := range shutdownKillOrder(toStop, mayorSession, deaconSession) {
+		fmt.Printf("  %d. %s\n", i+1, sess)
 	}
-	if sessionSet[bootSession] {
-		if killAndVerify(bootSession) {
-			stopped++
-		}
+	fmt.Println()
+	fmt.Println("Then:")
+	if shutdownGraceful {
+		fmt.Printf("  - Agents are interrupted and given %ds to hand off before the kills\n", shutdownWait)
 	}
-	if sessionSet[deaconSession] {
-		if killAndVerify(deaconSession) {
-			stopped++
-		}
+	fmt.Println("  - Orphaned Claude processes are cleaned up")
+	if shutdownNuclear {
+		fmt.Println("  - Polecat worktrees and branches are removed, including uncommitted work")
+	} else {
+		fmt.Println("  - Polecat worktrees and branches are removed (polecats with uncommitted work are skipped)")
 	}
-
-	return stopped
+	fmt.Println("  - The daemon is stopped")
 }
 
 // cleanupPolecats removes polecat worktrees and branches for all rigs.
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestFinishStart_Attach(t *testing.T) {
+	oldAttach, oldTerminal, oldFlag := attachMayorAfterStart, isStdinTerminal, startAttach
+	t.Cleanup(func() {
+		attachMayorAfterStart, isStdinTerminal, startAttach = oldAttach, oldTerminal, oldFlag
+	})
+
+	var attached int
+	attachMayorAfterStart = func(*cobra.Command, []string) error {
+		attached++
+		return nil
+	}
+
+	tests := []struct {
+		name         string
+		attach       bool
+		terminal     bool
+		wantAttached int
+		wantOutput   string
+	}{
+		{"default prints hints", false, true, 0, "gt mayor attach"},
+		{"attach on terminal", true, true, 1, "Gas Town is running"},
+		{"attach without terminal", true, false, 0, "--attach ignored: not an interactive terminal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attached = 0
+			startAttach = tt.attach
+			isStdinTerminal = func() bool { return tt.terminal }
+
+			output := captureStdout(t, func() {
+				if err := finishStart(nil); err != nil {
+					t.Errorf("finishStart: %v", err)
+				}
+			})
+			if attached != tt.wantAttached {
+				t.Errorf("attach called %d times, want %d", attached, tt.wantAttached)
+			}
+			if !strings.Contains(output, tt.wantOutput) {
+				t.Errorf("output missing %q:\n%s", tt.wantOutput, output)
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -34,7 +36,7 @@ import (
 var statusJSON bool
 var statusFast bool
 var statusWatch bool
-var statusInterval int
+var statusInterval = statusWatchInterval(2 * time.Second)
 var statusVerbose bool
 
 var statusCmd = &cobra.Command{
@@ -48,7 +50,13 @@ var statusCmd = &cobra.Command{
 Shows town name, registered rigs, polecats, and witness status.
 
 Use --fast to skip mail lookups for faster execution.
-Use --watch to continuously refresh status at regular intervals.`,
+Use --watch to continuously refresh status at regular intervals. In watch
+mode, open convoy progress is shown below the agent tree.
+
+Examples:
+  gt status --watch                 # Refresh every 2s until Ctrl+C
+  gt status --watch --interval=5s   # Custom refresh interval
+  gt status -w -n 10                # Bare numbers are seconds`,
 	RunE: runStatus,
 }
 
@@ -56,7 +64,7 @@ func init() {
 	statusCmd.Flags().BoolVar(&statusJSON, "json", false, "Output as JSON")
 	statusCmd.Flags().BoolVar(&statusFast, "fast", false, "Skip mail lookups for faster execution")
 	statusCmd.Flags().BoolVarP(&statusWatch, "watch", "w", false, "Watch mode: refresh status continuously")
-	statusCmd.Flags().IntVarP(&statusInterval, "interval", "n", 2, "Refresh interval in seconds")
+	statusCmd.Flags().VarP(&statusInterval, "interval", "n", "Refresh interval for --watch (e.g. 5s, 1m; bare numbers are seconds)")
 	statusCmd.Flags().BoolVarP(&statusVerbose, "verbose", "v", false, "Show detailed multi-line output per agent")
 	rootCmd.AddCommand(statusCmd)
 }
@@ -74,6 +82,17 @@ type TownStatus struct {
 	Agents   []AgentRuntime `json:"agents"`             // Global agents (Mayor, Deacon)
 	Rigs     []RigStatus    `json:"rigs"`
 	Summary  StatusSum      `json:"summary"`
+
+	// RestartHealth lists agents the daemon won't restart yet, keyed by agent ID.
+	RestartHealth map[string]RestartHealth `json:"restart_health,omitempty"`
+}
+
+// RestartHealth describes an agent that is crash-looping or in restart backoff.
+type RestartHealth struct {
+	CrashLoop          bool  `json:"crash_loop"`
+	RestartCount       int   `json:"restart_count"`
+	CrashLoopThreshold int   `json:"crash_loop_threshold"`                // Restarts within the window that trigger a crash loop
+	BackoffRemaining   int64 `json:"backoff_remaining_seconds,omitempty"` // Seconds until the daemon may restart it
 }
 
 // ServiceInfo represents a background service status.
@@ -465,15 +484,22 @@ func runStatusWatch(_ *cobra.Command, _ []string) error {
 	if statusJSON {
 		return fmt.Errorf("--json and --watch cannot be used together")
 	}
-	if statusInterval <= 0 {
-		return fmt.Errorf("interval must be positive, got %d", statusInterval)
+	if err := statusInterval.validate(); err != nil {
+		return err
 	}
+	interval := time.Duration(statusInterval)
 
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 	defer signal.Stop(sigChan)
 
-	ticker := time.NewTicker(time.Duration(statusInterval) * time.Second)
+	// Redraw immediately on terminal resize so the frame reflows to the new
+	// width instead of showing a garbled layout until the next tick.
+	resizeChan := make(chan os.Signal, 1)
+	notifyResize(resizeChan)
+	defer signal.Stop(resizeChan)
+
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	isTTY := term.IsTerminal(int(os.Stdout.Fd()))
@@ -484,64 +510,83 @@ func runStatusWatch(_ *cobra.Command, _ []string) error {
 	// agents to appear as not running (empty bubbles).
 	var cachedStatus *TownStatus
 	var cachedAt time.Time
-	maxStale := time.Duration(statusInterval) * time.Second * 5
+	maxStale := interval * 5
+
+	// The last gathered frame, kept so a resize can re-render it at the new
+	// width without another round of tmux and beads subprocesses.
+	var (
+		status    TownStatus
+		err       error
+		usedCache bool
+		gathered  time.Time
+		convoys   []watchConvoyProgress
+	)
+	convoyCache := &watchConvoyCache{fetch: fetchWatchConvoyProgress}
+	redrawOnly := false
 
 	for {
+		if !redrawOnly {
+			gathered = time.Now()
+			status, err = gatherStatus()
+			usedCache = false
+
+			// On error, retry once before giving up.
+			if err != nil {
+				status, err = gatherStatus()
+			}
+
+			if err == nil {
+				// Detect degraded results: zero running agents when we
+				// previously had some. This indicates a transient tmux
+				// failure rather than all agents legitimately stopping.
+				running := countRunningAgents(status)
+				if running == 0 && cachedStatus != nil &&
+					countRunningAgents(*cachedStatus) > 0 {
+					// Retry once to confirm.
+					retry, retryErr := gatherStatus()
+					if retryErr == nil &&
+						countRunningAgents(retry) > 0 {
+						status = retry
+					} else if time.Since(cachedAt) < maxStale {
+						status = *cachedStatus
+						usedCache = true
+					}
+				}
+			} else if cachedStatus != nil &&
+				time.Since(cachedAt) < maxStale {
+				// Complete failure even after retry — use cache.
+				status = *cachedStatus
+				usedCache = true
+				err = nil
+			}
+
+			if err == nil {
+				if !usedCache {
+					statusCopy := status
+					cachedStatus = &statusCopy
+					cachedAt = time.Now()
+				}
+				convoys = convoyCache.get(status.Location, time.Now())
+			}
+		}
+
 		var buf bytes.Buffer
 
 		if isTTY {
 			buf.WriteString("\033[H\033[2J") // ANSI: cursor home + clear screen
 		}
 
-		timestamp := time.Now().Format("15:04:05")
-		header := fmt.Sprintf("[%s] gt status --watch (every %ds, Ctrl+C to stop)", timestamp, statusInterval)
+		timestamp := gathered.Format("15:04:05")
+		header := fmt.Sprintf("[%s] gt status --watch (every %s, Ctrl+C to stop)", timestamp, interval)
 		if isTTY {
 			fmt.Fprintf(&buf, "%s\n\n", style.Dim.Render(header))
 		} else {
 			fmt.Fprintf(&buf, "%s\n\n", header)
 		}
 
-		status, err := gatherStatus()
-		usedCache := false
-
-		// On error, retry once before giving up.
-		if err != nil {
-			status, err = gatherStatus()
-		}
-
-		if err == nil {
-			// Detect degraded results: zero running agents when we
-			// previously had some. This indicates a transient tmux
-			// failure rather than all agents legitimately stopping.
-			running := countRunningAgents(status)
-			if running == 0 && cachedStatus != nil &&
-				countRunningAgents(*cachedStatus) > 0 {
-				// Retry once to confirm.
-				retry, retryErr := gatherStatus()
-				if retryErr == nil &&
-					countRunningAgents(retry) > 0 {
-					status = retry
-				} else if time.Since(cachedAt) < maxStale {
-					status = *cachedStatus
-					usedCache = true
-				}
-			}
-		} else if cachedStatus != nil &&
-			time.Since(cachedAt) < maxStale {
-			// Complete failure even after retry — use cache.
-			status = *cachedStatus
-			usedCache = true
-			err = nil
-		}
-
 		if err != nil {
 			fmt.Fprintf(&buf, "Error: %v\n", err)
 		} else {
-			if !usedCache {
-				statusCopy := status
-				cachedStatus = &statusCopy
-				cachedAt = time.Now()
-			}
 			if usedCache {
 				staleNote := fmt.Sprintf(
 					"(using cached data from %s)",
@@ -557,6 +602,7 @@ func runStatusWatch(_ *cobra.Command, _ []string) error {
 			if err := outputStatusText(&buf, status); err != nil {
 				fmt.Fprintf(&buf, "Error: %v\n", err)
 			}
+			renderWatchConvoyProgress(&buf, convoys)
 		}
 
 		// Write the entire frame atomically to prevent the terminal from
@@ -569,11 +615,134 @@ func runStatusWatch(_ *cobra.Command, _ []string) error {
 				fmt.Println("\nStopped.")
 			}
 			return nil
+		case <-resizeChan:
+			redrawOnly = true
 		case <-ticker.C:
+			redrawOnly = false
 		}
 	}
 }
 
+// minStatusWatchInterval keeps --watch from hammering tmux and beads; each
+// frame spawns many subprocesses.
+const minStatusWatchInterval = 500 * time.Millisecond
+
+// statusWatchInterval is the --interval flag value. It accepts Go durations ("5s",
+// "1m") and, for backward compatibility, bare integers meaning seconds.
+type statusWatchInterval time.Duration
+
+func (w *statusWatchInterval) String() string { return time.Duration(*w).String() }
+
+func (w *statusWatchInterval) Type() string { return "duration" }
+
+func (w *statusWatchInterval) Set(s string) error {
+	s = strings.TrimSpace(s)
+	if secs, err := strconv.Atoi(s); err == nil {
+		*w = statusWatchInterval(time.Duration(secs) * time.Second)
+		return nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid interval %q (use e.g. 5s, 1m, or seconds)", s)
+	}
+	*w = statusWatchInterval(d)
+	return nil
+}
+
+func (w statusWatchInterval) validate() error {
+	d := time.Duration(w)
+	if d <= 0 {
+		return fmt.Errorf("interval must be positive, got %s", d)
+	}
+	if d < minStatusWatchInterval {
+		return fmt.Errorf("interval %s is too short (minimum %s)", d, minStatusWatchInterval)
+	}
+	return nil
+}
+
+// watchConvoyTTL is how long --watch reuses convoy progress before querying
+// beads again. Progress needs one bd round-trip per open convoy, far more
+// than the rest of a frame, and convoys move slowly next to the tick rate.
+const watchConvoyTTL = 30 * time.Second
+
+// watchConvoyProgress is one open convoy's line in the watch frame.
+type watchConvoyProgress struct {
+	ID        string
+	Title     string
+	Completed int
+	Total     int
+	Err       bool // tracked issues could not be read
+}
+
+// watchConvoyCache holds the last convoy progress fetched for --watch and
+// refreshes it at most once per watchConvoyTTL.
+type watchConvoyCache struct {
+	fetch     func(townRoot string) []watchConvoyProgress
+	townRoot  string
+	fetchedAt time.Time
+	progress  []watchConvoyProgress
+}
+
+// get returns convoy progress for townRoot, fetching it when the cached copy
+// is missing, older than watchConvoyTTL, or for a different town.
+func (c *watchConvoyCache) get(townRoot string, now time.Time) []watchConvoyProgress {
+	if townRoot == c.townRoot && !c.fetchedAt.IsZero() && now.Sub(c.fetchedAt) < watchConvoyTTL {
+		return c.progress
+	}
+	c.townRoot = townRoot
+	c.fetchedAt = now
+	c.progress = c.fetch(townRoot)
+	return c.progress
+}
+
+// fetchWatchConvoyProgress queries beads for each open convoy's progress.
+// Failures are silent: convoy progress is supplementary and must not blank
+// the rest of the frame.
+func fetchWatchConvoyProgress(townRoot string) []watchConvoyProgress {
+	if townRoot == "" {
+		return nil
+	}
+	townBeads := filepath.Join(townRoot, ".beads")
+	convoys, err := listConvoyIssues(townBeads, "open", false)
+	if err != nil || len(convoys) == 0 {
+		return nil
+	}
+
+	progress := make([]watchConvoyProgress, 0, len(convoys))
+	for _, c := range convoys {
+		p := watchConvoyProgress{ID: c.ID, Title: c.Title}
+		tracked, err := getTrackedIssues(townBeads, c.ID)
+		if err != nil {
+			p.Err = true
+		}
+		for _, t := range tracked {
+			if t.Status == "closed" {
+				p.Completed++
+			}
+		}
+		p.Total = len(tracked)
+		progress = append(progress, p)
+	}
+	return progress
+}
+
+// renderWatchConvoyProgress appends a compact progress line per open convoy
+// to the watch frame.
+func renderWatchConvoyProgress(w io.Writer, convoys []watchConvoyProgress) {
+	if len(convoys) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Convoys:"))
+	for _, c := range convoys {
+		if c.Err {
+			fmt.Fprintf(w, "  🚚 %s: %s %s\n", c.ID, c.Title, style.Dim.Render("(progress unavailable)"))
+			continue
+		}
+		fmt.Fprintf(w, "  🚚 %s: %s (%d/%d)\n", c.ID, c.Title, c.Completed, c.Total)
+	}
+}
+
 // countRunningAgents returns the number of agents with Running=true
 // across all global agents and rig agents in the status.
 func countRunningAgents(s TownStatus) int {
@@ -808,6 +977,9 @@ func gatherStatus() (TownStatus, error) {
 	if daemonRunning, daemonPid, err := daemon.IsRunning(townRoot); err == nil {
 		status.Daemon = &ServiceInfo{Running: daemonRunning, PID: daemonPid}
 	}
+	if rt, err := daemon.LoadRestartTracker(townRoot); err == nil {
+		status.RestartHealth = collectRestartHealth(rt)
+	}
 
 	// Dolt status
 	doltCfg := doltserver.DefaultConfig(townRoot)
@@ -982,6 +1154,54 @@ func gatherStatus() (TownStatus, error) {
 	return status, nil
 }
 
+// collectRestartHealth returns the agents that are crash-looping or still in
+// restart backoff. Healthy agents are omitted.
+func collectRestartHealth(rt *daemon.RestartTracker) map[string]RestartHealth {
+	var health map[string]RestartHealth
+	for _, id := range rt.AgentIDs() {
+		crashLoop := rt.IsInCrashLoop(id)
+		remaining := rt.GetBackoffRemaining(id)
+		if !crashLoop && remaining <= 0 {
+			continue
+		}
+		if health == nil {
+			health = make(map[string]RestartHealth)
+		}
+		health[id] = RestartHealth{
+			CrashLoop:          crashLoop,
+			RestartCount:       rt.RestartCount(id),
+			CrashLoopThreshold: rt.CrashLoopCount(),
+			BackoffRemaining:   int64(remaining.Round(time.Second) / time.Second),
+		}
+	}
+	return health
+}
+
+// renderRestartHealth prints agents the daemon is holding back from restart.
+func renderRestartHealth(w io.Writer, health map[string]RestartHealth) {
+	if len(health) == 0 {
+		return
+	}
+	ids := make([]string, 0, len(health))
+	for id := range health {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	fmt.Fprintf(w, "%s\n", style.Bold.Render("Restart health:"))
+	for _, id := range ids {
+		h := health[id]
+		if h.CrashLoop {
+			fmt.Fprintf(w, "  %s %s %s\n", style.ErrorPrefix, id,
+				style.Dim.Render(fmt.Sprintf("crash loop (%d restarts, threshold %d) — gt daemon clear-backoff %s", h.RestartCount, h.CrashLoopThreshold, id)))
+			continue
+		}
+		fmt.Fprintf(w, "  %s %s %s\n", style.WarningPrefix, id,
+			style.Dim.Render(fmt.Sprintf("restart backoff, %s remaining", formatDuration(time.Duration(h.BackoffRemaining)*time.Second))))
+	}
+	fmt.Fprintln(w)
+}
+
 func outputStatusJSON(status TownStatus) error {
 	enc := json.NewEncoder(os.Stdout)
 	enc.SetIndent("", "  ")
@@ -1072,6 +1292,8 @@ func outputStatusText(w io.Writer, status TownStatus) error {
 		fmt.Fprintln(w)
 	}
 
+	renderRestartHealth(w, status.RestartHealth)
+
 	// Role icons - uses centralized emojis from constants package
 	roleIcons := map[string]string{
 		constants.RoleMayor:    constants.EmojiMayor,
@@ -1590,7 +1812,7 @@ func resolveHookFromMap(allHandoffs map[string]*beads.Issue, role, agentAddress,
 		return hook
 	}
 
-	attachment := beads.ParseAttachmentFields(handoff)
+	attachment := handoff.Attachment()
 	if attachment != nil && attachment.AttachedMolecule != "" {
 		hook.HasWork = true
 		hook.Molecule = attachment.AttachedMolecule
@@ -1965,7 +2187,7 @@ func getAgentHook(b *beads.Beads, role, agentAddress, roleType string) AgentHook
 	}
 
 	// Check for attachment
-	attachment := beads.ParseAttachmentFields(handoff)
+	attachment := handoff.Attachment()
 	if attachment != nil && attachment.AttachedMolecule != "" {
 		hook.HasWork = true
 		hook.Molecule = attachment.AttachedMolecule
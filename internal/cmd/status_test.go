@@ -2,14 +2,17 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/daemon"
 	"github.com/steveyegge/gastown/internal/rig"
 )
 
@@ -270,6 +273,53 @@ func TestRunStatusWatch_RejectsJSONCombo(t *testing.T) {
 	}
 }
 
+func TestWatchInterval_Set(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"5s", 5 * time.Second, false},
+		{"1m", time.Minute, false},
+		{"750ms", 750 * time.Millisecond, false},
+		{"3", 3 * time.Second, false}, // bare integers are seconds (legacy -n 3)
+		{" 10 ", 10 * time.Second, false},
+		{"fast", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		var w statusWatchInterval
+		err := w.Set(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Set(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && time.Duration(w) != tt.want {
+			t.Errorf("Set(%q) = %s, want %s", tt.in, time.Duration(w), tt.want)
+		}
+	}
+}
+
+func TestRunStatusWatch_RejectsTooShortInterval(t *testing.T) {
+	oldInterval := statusInterval
+	oldWatch := statusWatch
+	defer func() {
+		statusInterval = oldInterval
+		statusWatch = oldWatch
+	}()
+
+	statusInterval = statusWatchInterval(10 * time.Millisecond)
+	statusWatch = true
+
+	err := runStatusWatch(nil, nil)
+	if err == nil {
+		t.Fatal("expected error for sub-minimum interval, got nil")
+	}
+	if !strings.Contains(err.Error(), "too short") {
+		t.Errorf("error %q should mention 'too short'", err.Error())
+	}
+}
+
 func TestTryStatusDetailLockContention(t *testing.T) {
 	townRoot := t.TempDir()
 
@@ -573,3 +623,98 @@ func TestExtractBaseName(t *testing.T) {
 		})
 	}
 }
+
+func TestCollectRestartHealth(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "daemon"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	state := daemon.RestartState{Agents: map[string]*daemon.AgentRestartInfo{
+		"deacon":           {RestartCount: 6, CrashLoopSince: time.Now()},
+		"gastown-witness":  {RestartCount: 2, BackoffUntil: time.Now().Add(2 * time.Minute)},
+		"gastown-refinery": {RestartCount: 1, BackoffUntil: time.Now().Add(-time.Minute)},
+	}}
+	data, _ := json.Marshal(state)
+	if err := os.WriteFile(filepath.Join(townRoot, "daemon", "restart_state.json"), data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	rt, err := daemon.LoadRestartTracker(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	health := collectRestartHealth(rt)
+
+	if len(health) != 2 {
+		t.Fatalf("got %d entries, want 2 (healthy refinery omitted): %+v", len(health), health)
+	}
+	if h := health["deacon"]; !h.CrashLoop || h.RestartCount != 6 {
+		t.Errorf("deacon = %+v, want crash loop with 6 restarts", h)
+	}
+	if h := health["gastown-witness"]; h.CrashLoop || h.BackoffRemaining < 100 || h.BackoffRemaining > 120 {
+		t.Errorf("witness = %+v, want ~120s backoff", h)
+	}
+
+	var buf bytes.Buffer
+	renderRestartHealth(&buf, health)
+	out := buf.String()
+	for _, want := range []string{"Restart health:", "deacon", "crash loop", "gt daemon clear-backoff deacon", "gastown-witness", "remaining"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderRestartHealth_EmptyPrintsNothing(t *testing.T) {
+	var buf bytes.Buffer
+	renderRestartHealth(&buf, nil)
+	if buf.Len() != 0 {
+		t.Errorf("expected no output, got %q", buf.String())
+	}
+}
+
+func TestWatchConvoyCache_RefreshesOnlyAfterTTL(t *testing.T) {
+	fetches := 0
+	cache := &watchConvoyCache{fetch: func(string) []watchConvoyProgress {
+		fetches++
+		return []watchConvoyProgress{{ID: "hq-cv1", Title: "Work", Completed: fetches, Total: 3}}
+	}}
+
+	start := time.Now()
+	cache.get("/town", start)
+	cache.get("/town", start.Add(watchConvoyTTL/2))
+	if fetches != 1 {
+		t.Fatalf("fetches within TTL = %d, want 1", fetches)
+	}
+
+	got := cache.get("/town", start.Add(watchConvoyTTL))
+	if fetches != 2 || got[0].Completed != 2 {
+		t.Fatalf("after TTL: fetches = %d, progress = %+v, want a refresh", fetches, got)
+	}
+
+	cache.get("/other", start.Add(watchConvoyTTL))
+	if fetches != 3 {
+		t.Fatalf("fetches after town change = %d, want 3", fetches)
+	}
+}
+
+func TestRenderWatchConvoyProgress(t *testing.T) {
+	var buf bytes.Buffer
+	renderWatchConvoyProgress(&buf, []watchConvoyProgress{
+		{ID: "hq-cv1", Title: "Work", Completed: 1, Total: 3},
+		{ID: "hq-cv2", Title: "Broken", Err: true},
+	})
+	out := buf.String()
+	if !strings.Contains(out, "hq-cv1: Work (1/3)") {
+		t.Errorf("missing progress line:\n%s", out)
+	}
+	if !strings.Contains(out, "hq-cv2: Broken") || !strings.Contains(out, "progress unavailable") {
+		t.Errorf("missing unavailable line:\n%s", out)
+	}
+
+	buf.Reset()
+	renderWatchConvoyProgress(&buf, nil)
+	if buf.Len() != 0 {
+		t.Errorf("no convoys should render nothing, got %q", buf.String())
+	}
+}
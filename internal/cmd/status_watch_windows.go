@@ -0,0 +1,9 @@
+//go:build windows
+
+package cmd
+
+import "os"
+
+// notifyResize is a no-op on Windows since SIGWINCH is not available.
+// The next tick redraws at the new size.
+func notifyResize(ch chan<- os.Signal) {}
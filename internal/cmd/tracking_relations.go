@@ -76,7 +76,7 @@ func fallbackTrackingRelation(townRoot, trackerID, issueID string, add bool, sto
 		args = []string{"dep", "remove", trackerID, targetID, "--type=tracks"}
 	}
 
-	if out, err := BdCmd(args...).Dir(townRoot).WithAutoCommit().StripBeadsDir().CombinedOutput(); err != nil {
+	if out, err := BdCmd(args...).Dir(townRoot).WithAutoCommit().StripBeadsDir().WithRetry(bdTransientRetries, bdTransientBackoff).WithTimeout(bdMutationTimeout).CombinedOutput(); err != nil {
 		output := strings.TrimSpace(string(out))
 		if output == "" {
 			return fmt.Errorf("tracking relation via store failed: %w; fallback sql path failed: %v; fallback bd path failed: %w", storeErr, sqlErr, err)
@@ -647,6 +647,16 @@ func LoadAgentRegistry(path string) error {
 	return loadAgentRegistryFromPathLocked(path)
 }
 
+// ReloadAgentRegistry re-reads agent definitions from path even if it was
+// loaded before, so edits made while the process is running take effect.
+// Definitions are merged over the current registry like LoadAgentRegistry.
+func ReloadAgentRegistry(path string) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	delete(loadedPaths, path)
+	return loadAgentRegistryFromPathLocked(path)
+}
+
 // DefaultAgentRegistryPath returns the default path for agent registry.
 // Located alongside other town settings.
 func DefaultAgentRegistryPath(townRoot string) string {
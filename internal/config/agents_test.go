@@ -222,6 +222,49 @@ func TestLoadAgentRegistry(t *testing.T) {
 	ResetRegistryForTesting()
 }
 
+func TestReloadAgentRegistryRereadsCachedPath(t *testing.T) {
+	ResetRegistryForTesting()
+	t.Cleanup(ResetRegistryForTesting)
+
+	configPath := filepath.Join(t.TempDir(), "agents.json")
+	write := func(command string) {
+		t.Helper()
+		data, err := json.Marshal(AgentRegistry{
+			Version: CurrentAgentRegistryVersion,
+			Agents: map[string]*AgentPresetInfo{
+				"my-agent": {Name: "my-agent", Command: command},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(configPath, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("v1")
+	if err := LoadAgentRegistry(configPath); err != nil {
+		t.Fatalf("LoadAgentRegistry: %v", err)
+	}
+
+	write("v2")
+	// LoadAgentRegistry caches the path, so the edit is not seen...
+	if err := LoadAgentRegistry(configPath); err != nil {
+		t.Fatalf("LoadAgentRegistry: %v", err)
+	}
+	if got := GetAgentPresetByName("my-agent").Command; got != "v1" {
+		t.Fatalf("Command after cached load = %q, want v1", got)
+	}
+	// ...but ReloadAgentRegistry re-reads it.
+	if err := ReloadAgentRegistry(configPath); err != nil {
+		t.Fatalf("ReloadAgentRegistry: %v", err)
+	}
+	if got := GetAgentPresetByName("my-agent").Command; got != "v2" {
+		t.Errorf("Command after reload = %q, want v2", got)
+	}
+}
+
 func TestGetProcessNamesRespectsRegistryOverride(t *testing.T) {
 	// Regression test: settings/agents.json overrides must be visible to
 	// GetProcessNames so that liveness checks (IsAgentAlive, daemon heartbeat,
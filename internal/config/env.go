@@ -58,6 +58,11 @@ type AgentEnvConfig struct {
 	// RuntimeConfigDir is the optional CLAUDE_CONFIG_DIR path
 	RuntimeConfigDir string
 
+	// Account is the account handle the agent runs under (e.g., "teamB").
+	// Sets GT_ACCOUNT so gt commands run inside the session resolve the
+	// same account's credentials.
+	Account string
+
 	// SessionIDEnv is the environment variable name that holds the session ID.
 	// Sets GT_SESSION_ID_ENV so the runtime knows where to find the session ID.
 	SessionIDEnv string
@@ -178,6 +183,11 @@ func AgentEnv(cfg AgentEnvConfig) map[string]string {
 		env["CLAUDE_CONFIG_DIR"] = cfg.RuntimeConfigDir
 	}
 
+	// Record the account handle alongside its config dir
+	if cfg.Account != "" {
+		env["GT_ACCOUNT"] = cfg.Account
+	}
+
 	// Add session ID env var name if provided
 	if cfg.SessionIDEnv != "" {
 		env["GT_SESSION_ID_ENV"] = cfg.SessionIDEnv
@@ -405,6 +415,64 @@ func AgentEnv(cfg AgentEnvConfig) map[string]string {
 	return env
 }
 
+// EnvMismatchKind classifies how an actual environment differs from AgentEnv.
+type EnvMismatchKind string
+
+const (
+	// EnvMissing means the key is absent but a non-empty value is expected.
+	EnvMissing EnvMismatchKind = "missing"
+
+	// EnvUnset means the key is absent and the expected value is empty. The
+	// variable reads as empty either way, but a tmux session without the key
+	// inherits it from the global environment, so fixes still pin it.
+	EnvUnset EnvMismatchKind = "unset"
+
+	// EnvWrong means the key is present with a different value.
+	EnvWrong EnvMismatchKind = "wrong"
+
+	// EnvBeadsDir means BEADS_DIR is set to a non-empty value. It overrides
+	// bd's prefix-based routing and breaks multi-rig lookups, so it is
+	// reported separately from an ordinary wrong value.
+	EnvBeadsDir EnvMismatchKind = "beads_dir"
+)
+
+// EnvMismatch is one difference between an expected and actual environment.
+type EnvMismatch struct {
+	Key      string
+	Kind     EnvMismatchKind
+	Expected string
+	Actual   string // empty when the key is absent
+}
+
+// DiffEnv compares an actual environment against the expected one (usually
+// from AgentEnv) and returns every key whose value differs, sorted by key.
+// Keys present only in actual are ignored, except a non-empty BEADS_DIR.
+func DiffEnv(expected, actual map[string]string) []EnvMismatch {
+	var out []EnvMismatch
+	for key, want := range expected {
+		got, exists := actual[key]
+		switch {
+		case !exists && want != "":
+			out = append(out, EnvMismatch{Key: key, Kind: EnvMissing, Expected: want})
+		case !exists:
+			out = append(out, EnvMismatch{Key: key, Kind: EnvUnset})
+		case got != want:
+			kind := EnvWrong
+			if key == "BEADS_DIR" && got != "" {
+				kind = EnvBeadsDir
+			}
+			out = append(out, EnvMismatch{Key: key, Kind: kind, Expected: want, Actual: got})
+		}
+	}
+	if got := actual["BEADS_DIR"]; got != "" {
+		if _, ok := expected["BEADS_DIR"]; !ok {
+			out = append(out, EnvMismatch{Key: "BEADS_DIR", Kind: EnvBeadsDir, Actual: got})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
 func setDoltPortEnv(env map[string]string, port string) {
 	env["GT_DOLT_PORT"] = port
 	env["BEADS_DOLT_SERVER_PORT"] = port
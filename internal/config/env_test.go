@@ -162,6 +162,96 @@ func TestIdentityEnvVars_CoversAgentEnvOutput(t *testing.T) {
 	}
 }
 
+// TestAgentEnv_IdentityKeysPerRole pins exactly which IdentityEnvVars each
+// role gets with no overrides, so a role cannot silently gain or lose an
+// identity key (e.g. a witness picking up GT_POLECAT).
+func TestAgentEnv_IdentityKeysPerRole(t *testing.T) {
+	t.Parallel()
+	common := []string{"GT_ROLE", "BD_ACTOR", "GIT_AUTHOR_NAME"}
+	tests := []struct {
+		cfg  AgentEnvConfig
+		want []string
+	}{
+		{AgentEnvConfig{Role: "mayor"}, common},
+		{AgentEnvConfig{Role: "deacon"}, common},
+		{AgentEnvConfig{Role: "boot"}, common},
+		{AgentEnvConfig{Role: "witness", Rig: "myrig"}, append([]string{"GT_RIG"}, common...)},
+		{AgentEnvConfig{Role: "refinery", Rig: "myrig"}, append([]string{"GT_RIG"}, common...)},
+		{AgentEnvConfig{Role: "polecat", Rig: "myrig", AgentName: "Toast"}, append([]string{"GT_RIG", "GT_POLECAT", "BEADS_AGENT_NAME"}, common...)},
+		{AgentEnvConfig{Role: "crew", Rig: "myrig", AgentName: "emma"}, append([]string{"GT_RIG", "GT_CREW", "BEADS_AGENT_NAME"}, common...)},
+		{AgentEnvConfig{Role: "dog", AgentName: "alpha"}, append([]string{"GT_DOG_NAME"}, common...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cfg.Role, func(t *testing.T) {
+			t.Parallel()
+			tt.cfg.TownRoot = "/town"
+			env := AgentEnv(tt.cfg)
+
+			want := make(map[string]bool, len(tt.want))
+			for _, k := range tt.want {
+				want[k] = true
+			}
+			for _, k := range IdentityEnvVars {
+				if _, got := env[k]; got != want[k] {
+					t.Errorf("%s set = %v, want %v", k, got, want[k])
+				}
+			}
+		})
+	}
+}
+
+func TestDiffEnv(t *testing.T) {
+	t.Parallel()
+	expected := map[string]string{
+		"GT_ROLE":    "mayor",
+		"GT_ROOT":    "/town",
+		"CLAUDECODE": "",
+		"BEADS_DIR":  "",
+		"BD_ACTOR":   "mayor",
+	}
+	actual := map[string]string{
+		"GT_ROLE":   "deacon",
+		"BEADS_DIR": "/rig/.beads",
+		"BD_ACTOR":  "mayor",
+		"EXTRA":     "ignored",
+	}
+
+	got := DiffEnv(expected, actual)
+	want := []EnvMismatch{
+		{Key: "BEADS_DIR", Kind: EnvBeadsDir, Expected: "", Actual: "/rig/.beads"},
+		{Key: "CLAUDECODE", Kind: EnvUnset},
+		{Key: "GT_ROLE", Kind: EnvWrong, Expected: "mayor", Actual: "deacon"},
+		{Key: "GT_ROOT", Kind: EnvMissing, Expected: "/town"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("DiffEnv() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("DiffEnv()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDiffEnv_BeadsDirWithoutExpectation(t *testing.T) {
+	t.Parallel()
+	got := DiffEnv(map[string]string{}, map[string]string{"BEADS_DIR": "/x"})
+	if len(got) != 1 || got[0].Kind != EnvBeadsDir || got[0].Actual != "/x" {
+		t.Errorf("DiffEnv() = %+v, want one beads_dir mismatch", got)
+	}
+	if got := DiffEnv(map[string]string{}, map[string]string{"BEADS_DIR": ""}); len(got) != 0 {
+		t.Errorf("DiffEnv() with empty BEADS_DIR = %+v, want none", got)
+	}
+}
+
+func TestDiffEnv_MatchingAgentEnv(t *testing.T) {
+	t.Parallel()
+	env := AgentEnv(AgentEnvConfig{Role: "witness", Rig: "myrig", TownRoot: "/town"})
+	if got := DiffEnv(env, env); len(got) != 0 {
+		t.Errorf("DiffEnv(env, env) = %+v, want none", got)
+	}
+}
+
 func TestAgentEnv_WithRuntimeConfigDir(t *testing.T) {
 	t.Parallel()
 	env := AgentEnv(AgentEnvConfig{
@@ -187,6 +277,33 @@ func TestAgentEnv_WithoutRuntimeConfigDir(t *testing.T) {
 	assertNotSet(t, env, "CLAUDE_CONFIG_DIR")
 }
 
+func TestAgentEnv_WithAccount(t *testing.T) {
+	t.Parallel()
+	env := AgentEnv(AgentEnvConfig{
+		Role:             "polecat",
+		Rig:              "myrig",
+		AgentName:        "Toast",
+		TownRoot:         "/town",
+		RuntimeConfigDir: "/home/user/.claude-accounts/teamB",
+		Account:          "teamB",
+	})
+
+	assertEnv(t, env, "GT_ACCOUNT", "teamB")
+	assertEnv(t, env, "CLAUDE_CONFIG_DIR", "/home/user/.claude-accounts/teamB")
+}
+
+func TestAgentEnv_WithoutAccount(t *testing.T) {
+	t.Parallel()
+	env := AgentEnv(AgentEnvConfig{
+		Role:      "polecat",
+		Rig:       "myrig",
+		AgentName: "Toast",
+		TownRoot:  "/town",
+	})
+
+	assertNotSet(t, env, "GT_ACCOUNT")
+}
+
 func TestAgentEnvSimple(t *testing.T) {
 	t.Parallel()
 	env := AgentEnvSimple("polecat", "myrig", "Toast")
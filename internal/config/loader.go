@@ -882,10 +882,14 @@ func (c *AccountsConfig) GetDefaultAccount() *Account {
 
 // ResolveAccountConfigDir resolves the CLAUDE_CONFIG_DIR for account selection.
 // Priority order:
-//  1. GT_ACCOUNT environment variable
-//  2. accountFlag (from --account command flag)
+//  1. accountFlag (from --account command flag)
+//  2. GT_ACCOUNT environment variable
 //  3. Default account from config
 //
+// The explicit flag wins over GT_ACCOUNT because agent sessions export
+// GT_ACCOUNT, and a --account passed from inside one must not be silently
+// replaced by the inherited account.
+//
 // Returns empty string if no account configured or resolved.
 // Returns the handle that was resolved as second value.
 func ResolveAccountConfigDir(accountsPath, accountFlag string) (configDir, handle string, err error) {
@@ -896,16 +900,7 @@ func ResolveAccountConfigDir(accountsPath, accountFlag string) (configDir, handl
 		return "", "", nil
 	}
 
-	// Priority 1: GT_ACCOUNT env var
-	if envAccount := os.Getenv("GT_ACCOUNT"); envAccount != "" {
-		acct := cfg.GetAccount(envAccount)
-		if acct == nil {
-			return "", "", fmt.Errorf("GT_ACCOUNT '%s' not found in accounts config", envAccount)
-		}
-		return expandPath(acct.ConfigDir), envAccount, nil
-	}
-
-	// Priority 2: --account flag
+	// Priority 1: --account flag
 	if accountFlag != "" {
 		acct := cfg.GetAccount(accountFlag)
 		if acct == nil {
@@ -914,6 +909,15 @@ func ResolveAccountConfigDir(accountsPath, accountFlag string) (configDir, handl
 		return expandPath(acct.ConfigDir), accountFlag, nil
 	}
 
+	// Priority 2: GT_ACCOUNT env var
+	if envAccount := os.Getenv("GT_ACCOUNT"); envAccount != "" {
+		acct := cfg.GetAccount(envAccount)
+		if acct == nil {
+			return "", "", fmt.Errorf("GT_ACCOUNT '%s' not found in accounts config", envAccount)
+		}
+		return expandPath(acct.ConfigDir), envAccount, nil
+	}
+
 	// Priority 3: Default account
 	if cfg.Default != "" {
 		acct := cfg.GetDefaultAccount()
@@ -925,6 +929,20 @@ func ResolveAccountConfigDir(accountsPath, accountFlag string) (configDir, handl
 	return "", "", nil
 }
 
+// ValidateAccount checks that handle names an account in the accounts config
+// at accountsPath. Unlike ResolveAccountConfigDir, a missing accounts config
+// is an error: an explicitly requested account must exist.
+func ValidateAccount(accountsPath, handle string) error {
+	cfg, err := LoadAccountsConfig(accountsPath)
+	if err != nil {
+		return fmt.Errorf("account '%s' requested but no accounts are configured (see 'gt account add'): %w", handle, err)
+	}
+	if cfg.GetAccount(handle) == nil {
+		return fmt.Errorf("account '%s' not found in accounts config", handle)
+	}
+	return nil
+}
+
 // expandPath expands ~ to home directory.
 func expandPath(path string) string {
 	if strings.HasPrefix(path, "~/") {
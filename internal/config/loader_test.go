@@ -729,6 +729,59 @@ func TestLoadAccountsConfigNotFound(t *testing.T) {
 	}
 }
 
+func TestValidateAccount(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mayor", "accounts.json")
+
+	if err := ValidateAccount(path, "teamB"); err == nil {
+		t.Error("expected error when no accounts config exists")
+	}
+
+	cfg := NewAccountsConfig()
+	cfg.Accounts["teamB"] = Account{Email: "b@example.com", ConfigDir: "~/.claude-accounts/teamB"}
+	if err := SaveAccountsConfig(path, cfg); err != nil {
+		t.Fatalf("SaveAccountsConfig: %v", err)
+	}
+
+	if err := ValidateAccount(path, "teamB"); err != nil {
+		t.Errorf("ValidateAccount(teamB) = %v, want nil", err)
+	}
+	if err := ValidateAccount(path, "teamC"); err == nil {
+		t.Error("expected error for unknown account")
+	}
+}
+
+func TestResolveAccountConfigDir_FlagOutranksEnv(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mayor", "accounts.json")
+
+	cfg := NewAccountsConfig()
+	cfg.Accounts["teamA"] = Account{Email: "a@example.com", ConfigDir: "/accounts/teamA"}
+	cfg.Accounts["teamB"] = Account{Email: "b@example.com", ConfigDir: "/accounts/teamB"}
+	cfg.Default = "teamA"
+	if err := SaveAccountsConfig(path, cfg); err != nil {
+		t.Fatalf("SaveAccountsConfig: %v", err)
+	}
+
+	// Inside an agent session GT_ACCOUNT names the inherited account.
+	t.Setenv("GT_ACCOUNT", "teamA")
+
+	configDir, handle, err := ResolveAccountConfigDir(path, "teamB")
+	if err != nil {
+		t.Fatalf("ResolveAccountConfigDir: %v", err)
+	}
+	if handle != "teamB" || configDir != "/accounts/teamB" {
+		t.Errorf("got (%q, %q), want (/accounts/teamB, teamB)", configDir, handle)
+	}
+
+	// Without a flag, GT_ACCOUNT still applies.
+	t.Setenv("GT_ACCOUNT", "teamB")
+	if _, handle, _ := ResolveAccountConfigDir(path, ""); handle != "teamB" {
+		t.Errorf("handle without flag = %q, want teamB", handle)
+	}
+}
+
 func TestMessagingConfigRoundTrip(t *testing.T) {
 	t.Parallel()
 	dir := t.TempDir()
@@ -2,6 +2,7 @@ package daemon
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -72,6 +73,7 @@ func (d *Daemon) runCheckpointDog() {
 
 	d.logger.Printf("checkpoint_dog: cycle complete — scanned %d worktrees, checkpointed %d",
 		totalScanned, totalCheckpointed)
+	d.patrolCounters.recordAction("checkpoint_dog", "checkpointed", totalCheckpointed)
 	mol.closeStep("report")
 }
 
@@ -128,8 +130,10 @@ func (d *Daemon) checkpointRigPolecats(rigName string) (int, int) {
 // checkpointWorktree creates a WIP checkpoint commit for a single worktree.
 // Returns true if a checkpoint was created.
 func (d *Daemon) checkpointWorktree(workDir, rigName, polecatName string) bool {
-	// Check git status (exclude runtime dirs from consideration)
-	statusOut, err := runGitCmd(workDir, "status", "--porcelain")
+	// Check git status (exclude runtime dirs from consideration). Only this
+	// read-only probe honors daemon shutdown; once staging starts, the
+	// mutations below run to completion so the index is never left half-done.
+	statusOut, err := runGitCmd(d.subprocessCtx(), workDir, "status", "--porcelain")
 	if err != nil {
 		d.logger.Printf("checkpoint_dog: git status failed in %s/%s: %v", rigName, polecatName, err)
 		return false
@@ -139,7 +143,7 @@ func (d *Daemon) checkpointWorktree(workDir, rigName, polecatName string) bool {
 	}
 
 	// Stage everything
-	if _, err := runGitCmd(workDir, "add", "-A"); err != nil {
+	if _, err := runGitCmd(context.Background(), workDir, "add", "-A"); err != nil {
 		d.logger.Printf("checkpoint_dog: git add -A failed in %s/%s: %v", rigName, polecatName, err)
 		return false
 	}
@@ -147,13 +151,13 @@ func (d *Daemon) checkpointWorktree(workDir, rigName, polecatName string) bool {
 	// Unstage runtime/ephemeral artifacts using the same centralized policy as
 	// gt done. Scanning staged paths catches tracked nested runtime dirs that
 	// git add -A can restage despite ignore rules.
-	stagedOut, err := runGitCmdRaw(workDir, "diff", "--cached", "--name-only", "-z")
+	stagedOut, err := runGitCmdRaw(context.Background(), workDir, "diff", "--cached", "--name-only", "-z")
 	if err != nil {
 		d.logger.Printf("checkpoint_dog: git diff --cached failed in %s/%s: %v", rigName, polecatName, err)
 		return false
 	}
 	for _, pathspec := range gtgit.RuntimeArtifactPathspecs(splitNullSeparatedPaths(stagedOut)) {
-		if _, err := runGitCmd(workDir, "reset", "HEAD", "--", pathspec); err != nil {
+		if _, err := runGitCmd(context.Background(), workDir, "reset", "HEAD", "--", pathspec); err != nil {
 			d.logger.Printf("checkpoint_dog: git reset runtime artifact %q failed in %s/%s: %v", pathspec, rigName, polecatName, err)
 			return false
 		}
@@ -163,25 +167,25 @@ func (d *Daemon) checkpointWorktree(workDir, rigName, polecatName string) bool {
 	// (additions + modifications), never commit deletions of tracked files.
 	// This prevents the bug where a polecat's working tree has a missing
 	// tracked file and the checkpoint commits the deletion (gt-pvx fix).
-	if delOut, err := runGitCmd(workDir, "diff", "--cached", "--name-only", "--diff-filter=D"); err == nil {
+	if delOut, err := runGitCmd(context.Background(), workDir, "diff", "--cached", "--name-only", "--diff-filter=D"); err == nil {
 		if dels := strings.TrimSpace(delOut); dels != "" {
 			for _, f := range strings.Split(dels, "\n") {
 				if f != "" {
-					_, _ = runGitCmd(workDir, "reset", "HEAD", "--", f)
+					_, _ = runGitCmd(context.Background(), workDir, "reset", "HEAD", "--", f)
 				}
 			}
 		}
 	}
 
 	// Check if anything is staged after exclusions
-	diffOut, err := runGitCmd(workDir, "diff", "--cached", "--quiet")
+	diffOut, err := runGitCmd(context.Background(), workDir, "diff", "--cached", "--quiet")
 	if err == nil && strings.TrimSpace(diffOut) == "" {
 		// --quiet exits 0 if no diff → nothing staged
 		return false
 	}
 
 	// Commit the checkpoint
-	if _, err := runGitCmd(workDir, "commit", "-m", "WIP: checkpoint (auto)"); err != nil {
+	if _, err := runGitCmd(context.Background(), workDir, "commit", "-m", "WIP: checkpoint (auto)"); err != nil {
 		d.logger.Printf("checkpoint_dog: git commit failed in %s/%s: %v", rigName, polecatName, err)
 		return false
 	}
@@ -221,16 +225,16 @@ func resolveCheckpointWorkDir(polecatsDir, polecatName, rigName string) string {
 }
 
 // runGitCmd executes a git command in the given directory and returns stdout.
-func runGitCmd(workDir string, args ...string) (string, error) {
-	out, err := runGitCmdRaw(workDir, args...)
+func runGitCmd(ctx context.Context, workDir string, args ...string) (string, error) {
+	out, err := runGitCmdRaw(ctx, workDir, args...)
 	if err != nil {
 		return "", err
 	}
 	return strings.TrimSpace(out), nil
 }
 
-func runGitCmdRaw(workDir string, args ...string) (string, error) {
-	cmd := exec.Command("git", args...)
+func runGitCmdRaw(ctx context.Context, workDir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
 	cmd.Dir = workDir
 	util.SetDetachedProcessGroup(cmd)
 
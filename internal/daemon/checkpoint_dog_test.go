@@ -1,6 +1,7 @@
 package daemon
 
 import (
+	"context"
 	"io"
 	"log"
 	"os"
@@ -287,9 +288,37 @@ func TestCheckpointWorktreeSkipsRuntimeOnlyNestedArtifacts(t *testing.T) {
 	}
 }
 
+func TestCheckpointWorktreeHonorsDaemonShutdown(t *testing.T) {
+	workDir := t.TempDir()
+	mustRunGit(t, workDir, "init")
+	mustRunGit(t, workDir, "config", "user.name", "Checkpoint Dog")
+	mustRunGit(t, workDir, "config", "user.email", "checkpoint@example.com")
+
+	if err := os.WriteFile(filepath.Join(workDir, "app.go"), []byte("package main\n"), 0o644); err != nil {
+		t.Fatalf("write source: %v", err)
+	}
+	mustRunGit(t, workDir, "add", "app.go")
+	mustRunGit(t, workDir, "commit", "-m", "initial")
+	before := mustRunGit(t, workDir, "rev-parse", "HEAD")
+
+	if err := os.WriteFile(filepath.Join(workDir, "app.go"), []byte("package main\n// dirty\n"), 0o644); err != nil {
+		t.Fatalf("modify source: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // daemon is shutting down
+	d := &Daemon{logger: log.New(io.Discard, "", 0), ctx: ctx}
+	if d.checkpointWorktree(workDir, "rig", "polecat") {
+		t.Fatal("checkpointWorktree created a checkpoint after daemon shutdown")
+	}
+	if after := mustRunGit(t, workDir, "rev-parse", "HEAD"); after != before {
+		t.Fatalf("checkpointWorktree advanced HEAD to %s, want %s", after, before)
+	}
+}
+
 func mustRunGit(t *testing.T, workDir string, args ...string) string {
 	t.Helper()
-	out, err := runGitCmd(workDir, args...)
+	out, err := runGitCmd(context.Background(), workDir, args...)
 	if err != nil {
 		t.Fatalf("git %s: %v", strings.Join(args, " "), err)
 	}
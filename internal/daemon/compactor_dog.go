@@ -247,7 +247,7 @@ func (d *Daemon) compactorDatabases() []string {
 
 // compactorCountCommits counts the number of commits in the database's dolt_log.
 func (d *Daemon) compactorCountCommits(dbName string) (int, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), compactorQueryTimeout)
+	ctx, cancel := context.WithTimeout(d.subprocessCtx(), compactorQueryTimeout)
 	defer cancel()
 
 	db, err := d.compactorOpenDB(dbName)
@@ -608,7 +608,7 @@ func (d *Daemon) compactorOpenDB(dbName string) (*sql.DB, error) {
 
 // compactorGetHead returns the current HEAD commit hash of the main branch.
 func (d *Daemon) compactorGetHead(db *sql.DB, dbName string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), compactorQueryTimeout)
+	ctx, cancel := context.WithTimeout(d.subprocessCtx(), compactorQueryTimeout)
 	defer cancel()
 
 	var hash string
@@ -625,7 +625,7 @@ func (d *Daemon) compactorGetHead(db *sql.DB, dbName string) (string, error) {
 
 // compactorGetRootCommit returns the hash of the earliest commit in the database.
 func (d *Daemon) compactorGetRootCommit(db *sql.DB, dbName string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), compactorQueryTimeout)
+	ctx, cancel := context.WithTimeout(d.subprocessCtx(), compactorQueryTimeout)
 	defer cancel()
 
 	var hash string
@@ -638,7 +638,7 @@ func (d *Daemon) compactorGetRootCommit(db *sql.DB, dbName string) (string, erro
 
 // compactorGetRowCounts returns a map of table -> row count for all user tables.
 func (d *Daemon) compactorGetRowCounts(db *sql.DB, dbName string) (map[string]int, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), compactorQueryTimeout)
+	ctx, cancel := context.WithTimeout(d.subprocessCtx(), compactorQueryTimeout)
 	defer cancel()
 
 	// Get list of user tables (excluding dolt system tables).
@@ -130,9 +130,26 @@ type Daemon struct {
 	knownRigsCache      []string
 	knownRigsCacheValid bool
 
+	// patrolRigsCache memoizes getPatrolRigs per patrol type across
+	// heartbeats. A hit skips the list-building isRigOperational pass, so
+	// excluded (parked/docked) rigs cost no bd lookup; included rigs are still
+	// checked once per cycle by the per-rig ensure* paths. See getPatrolRigs
+	// for when an entry is reused.
+	// Only accessed from heartbeat loop goroutine - no sync needed.
+	patrolRigsCache map[string]patrolRigsCacheEntry
+
+	// escalations collapses repeated identical escalations (e.g. every patrol
+	// reporting the same Dolt outage each cycle) into one alert per window.
+	// Nil disables deduplication.
+	escalations *escalationDeduper
+
 	// legacySocketCleanupOnce ensures upgrade cleanup only runs once per daemon
 	// lifetime, before any patrol agent can be started on the current socket.
 	legacySocketCleanupOnce sync.Once
+
+	// patrolCounters counts patrol runs and actions for 'gt daemon metrics'.
+	// Loaded from daemon/patrol_metrics.json at startup; nil until Run.
+	patrolCounters *patrolCounters
 }
 
 // sessionDeath records a detected session death for mass death analysis.
@@ -151,8 +168,18 @@ const (
 	// doctorMolCooldown is the minimum interval between mol-dog-doctor molecules.
 	// Configurable via operational.daemon.doctor_mol_cooldown.
 	doctorMolCooldown = 5 * time.Minute
+
+	// patrolRigsCacheTTL bounds how long a cached patrol-rig list is reused.
+	// Rig bead labels (gt rig dock/undock) have no file to watch, so this is
+	// how long an undocked rig can stay excluded from a patrol.
+	patrolRigsCacheTTL = 5 * time.Minute
 )
 
+// rigStatusUnverified is isRigOperational's fail-safe reason when the rig
+// bead cannot be read. Patrol-rig lists excluding a rig for this reason are
+// never cached, so the rig is retried on the next cycle.
+const rigStatusUnverified = "cannot verify rig status (Dolt unavailable)"
+
 const beadsModulePath = "github.com/steveyegge/beads"
 
 var semverPattern = regexp.MustCompile(`v?(\d+\.\d+\.\d+)`)
@@ -395,6 +422,7 @@ func New(config *Config) (*Daemon, error) {
 		otelProvider:    otelProvider,
 		metrics:         dm,
 		rigPool:         newRigWorkerPool(0, 0, logger), // defaults: 10 workers, 30s timeout
+		escalations:     newEscalationDeduper(escalationDedupWindow(patrolConfig)),
 	}
 	return d, nil
 }
@@ -504,6 +532,13 @@ func (d *Daemon) Run() (err error) {
 		d.logger.Printf("Warning: failed to save state: %v", err)
 	}
 
+	// Resume patrol counters from the last flush so they span restarts.
+	loadedMetrics, err := LoadPatrolMetrics(d.config.TownRoot)
+	if err != nil {
+		d.logger.Printf("Warning: failed to load patrol metrics, starting from zero: %v", err)
+	}
+	d.patrolCounters = newPatrolCounters(loadedMetrics)
+
 	// Handle signals
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, daemonSignals()...)
@@ -722,7 +757,8 @@ func (d *Daemon) Run() (err error) {
 	// per-session approach which has been tested to work for continuous recovery.
 
 	// Initial heartbeat
-	d.heartbeat(state)
+	d.runPatrol("heartbeat", func() { d.heartbeat(state) })
+	d.flushPatrolCounters()
 	startupComplete = true
 
 	for {
@@ -753,81 +789,82 @@ func (d *Daemon) Run() (err error) {
 			// Dedicated Dolt health check — fast crash detection independent
 			// of the 3-minute general heartbeat.
 			if !d.isShutdownInProgress() {
-				d.ensureDoltServerRunning()
+				d.runPatrol("dolt_health", d.ensureDoltServerRunning)
 			}
 
 		case <-doltRemotesChan:
 			// Periodic Dolt remote push — pushes databases to their configured
 			// git remotes on a 15-minute cadence (independent of heartbeat).
 			if !d.isShutdownInProgress() {
-				d.pushDoltRemotes()
+				d.runPatrol("dolt_remotes", d.pushDoltRemotes)
 			}
 
 		case <-doltBackupChan:
 			// Periodic Dolt filesystem backup — syncs production databases to
 			// local backup directory on a 15-minute cadence.
 			if !d.isShutdownInProgress() {
-				d.syncDoltBackups()
+				d.runPatrol("dolt_backup", d.syncDoltBackups)
 			}
 
 		case <-jsonlGitBackupChan:
 			// Periodic JSONL git backup — exports issues, scrubs ephemeral data,
 			// commits and pushes to git repo.
 			if !d.isShutdownInProgress() {
-				d.syncJsonlGitBackup()
+				d.runPatrol("jsonl_git_backup", d.syncJsonlGitBackup)
 			}
 
 		case <-wispReaperChan:
 			// Periodic wisp reaper — closes stale wisps (abandoned molecule steps,
 			// old patrol data) to prevent unbounded table growth (Clown Show audit).
 			if !d.isShutdownInProgress() {
-				d.reapWisps()
+				d.runPatrol("wisp_reaper", d.reapWisps)
 			}
 
 		case <-doctorDogChan:
 			// Doctor dog — comprehensive Dolt health monitor: connectivity, latency,
 			// gc, zombie detection, backup staleness, and disk usage checks.
 			if !d.isShutdownInProgress() {
-				d.runDoctorDog()
+				d.runPatrol("doctor_dog", d.runDoctorDog)
 			}
 
 		case <-compactorDogChan:
 			// Compactor dog — flattens Dolt commit history on production databases.
 			// Reclaims commit graph storage, then runs gc to reclaim chunks.
 			if !d.isShutdownInProgress() {
-				d.runCompactorDog()
+				d.runPatrol("compactor_dog", d.runCompactorDog)
 			}
 
 		case <-checkpointDogChan:
 			// Checkpoint dog — auto-commits WIP changes in active polecat
 			// worktrees to prevent data loss from session crashes.
 			if !d.isShutdownInProgress() {
-				d.runCheckpointDog()
+				d.runPatrol("checkpoint_dog", d.runCheckpointDog)
 			}
 
 		case <-scheduledMaintenanceChan:
 			// Scheduled maintenance — checks if we're in the maintenance window
 			// and runs `gt maintain --force` when commit counts exceed threshold.
 			if !d.isShutdownInProgress() {
-				d.runScheduledMaintenance()
+				d.runPatrol("scheduled_maintenance", d.runScheduledMaintenance)
 			}
 
 		case <-mainBranchTestChan:
 			// Main branch test runner — periodically runs quality gates on each
 			// rig's main branch to catch regressions from merges or direct pushes.
 			if !d.isShutdownInProgress() {
-				d.runMainBranchTests()
+				d.runPatrol("main_branch_test", d.runMainBranchTests)
 			}
 
 		case <-quotaDogChan:
 			// Quota dog — scans for rate-limited sessions and automatically
 			// rotates credentials to available accounts via keychain swap.
 			if !d.isShutdownInProgress() {
-				d.runQuotaDog()
+				d.runPatrol("quota_dog", d.runQuotaDog)
 			}
 
 		case <-timer.C:
-			d.heartbeat(state)
+			d.runPatrol("heartbeat", func() { d.heartbeat(state) })
+			d.flushPatrolCounters()
 
 			// Fixed recovery interval (no activity-based backoff)
 			timer.Reset(d.recoveryHeartbeatInterval())
@@ -880,137 +917,176 @@ func (d *Daemon) heartbeat(state *State) {
 	// 0a. Reload prefix registry so new/changed rigs get correct session names.
 	// Without this, rigs added after daemon startup get the "gt" default prefix,
 	// causing ghost sessions like gt-witness instead of ti-witness. (hq-ouz, hq-eqf, hq-3i4)
-	if err := session.InitRegistry(d.config.TownRoot); err != nil {
+	if err := session.ReloadRegistry(d.config.TownRoot); err != nil {
 		d.logger.Printf("Warning: failed to reload prefix registry: %v", err)
 	}
 
-	// 0b. Kill ghost sessions left over from stale registry (default "gt" prefix).
-	d.killDefaultPrefixGhosts()
+	d.runHeartbeatSteps(state, d.heartbeatSteps())
+}
 
-	// 0. Ensure Dolt server is running (if configured)
-	// This must happen before beads operations that depend on Dolt.
-	d.ensureDoltServerRunning()
+// heartbeatStep is one named patrol run inside a heartbeat cycle.
+type heartbeatStep struct {
+	name string
+	run  func()
+}
 
-	// 1. Ensure Deacon is running (restart if dead)
-	// Check patrol config - can be disabled in mayor/daemon.json
-	if d.isPatrolActive("deacon") {
-		d.ensureDeaconRunning()
-	} else {
-		d.logger.Printf("Deacon patrol disabled in config, skipping")
-		// Kill leftover deacon/boot sessions from before patrol was disabled.
-		// Without this, a stale deacon keeps running its own patrol loop,
-		// spawning witnesses and refineries despite daemon config. (hq-2mstj)
-		d.killDeaconSessions()
+// runHeartbeatSteps runs each step under its own panic recovery, then records
+// the heartbeat in state. A panic in one step is logged and escalated by
+// runPatrol; the remaining steps and the state save still happen.
+func (d *Daemon) runHeartbeatSteps(state *State, steps []heartbeatStep) {
+	for _, step := range steps {
+		d.runPatrol(step.name, step.run)
 	}
 
-	// 2. Poke Boot for intelligent triage (stuck/nudge/interrupt)
-	// Boot handles nuanced "is Deacon responsive" decisions
-	// Only run if Deacon patrol is enabled
-	if d.isPatrolActive("deacon") {
-		d.ensureBootRunning()
+	// Update state
+	state.LastHeartbeat = time.Now()
+	state.HeartbeatCount++
+	if err := SaveState(d.config.TownRoot, state); err != nil {
+		d.logger.Printf("Warning: failed to save state: %v", err)
 	}
 
-	// 3. Direct Deacon heartbeat check (belt-and-suspenders)
-	// Boot may not detect all stuck states; this provides a fallback
-	// Only run if Deacon patrol is enabled
-	if d.isPatrolActive("deacon") {
-		d.checkDeaconHeartbeat()
-	}
+	d.logger.Printf("Heartbeat complete (#%d)", state.HeartbeatCount)
+}
 
-	// 4. Ensure Witnesses are running for all rigs (restart if dead)
-	// Check patrol config - can be disabled in mayor/daemon.json
-	if d.isPatrolActive("witness") {
-		d.ensureWitnessesRunning()
-	} else {
-		d.logger.Printf("Witness patrol disabled in config, skipping")
-		// Kill leftover witness sessions from before patrol was disabled. (hq-2mstj)
-		d.killWitnessSessions()
-	}
+// heartbeatSteps returns the patrols run by each heartbeat, in order.
+func (d *Daemon) heartbeatSteps() []heartbeatStep {
+	return []heartbeatStep{
+		// 0b. Kill ghost sessions left over from stale registry (default "gt" prefix).
+		{"ghost_sessions", d.killDefaultPrefixGhosts},
 
-	// 5. Ensure Refineries are running for all rigs (restart if dead)
-	// Check patrol config - can be disabled in mayor/daemon.json
-	// Pressure-gated: refineries consume API credits, defer when system is loaded.
-	if d.isPatrolActive("refinery") {
-		if p := d.checkPressure("refinery"); !p.OK {
-			d.logger.Printf("Deferring refinery spawn: %s", p.Reason)
-		} else {
-			d.ensureRefineriesRunning()
-		}
-	} else {
-		d.logger.Printf("Refinery patrol disabled in config, skipping")
-		// Kill leftover refinery sessions from before patrol was disabled. (hq-2mstj)
-		d.killRefinerySessions()
-	}
+		// 0. Ensure Dolt server is running (if configured)
+		// This must happen before beads operations that depend on Dolt.
+		{"ensure_dolt", d.ensureDoltServerRunning},
 
-	// 6. Ensure Mayor is running (restart if dead)
-	d.ensureMayorRunning()
+		// 1. Ensure Deacon is running (restart if dead)
+		// Check patrol config - can be disabled in mayor/daemon.json
+		{"ensure_deacon", func() {
+			if d.isPatrolActive("deacon") {
+				d.ensureDeaconRunning()
+			} else {
+				d.logger.Printf("Deacon patrol disabled in config, skipping")
+				// Kill leftover deacon/boot sessions from before patrol was disabled.
+				// Without this, a stale deacon keeps running its own patrol loop,
+				// spawning witnesses and refineries despite daemon config. (hq-2mstj)
+				d.killDeaconSessions()
+			}
+		}},
+
+		// 2. Poke Boot for intelligent triage (stuck/nudge/interrupt)
+		// Boot handles nuanced "is Deacon responsive" decisions
+		// Only run if Deacon patrol is enabled
+		{"ensure_boot", func() {
+			if d.isPatrolActive("deacon") {
+				d.ensureBootRunning()
+			}
+		}},
+
+		// 3. Direct Deacon heartbeat check (belt-and-suspenders)
+		// Boot may not detect all stuck states; this provides a fallback
+		// Only run if Deacon patrol is enabled
+		{"deacon_heartbeat", func() {
+			if d.isPatrolActive("deacon") {
+				d.checkDeaconHeartbeat()
+			}
+		}},
 
-	// 6.5. Handle Dog lifecycle: cleanup stuck dogs and dispatch plugins
-	// Pressure-gated: dog dispatch spawns new agent sessions.
-	if d.isPatrolActive("handler") {
-		if p := d.checkPressure("dog"); !p.OK {
-			d.logger.Printf("Deferring dog dispatch: %s", p.Reason)
-			// Still run cleanup phases (stuck/stale/idle) — only skip dispatch
-			d.handleDogsCleanupOnly()
-		} else {
-			d.handleDogs()
-		}
-	} else {
-		d.logger.Printf("Handler patrol disabled in config, skipping")
-	}
+		// 4. Ensure Witnesses are running for all rigs (restart if dead)
+		// Check patrol config - can be disabled in mayor/daemon.json
+		{"ensure_witnesses", func() {
+			if d.isPatrolActive("witness") {
+				d.ensureWitnessesRunning()
+			} else {
+				d.logger.Printf("Witness patrol disabled in config, skipping")
+				// Kill leftover witness sessions from before patrol was disabled. (hq-2mstj)
+				d.killWitnessSessions()
+			}
+		}},
+
+		// 5. Ensure Refineries are running for all rigs (restart if dead)
+		// Check patrol config - can be disabled in mayor/daemon.json
+		// Pressure-gated: refineries consume API credits, defer when system is loaded.
+		{"ensure_refineries", func() {
+			if d.isPatrolActive("refinery") {
+				if p := d.checkPressure("refinery"); !p.OK {
+					d.logger.Printf("Deferring refinery spawn: %s", p.Reason)
+				} else {
+					d.ensureRefineriesRunning()
+				}
+			} else {
+				d.logger.Printf("Refinery patrol disabled in config, skipping")
+				// Kill leftover refinery sessions from before patrol was disabled. (hq-2mstj)
+				d.killRefinerySessions()
+			}
+		}},
+
+		// 6. Ensure Mayor is running (restart if dead)
+		{"ensure_mayor", d.ensureMayorRunning},
+
+		// 6.5. Handle Dog lifecycle: cleanup stuck dogs and dispatch plugins
+		// Pressure-gated: dog dispatch spawns new agent sessions.
+		{"handle_dogs", func() {
+			if d.isPatrolActive("handler") {
+				if p := d.checkPressure("dog"); !p.OK {
+					d.logger.Printf("Deferring dog dispatch: %s", p.Reason)
+					// Still run cleanup phases (stuck/stale/idle) — only skip dispatch
+					d.handleDogsCleanupOnly()
+				} else {
+					d.handleDogs()
+				}
+			} else {
+				d.logger.Printf("Handler patrol disabled in config, skipping")
+			}
+		}},
 
-	// 7. Process lifecycle requests
-	d.processLifecycleRequests()
+		// 7. Process lifecycle requests
+		{"lifecycle_requests", d.processLifecycleRequests},
 
-	// 9. (Removed) Stale agent check - violated "discover, don't track"
+		// 9. (Removed) Stale agent check - violated "discover, don't track"
 
-	// 10. Check for GUPP violations (agents with work-on-hook not progressing)
-	d.checkGUPPViolations()
+		// 10. Check for GUPP violations (agents with work-on-hook not progressing)
+		{"gupp_violations", d.checkGUPPViolations},
 
-	// 11. Check for orphaned work (assigned to dead agents)
-	d.checkOrphanedWork()
+		// 11. Check for orphaned work (assigned to dead agents)
+		{"orphaned_work", d.checkOrphanedWork},
 
-	// 12. Check polecat session health (proactive crash detection)
-	// This validates tmux sessions are still alive for polecats with work-on-hook
-	d.checkPolecatSessionHealth()
+		// 12. Check polecat session health (proactive crash detection)
+		// This validates tmux sessions are still alive for polecats with work-on-hook
+		{"polecat_session_health", d.checkPolecatSessionHealth},
 
-	// 12b. Reap idle polecat sessions to prevent API slot burn.
-	// Polecats transition to IDLE after gt done but sessions stay alive.
-	// Kill sessions that have been idle longer than the configured threshold.
-	d.reapIdlePolecats()
+		// 12b. Reap idle polecat sessions to prevent API slot burn.
+		// Polecats transition to IDLE after gt done but sessions stay alive.
+		// Kill sessions that have been idle longer than the configured threshold.
+		{"reap_idle_polecats", d.reapIdlePolecats},
 
-	// 13. Clean up orphaned claude subagent processes (memory leak prevention)
-	// These are Task tool subagents that didn't clean up after completion.
-	// This is a safety net - Deacon patrol also does this more frequently.
-	d.cleanupOrphanedProcesses()
+		// 13. Clean up orphaned claude subagent processes (memory leak prevention)
+		// These are Task tool subagents that didn't clean up after completion.
+		// This is a safety net - Deacon patrol also does this more frequently.
+		{"orphaned_processes", d.cleanupOrphanedProcesses},
 
-	// 13. Prune stale local polecat tracking branches across all rig clones.
-	// When polecats push branches to origin, other clones create local tracking
-	// branches via git fetch. After merge, remote branches are deleted but local
-	// branches persist indefinitely. This cleans them up periodically.
-	d.pruneStaleBranches()
+		// 13. Prune stale local polecat tracking branches across all rig clones.
+		// When polecats push branches to origin, other clones create local tracking
+		// branches via git fetch. After merge, remote branches are deleted but local
+		// branches persist indefinitely. This cleans them up periodically.
+		{"prune_branches", d.pruneStaleBranches},
 
-	// 14. Dispatch scheduled work (capacity-controlled polecat dispatch).
-	// Shells out to `gt scheduler run` to avoid circular import between daemon and cmd.
-	// Pressure-gated: polecats are the primary resource consumers.
-	if p := d.checkPressure("polecat"); !p.OK {
-		d.logger.Printf("Deferring polecat dispatch: %s", p.Reason)
-	} else {
-		d.dispatchQueuedWork()
-	}
+		// 14. Dispatch scheduled work (capacity-controlled polecat dispatch).
+		// Shells out to `gt scheduler run` to avoid circular import between daemon and cmd.
+		// Pressure-gated: polecats are the primary resource consumers.
+		{"dispatch_queued_work", func() {
+			if p := d.checkPressure("polecat"); !p.OK {
+				d.logger.Printf("Deferring polecat dispatch: %s", p.Reason)
+			} else {
+				d.dispatchQueuedWork()
+			}
+		}},
 
-	// 15. Rotate oversized Dolt logs (copytruncate for child process fds).
-	// daemon.log uses lumberjack for automatic rotation; this handles Dolt server logs.
-	d.rotateOversizedLogs()
+		// 15. Rotate oversized Dolt logs (copytruncate for child process fds).
+		// daemon.log uses lumberjack for automatic rotation; this handles Dolt server logs.
+		{"rotate_logs", d.rotateOversizedLogs},
 
-	// Update state
-	state.LastHeartbeat = time.Now()
-	state.HeartbeatCount++
-	if err := SaveState(d.config.TownRoot, state); err != nil {
-		d.logger.Printf("Warning: failed to save state: %v", err)
+		// 16. Send summaries for escalation dedup windows that have closed.
+		{"flush_escalations", d.flushEscalations},
 	}
-
-	d.logger.Printf("Heartbeat complete (#%d)", state.HeartbeatCount)
 }
 
 // rotateOversizedLogs checks Dolt server log files and rotates any that exceed
@@ -1369,7 +1445,7 @@ func (d *Daemon) ensureBootRunning() {
 	idleCheckBin := filepath.Join(d.config.TownRoot, "bin", "gt-idle-check")
 	if _, err := os.Stat(idleCheckBin); err == nil {
 		//nolint:gosec // G204: path is constructed from config
-		cmd := exec.Command(idleCheckBin)
+		cmd := exec.CommandContext(d.subprocessCtx(), idleCheckBin)
 		cmd.Env = append(os.Environ(), fmt.Sprintf("PATH=%s:%s",
 			filepath.Join(d.config.TownRoot, "bin"), os.Getenv("PATH")))
 		if output, err := cmd.CombinedOutput(); err == nil {
@@ -1502,6 +1578,7 @@ func (d *Daemon) ensureDeaconRunning() {
 	// The heartbeat file will still be stale until the Deacon runs a full patrol cycle.
 	d.deaconLastStarted = time.Now()
 	d.metrics.recordRestart(d.ctx, "deacon")
+	d.patrolCounters.recordAction("heartbeat", "deacon_restarts", 1)
 	telemetry.RecordDaemonRestart(d.ctx, "deacon")
 	d.logger.Println("Deacon started successfully")
 }
@@ -1790,6 +1867,7 @@ func (d *Daemon) ensureWitnessRunning(rigName string) {
 	}
 
 	d.metrics.recordRestart(d.ctx, "witness")
+	d.patrolCounters.recordAction("heartbeat", "witness_restarts", 1)
 	telemetry.RecordDaemonRestart(d.ctx, "witness-"+rigName)
 	d.logger.Printf("Witness session for %s started successfully", rigName)
 }
@@ -1889,6 +1967,7 @@ func (d *Daemon) ensureRefineryRunning(rigName string) {
 	}
 
 	d.metrics.recordRestart(d.ctx, "refinery")
+	d.patrolCounters.recordAction("heartbeat", "refinery_restarts", 1)
 	telemetry.RecordDaemonRestart(d.ctx, "refinery-"+rigName)
 	d.logger.Printf("Refinery session for %s started successfully", rigName)
 }
@@ -2160,6 +2239,11 @@ func (d *Daemon) readKnownRigsFromDisk() []string {
 // If the patrol config specifies a rigs filter, only those rigs are returned.
 // Otherwise, all known rigs are returned. In both cases, non-operational
 // rigs (parked/docked) are filtered out at list-building time. (Fixes upstream #2082)
+//
+// The result is cached per patrol and reused while the candidate rigs,
+// mayor/rigs.json and every candidate's wisp config are unchanged (by mtime),
+// for at most patrolRigsCacheTTL. A stale inclusion is harmless because the
+// per-rig ensure* paths re-check isRigOperational before starting anything.
 func (d *Daemon) getPatrolRigs(patrol string) []string {
 	configRigs := GetPatrolRigs(d.patrolConfig, patrol)
 	var candidates []string
@@ -2169,18 +2253,62 @@ func (d *Daemon) getPatrolRigs(patrol string) []string {
 		candidates = d.getKnownRigs()
 	}
 
+	fingerprint := d.patrolRigsFingerprint(candidates)
+	if entry, ok := d.patrolRigsCache[patrol]; ok &&
+		entry.fingerprint == fingerprint && time.Since(entry.cachedAt) < patrolRigsCacheTTL {
+		return entry.rigs
+	}
+
 	// Filter out non-operational rigs early to avoid per-rig skip noise
 	var operational []string
+	cacheable := true
 	for _, rigName := range candidates {
 		if ok, reason := d.isRigOperational(rigName); ok {
 			operational = append(operational, rigName)
 		} else {
 			d.logger.Printf("Excluding %s from %s patrol: %s", rigName, patrol, reason)
+			if reason == rigStatusUnverified {
+				cacheable = false
+			}
 		}
 	}
+
+	if cacheable {
+		if d.patrolRigsCache == nil {
+			d.patrolRigsCache = make(map[string]patrolRigsCacheEntry)
+		}
+		d.patrolRigsCache[patrol] = patrolRigsCacheEntry{fingerprint: fingerprint, rigs: operational, cachedAt: time.Now()}
+	} else {
+		delete(d.patrolRigsCache, patrol)
+	}
 	return operational
 }
 
+// patrolRigsCacheEntry is one patrol's cached getPatrolRigs result.
+type patrolRigsCacheEntry struct {
+	fingerprint string
+	rigs        []string
+	cachedAt    time.Time
+}
+
+// patrolRigsFingerprint identifies the on-disk inputs to getPatrolRigs: the
+// candidate rigs and the mtimes of mayor/rigs.json and each candidate's wisp
+// config (where parked/docked status and auto_restart live).
+func (d *Daemon) patrolRigsFingerprint(candidates []string) string {
+	var b strings.Builder
+	mtime := func(path string) int64 {
+		if info, err := os.Stat(path); err == nil {
+			return info.ModTime().UnixNano()
+		}
+		return 0
+	}
+	fmt.Fprintf(&b, "rigs.json@%d", mtime(filepath.Join(d.config.TownRoot, "mayor", "rigs.json")))
+	for _, rigName := range candidates {
+		fmt.Fprintf(&b, "|%s@%d", rigName, mtime(wisp.NewConfig(d.config.TownRoot, rigName).ConfigPath()))
+	}
+	return b.String()
+}
+
 // isRigOperational checks if a rig is in an operational state.
 // Returns true if the rig can have agents auto-started.
 // Returns false (with reason) if the rig is parked, docked, or has auto_restart blocked/disabled.
@@ -2199,11 +2327,10 @@ func (d *Daemon) isRigOperational(rigName string) (bool, string) {
 	}
 
 	// Check wisp layer first (local/ephemeral overrides)
-	status := cfg.GetString("status")
-	switch status {
-	case "parked":
+	switch cfg.Status() {
+	case wisp.StatusParked:
 		return false, "rig is parked"
-	case "docked":
+	case wisp.StatusDocked:
 		return false, "rig is docked"
 	}
 
@@ -2238,7 +2365,7 @@ func (d *Daemon) isRigOperational(rigName string) (bool, string) {
 		// assume the rig is NOT operational. This prevents wasting API credits starting
 		// witnesses that might be docked. Better to delay work than burn credits unnecessarily.
 		d.logger.Printf("Warning: failed to check rig bead %s for docked/parked status: %v (assuming not operational)", rigBeadID, err)
-		return false, "cannot verify rig status (Dolt unavailable)"
+		return false, rigStatusUnverified
 	}
 
 	// Check auto_restart config
@@ -2312,6 +2439,7 @@ func (d *Daemon) shutdown(state *State) error { //nolint:unparam // error return
 	if err := SaveState(d.config.TownRoot, state); err != nil {
 		d.logger.Printf("Warning: failed to save final state: %v", err)
 	}
+	d.flushPatrolCounters()
 
 	d.logger.Println("Daemon stopped")
 	return nil
@@ -2322,6 +2450,18 @@ func (d *Daemon) Stop() {
 	d.cancel()
 }
 
+// subprocessCtx returns the context read-only patrol probes run under, so that
+// daemon shutdown kills in-flight probes instead of waiting them out. Do not
+// use it for mutations (git stash/pull, mail send): killing those part-way
+// can leave a workspace mid-rebase or a message half-sent.
+// Daemons built without New (tests) have no ctx and get Background.
+func (d *Daemon) subprocessCtx() context.Context {
+	if d.ctx == nil {
+		return context.Background()
+	}
+	return d.ctx
+}
+
 // isShutdownInProgress checks if a shutdown is currently in progress.
 // The shutdown.lock file is created by gt down before terminating sessions.
 // This prevents the daemon from fighting shutdown by auto-restarting killed agents.
@@ -2798,7 +2938,7 @@ func (d *Daemon) emitMassDeathEvent() {
 // On any error (bead not found, bd failure), returns false to err on the side
 // of crash detection rather than silently suppressing alerts.
 func (d *Daemon) isBeadClosed(beadID string) bool {
-	cmd := exec.Command(d.bdPath, "show", beadID, "--json") //nolint:gosec // G204: args are constructed internally
+	cmd := exec.CommandContext(d.subprocessCtx(), d.bdPath, "show", beadID, "--json") //nolint:gosec // G204: args are constructed internally
 	setSysProcAttr(cmd)
 	cmd.Dir = d.config.TownRoot
 	cmd.Env = bdReadOnlyRoutingEnv(d.config.TownRoot)
@@ -2829,7 +2969,7 @@ func (d *Daemon) hasAssignedOpenWork(rigName, assignee string) bool {
 
 	for _, status := range []string{"hooked", "in_progress", "open"} {
 		args := beads.InjectFlatForListJSON([]string{"list", "--assignee=" + assignee, "--status=" + status, "--json"})
-		cmd := exec.Command(d.bdPath, args...) //nolint:gosec // G204: args are constructed internally
+		cmd := exec.CommandContext(d.subprocessCtx(), d.bdPath, args...) //nolint:gosec // G204: args are constructed internally
 		cmd.Dir = d.config.TownRoot
 		if rigDir != "" {
 			cmd.Env = bdReadOnlyPinnedEnv(beads.ResolveBeadsDir(rigDir))
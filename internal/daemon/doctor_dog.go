@@ -116,5 +116,6 @@ func (d *Daemon) runDoctorDog() {
 		return
 	}
 
+	d.patrolCounters.recordAction("doctor_dog", "molecules_poured", 1)
 	d.logger.Printf("doctor_dog: poured %s → %s", constants.MolDogDoctor, mol.rootID)
 }
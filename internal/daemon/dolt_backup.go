@@ -118,10 +118,7 @@ func (d *Daemon) syncDoltBackups() {
 // retrying once on failure so a transient lock or large delta does not fail the
 // cycle (gt-ye21).
 func (d *Daemon) syncBackup(dataDir, db, backupName string) error {
-	parentCtx := d.ctx
-	if parentCtx == nil {
-		parentCtx = context.Background()
-	}
+	parentCtx := d.subprocessCtx()
 	dbDir := filepath.Join(dataDir, db)
 
 	var lastErr error
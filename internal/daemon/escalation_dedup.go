@@ -0,0 +1,159 @@
+package daemon
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// defaultEscalationDedupWindow is how long identical escalations are
+// collapsed. Long enough to absorb several patrol cycles of a Dolt outage
+// (doctor_dog, dolt_remotes and maintenance all escalate every cycle).
+const defaultEscalationDedupWindow = 30 * time.Minute
+
+// EscalationConfig holds configuration for daemon escalations.
+type EscalationConfig struct {
+	// DedupWindowStr collapses identical (source, message) escalations
+	// within this window into one alert (default 30m, "0" disables).
+	DedupWindowStr string `json:"dedup_window,omitempty"`
+}
+
+// escalationDedupWindow returns the configured dedup window, or the default.
+// An explicit zero disables deduplication.
+func escalationDedupWindow(config *DaemonPatrolConfig) time.Duration {
+	if config != nil && config.Patrols != nil && config.Patrols.Escalation != nil {
+		if s := config.Patrols.Escalation.DedupWindowStr; s != "" {
+			if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+				return d
+			}
+		}
+	}
+	return defaultEscalationDedupWindow
+}
+
+type escalationKey struct {
+	source  string
+	message string
+}
+
+// escalationWindow counts occurrences of one escalation since it was last sent.
+type escalationWindow struct {
+	start time.Time
+	count int
+}
+
+// repeatedEscalation is the summary sent when a window with suppressed
+// duplicates closes.
+type repeatedEscalation struct {
+	source  string
+	message string
+	count   int
+}
+
+// escalationDeduper rate-limits escalations so a persistent failure produces
+// one alert per window instead of one per patrol cycle. The first occurrence
+// is always sent immediately; duplicates within the window are only counted,
+// and a single summary with the total count is sent once the window closes.
+type escalationDeduper struct {
+	mu      sync.Mutex
+	window  time.Duration
+	now     func() time.Time
+	windows map[escalationKey]*escalationWindow
+}
+
+func newEscalationDeduper(window time.Duration) *escalationDeduper {
+	return &escalationDeduper{
+		window:  window,
+		now:     time.Now,
+		windows: make(map[escalationKey]*escalationWindow),
+	}
+}
+
+// observe records one occurrence of an escalation. It reports whether the
+// escalation should be sent now, plus summaries for any windows that have
+// closed since the last call.
+func (e *escalationDeduper) observe(source, message string) (sendNow bool, closed []repeatedEscalation) {
+	if e.window <= 0 {
+		return true, nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	closed = e.closeExpiredLocked()
+	key := escalationKey{source: source, message: message}
+	if w, ok := e.windows[key]; ok {
+		w.count++
+		return false, closed
+	}
+	e.windows[key] = &escalationWindow{start: e.now(), count: 1}
+	return true, closed
+}
+
+// flush returns summaries for windows that have closed, without recording
+// a new occurrence.
+func (e *escalationDeduper) flush() []repeatedEscalation {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.closeExpiredLocked()
+}
+
+// closeExpiredLocked drops windows older than the dedup window and returns a
+// summary for each one that suppressed duplicates. Caller must hold e.mu.
+func (e *escalationDeduper) closeExpiredLocked() []repeatedEscalation {
+	now := e.now()
+	var closed []repeatedEscalation
+	for key, w := range e.windows {
+		if now.Sub(w.start) < e.window {
+			continue
+		}
+		delete(e.windows, key)
+		if w.count > 1 {
+			closed = append(closed, repeatedEscalation{source: key.source, message: key.message, count: w.count})
+		}
+	}
+	sort.Slice(closed, func(i, j int) bool {
+		if closed[i].source != closed[j].source {
+			return closed[i].source < closed[j].source
+		}
+		return closed[i].message < closed[j].message
+	})
+	return closed
+}
+
+// escalate sends an escalation to the mayor, collapsing repeats of the same
+// (source, message) within the dedup window into a single alert. Every alert
+// sent is also recorded in daemon/escalations.jsonl.
+func (d *Daemon) escalate(source, message string) {
+	if d.escalations == nil {
+		d.sendEscalation(source, message)
+		d.recordEscalation(source, message, 1)
+		return
+	}
+	sendNow, closed := d.escalations.observe(source, message)
+	d.sendRepeatedEscalations(closed)
+	if sendNow {
+		d.sendEscalation(source, message)
+		d.recordEscalation(source, message, 1)
+	} else {
+		d.logger.Printf("%s: escalation suppressed as duplicate: %s", source, message)
+	}
+}
+
+// flushEscalations sends summaries for dedup windows that closed without a
+// further occurrence. Called once per heartbeat.
+func (d *Daemon) flushEscalations() {
+	if d.escalations == nil {
+		return
+	}
+	d.sendRepeatedEscalations(d.escalations.flush())
+}
+
+func (d *Daemon) sendRepeatedEscalations(closed []repeatedEscalation) {
+	for _, r := range closed {
+		d.sendEscalation(r.source, fmt.Sprintf("%s (occurred %d times in the last %s)",
+			r.message, r.count, d.escalations.window))
+		d.recordEscalation(r.source, r.message, r.count)
+	}
+}
@@ -0,0 +1,130 @@
+package daemon
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEscalationDeduperCollapsesRepeatsInWindow(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	e := newEscalationDeduper(30 * time.Minute)
+	e.now = func() time.Time { return now }
+
+	sent := 0
+	for i := 0; i < 10; i++ {
+		sendNow, closed := e.observe("doctor_dog", "Dolt server unreachable")
+		if sendNow {
+			sent++
+		}
+		if len(closed) != 0 {
+			t.Fatalf("occurrence %d closed a window early: %+v", i, closed)
+		}
+		now = now.Add(time.Minute)
+	}
+	if sent != 1 {
+		t.Errorf("sent %d alerts for 10 identical escalations, want 1 (the first)", sent)
+	}
+
+	// A different message is not a duplicate.
+	if sendNow, _ := e.observe("doctor_dog", "disk usage high"); !sendNow {
+		t.Error("distinct escalation was suppressed")
+	}
+
+	now = now.Add(30 * time.Minute)
+	closed := e.flush()
+	if len(closed) != 1 {
+		t.Fatalf("flush returned %d summaries, want 1: %+v", len(closed), closed)
+	}
+	if got := closed[0]; got.source != "doctor_dog" || got.message != "Dolt server unreachable" || got.count != 10 {
+		t.Errorf("summary = %+v, want doctor_dog/Dolt server unreachable count=10", got)
+	}
+
+	// The next window starts fresh and sends immediately again.
+	if sendNow, _ := e.observe("doctor_dog", "Dolt server unreachable"); !sendNow {
+		t.Error("first escalation of the next window was suppressed")
+	}
+}
+
+func TestEscalationDeduperZeroWindowDisables(t *testing.T) {
+	e := newEscalationDeduper(0)
+	for i := 0; i < 3; i++ {
+		if sendNow, _ := e.observe("compactor_dog", "failed"); !sendNow {
+			t.Fatalf("occurrence %d suppressed with dedup disabled", i)
+		}
+	}
+}
+
+func TestEscalateSendsOneAlertWithCount(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses Unix shell script mocks")
+	}
+
+	binDir := t.TempDir()
+	escalated := filepath.Join(binDir, "escalated.log")
+	script := "#!/bin/sh\nprintf '%s\\n' \"$*\" >> \"" + escalated + "\"\n"
+	if err := os.WriteFile(filepath.Join(binDir, "gt"), []byte(script), 0o755); err != nil {
+		t.Fatalf("write fake gt: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var logBuf bytes.Buffer
+	d := &Daemon{
+		config:      &Config{TownRoot: t.TempDir()},
+		logger:      log.New(&logBuf, "", 0),
+		escalations: newEscalationDeduper(30 * time.Minute),
+	}
+	d.escalations.now = func() time.Time { return now }
+
+	for i := 0; i < 10; i++ {
+		d.escalate("dolt_remotes", "push failed: connection refused")
+	}
+	now = now.Add(31 * time.Minute)
+	d.flushEscalations()
+
+	data, err := os.ReadFile(escalated)
+	if err != nil {
+		t.Fatalf("read escalations: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d gt escalate calls, want first occurrence + one summary:\n%s", len(lines), data)
+	}
+	if strings.Contains(lines[0], "occurred") {
+		t.Errorf("first alert should be the plain message, got %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "dolt_remotes: push failed: connection refused (occurred 10 times in the last 30m0s)") {
+		t.Errorf("summary alert = %q, want count=10", lines[1])
+	}
+
+	records, err := ReadEscalationLog(d.config.TownRoot, time.Time{})
+	if err != nil {
+		t.Fatalf("ReadEscalationLog: %v", err)
+	}
+	if len(records) != 2 || records[0].Count != 1 || records[1].Count != 10 {
+		t.Errorf("escalation history = %+v, want counts 1 then 10", records)
+	}
+	if records[1].Message != "push failed: connection refused" {
+		t.Errorf("summary recorded with message %q, want the original message", records[1].Message)
+	}
+}
+
+func TestEscalationDedupWindowConfig(t *testing.T) {
+	if got := escalationDedupWindow(nil); got != defaultEscalationDedupWindow {
+		t.Errorf("default = %v, want %v", got, defaultEscalationDedupWindow)
+	}
+	cfg := &DaemonPatrolConfig{Patrols: &PatrolsConfig{Escalation: &EscalationConfig{DedupWindowStr: "5m"}}}
+	if got := escalationDedupWindow(cfg); got != 5*time.Minute {
+		t.Errorf("configured = %v, want 5m", got)
+	}
+	cfg.Patrols.Escalation.DedupWindowStr = "0"
+	if got := escalationDedupWindow(cfg); got != 0 {
+		t.Errorf("explicit zero = %v, want 0 (disabled)", got)
+	}
+}
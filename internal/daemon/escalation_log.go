@@ -0,0 +1,135 @@
+package daemon
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// maxEscalationLogSize caps daemon/escalations.jsonl. When exceeded, the file
+// is truncated to keep the newest half, like the events feed.
+const maxEscalationLogSize int64 = 1024 * 1024 // 1MB
+
+// EscalationRecord is one alert sent by the daemon, as recorded in
+// daemon/escalations.jsonl.
+type EscalationRecord struct {
+	Time    time.Time `json:"ts"`
+	Patrol  string    `json:"patrol"`
+	Message string    `json:"message"`
+	// Count is the number of identical escalations this alert covers:
+	// 1 for an immediate alert, more for a dedup window summary.
+	Count int `json:"count"`
+}
+
+// escalationLogMu serializes appends from concurrent patrols.
+var escalationLogMu sync.Mutex
+
+// EscalationLogFile returns the path to the daemon's escalation history.
+func EscalationLogFile(townRoot string) string {
+	return filepath.Join(townRoot, "daemon", "escalations.jsonl")
+}
+
+// recordEscalation appends an alert to the escalation history. Best-effort:
+// failures are logged, never returned, so history never blocks alerting.
+func (d *Daemon) recordEscalation(patrol, message string, count int) {
+	rec := EscalationRecord{Time: time.Now().UTC(), Patrol: patrol, Message: message, Count: count}
+	if err := appendEscalationRecord(EscalationLogFile(d.config.TownRoot), rec, maxEscalationLogSize); err != nil {
+		d.logger.Printf("%s: recording escalation: %v", patrol, err)
+	}
+}
+
+func appendEscalationRecord(path string, rec EscalationRecord, maxSize int64) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	escalationLogMu.Lock()
+	defer escalationLogMu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if info, err := os.Stat(path); err == nil && info.Size() > maxSize {
+		truncateEscalationLog(path, info.Size())
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// truncateEscalationLog keeps the newest half of the log using atomic rename.
+// Must be called under escalationLogMu.
+func truncateEscalationLog(path string, currentSize int64) {
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(currentSize-currentSize/2, io.SeekStart); err != nil {
+		return
+	}
+	reader := bufio.NewReader(f)
+	// Skip the partial line at the cut point.
+	if _, err := reader.ReadString('\n'); err != nil {
+		return
+	}
+
+	tmpPath := path + ".truncate.tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return
+	}
+	if _, err := io.Copy(tmp, reader); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return
+	}
+	tmp.Close()
+
+	// Close the read handle before rename — Windows cannot rename over open files.
+	f.Close()
+	os.Rename(tmpPath, path) //nolint:errcheck // best-effort truncation
+}
+
+// ReadEscalationLog returns recorded escalations at or after since, oldest
+// first. A missing log means no escalations; malformed lines are skipped.
+func ReadEscalationLog(townRoot string, since time.Time) ([]EscalationRecord, error) {
+	f, err := os.Open(EscalationLogFile(townRoot))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []EscalationRecord
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var rec EscalationRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			continue
+		}
+		if !since.IsZero() && rec.Time.Before(since) {
+			continue
+		}
+		records = append(records, rec)
+	}
+	return records, scanner.Err()
+}
@@ -0,0 +1,92 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEscalationLogAppendAndReadSince(t *testing.T) {
+	townRoot := t.TempDir()
+	path := EscalationLogFile(townRoot)
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for i, rec := range []EscalationRecord{
+		{Time: base, Patrol: "doctor_dog", Message: "Dolt unreachable", Count: 1},
+		{Time: base.Add(time.Hour), Patrol: "doctor_dog", Message: "Dolt unreachable", Count: 10},
+		{Time: base.Add(2 * time.Hour), Patrol: "compactor_dog", Message: "compaction failed", Count: 1},
+	} {
+		if err := appendEscalationRecord(path, rec, maxEscalationLogSize); err != nil {
+			t.Fatalf("append %d: %v", i, err)
+		}
+	}
+	// A torn write must not hide the records around it.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Fprintln(f, `{"ts":`)
+	f.Close()
+
+	all, err := ReadEscalationLog(townRoot, time.Time{})
+	if err != nil {
+		t.Fatalf("ReadEscalationLog: %v", err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("read %d records, want 3: %+v", len(all), all)
+	}
+	if all[1].Count != 10 || all[1].Patrol != "doctor_dog" {
+		t.Errorf("record 1 = %+v, want doctor_dog count=10", all[1])
+	}
+
+	recent, err := ReadEscalationLog(townRoot, base.Add(90*time.Minute))
+	if err != nil {
+		t.Fatalf("ReadEscalationLog since: %v", err)
+	}
+	if len(recent) != 1 || recent[0].Patrol != "compactor_dog" {
+		t.Errorf("since filter returned %+v, want only compactor_dog", recent)
+	}
+}
+
+func TestEscalationLogMissingFile(t *testing.T) {
+	records, err := ReadEscalationLog(t.TempDir(), time.Time{})
+	if err != nil || records != nil {
+		t.Errorf("ReadEscalationLog on missing file = %v, %v; want nil, nil", records, err)
+	}
+}
+
+func TestEscalationLogTruncatesToNewestHalf(t *testing.T) {
+	townRoot := t.TempDir()
+	path := EscalationLogFile(townRoot)
+	const maxSize = 4096
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	n := 0
+	for ; n < 200; n++ {
+		rec := EscalationRecord{Time: base.Add(time.Duration(n) * time.Minute), Patrol: "p", Message: strings.Repeat("x", 40), Count: n}
+		if err := appendEscalationRecord(path, rec, maxSize); err != nil {
+			t.Fatalf("append %d: %v", n, err)
+		}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// At most one record past the cap before the next append truncates.
+	if info.Size() > 2*maxSize {
+		t.Errorf("log size %d not capped near %d", info.Size(), maxSize)
+	}
+	records, err := ReadEscalationLog(townRoot, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) == 0 || records[len(records)-1].Count != n-1 {
+		t.Fatalf("newest record lost after truncation: %+v", records)
+	}
+	if records[0].Count == 0 {
+		t.Error("oldest records were not dropped")
+	}
+}
@@ -1,11 +1,13 @@
 package daemon
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestHasAssignedOpenWork_UsesPinnedBeadsDirInsteadOfRigOrRepoFlag(t *testing.T) {
@@ -77,3 +79,31 @@ printf '[{"id":"gt-123"}]\n'
 		t.Fatalf("expected bd call to pin BEADS_DIR to %q, got %q", expectedBeadsDir, loggedArgs)
 	}
 }
+
+func TestHasAssignedOpenWork_CancelledContextReturnsPromptly(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses Unix shell script mocks")
+	}
+
+	binDir := t.TempDir()
+	bdPath := filepath.Join(binDir, "bd")
+	if err := os.WriteFile(bdPath, []byte("#!/bin/sh\nexec sleep 30\n"), 0o755); err != nil {
+		t.Fatalf("write fake bd: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // daemon is shutting down
+	d := &Daemon{
+		config: &Config{TownRoot: t.TempDir()},
+		bdPath: bdPath,
+		ctx:    ctx,
+	}
+
+	start := time.Now()
+	if d.hasAssignedOpenWork("gastown", "polecats/rust") {
+		t.Fatal("expected no assigned work when the probe is cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("probe took %s after cancellation, want prompt return", elapsed)
+	}
+}
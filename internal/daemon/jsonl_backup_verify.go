@@ -0,0 +1,132 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// JsonlBackupDBStatus is the verification result for one database's
+// issues.jsonl in the backup repo.
+type JsonlBackupDBStatus struct {
+	DB        string  `json:"db"`
+	Previous  int     `json:"previous"`  // records in HEAD (0 = first export)
+	Current   int     `json:"current"`   // records in the working tree
+	Pollution int     `json:"pollution"` // records filterTestPollution would remove
+	Spike     bool    `json:"spike"`
+	Delta     float64 `json:"delta,omitempty"` // fractional change, set for spikes
+}
+
+// JsonlBackupVerifyResult is the outcome of VerifyJsonlBackup.
+type JsonlBackupVerifyResult struct {
+	GitRepo   string                `json:"git_repo"`
+	Threshold float64               `json:"threshold"`
+	Databases []JsonlBackupDBStatus `json:"databases"`
+	// SpikeReport is the formatted report the backup patrol would escalate,
+	// empty when no spikes were found.
+	SpikeReport string `json:"spike_report,omitempty"`
+}
+
+// Spikes returns the number of databases whose count change exceeds the threshold.
+func (r *JsonlBackupVerifyResult) Spikes() int {
+	n := 0
+	for _, db := range r.Databases {
+		if db.Spike {
+			n++
+		}
+	}
+	return n
+}
+
+// VerifyJsonlBackup runs the backup patrol's spike and pollution checks
+// against gitRepo without modifying it: each database's exported
+// issues.jsonl (working tree) is compared to the last commit, and the
+// records filterTestPollution would drop are counted. The spike baseline
+// left by a halted export is honored but never written.
+//
+// When databases is empty, every top-level directory holding an
+// issues.jsonl is checked.
+func VerifyJsonlBackup(gitRepo string, databases []string, threshold float64) (*JsonlBackupVerifyResult, error) {
+	if _, err := os.Stat(filepath.Join(gitRepo, ".git")); err != nil {
+		return nil, fmt.Errorf("%s is not a git repo: %w", gitRepo, err)
+	}
+	if threshold <= 0 || threshold > 1.0 {
+		return nil, fmt.Errorf("threshold must be in (0, 1], got %v", threshold)
+	}
+
+	if len(databases) == 0 {
+		var err error
+		if databases, err = jsonlBackupDatabases(gitRepo); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &JsonlBackupVerifyResult{GitRepo: gitRepo, Threshold: threshold}
+	counts := make(map[string]int, len(databases))
+	for _, db := range databases {
+		if !validDBName.MatchString(db) {
+			continue
+		}
+		issuesPath := filepath.Join(gitRepo, db, "issues.jsonl")
+		data, err := os.ReadFile(issuesPath)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", issuesPath, err)
+		}
+		current, err := countFileLines(issuesPath)
+		if err != nil {
+			return nil, fmt.Errorf("counting %s: %w", issuesPath, err)
+		}
+		previous, _ := previousCommitLineCount(gitRepo, filepath.Join(db, "issues.jsonl"))
+		_, pollution := filterTestPollution(data)
+
+		counts[db] = current
+		result.Databases = append(result.Databases, JsonlBackupDBStatus{
+			DB:        db,
+			Previous:  previous,
+			Current:   current,
+			Pollution: pollution,
+		})
+	}
+
+	spikes := detectExportSpikes(gitRepo, databases, counts, threshold, loadSpikeBaseline(gitRepo),
+		func(string, ...interface{}) {})
+	for _, spike := range spikes {
+		for i := range result.Databases {
+			if result.Databases[i].DB == spike.DB {
+				result.Databases[i].Spike = true
+				result.Databases[i].Delta = spike.Delta
+			}
+		}
+	}
+	if len(spikes) > 0 {
+		result.SpikeReport = formatSpikeReport(spikes)
+	}
+	return result, nil
+}
+
+// jsonlBackupDatabases lists the top-level directories of gitRepo that hold
+// an issues.jsonl export, sorted by name.
+func jsonlBackupDatabases(gitRepo string) ([]string, error) {
+	entries, err := os.ReadDir(gitRepo)
+	if err != nil {
+		return nil, err
+	}
+	var databases []string
+	for _, e := range entries {
+		if !e.IsDir() || !validDBName.MatchString(e.Name()) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(gitRepo, e.Name(), "issues.jsonl")); err == nil {
+			databases = append(databases, e.Name())
+		}
+	}
+	sort.Strings(databases)
+	return databases, nil
+}
+
+// JsonlBackupSpikeThreshold returns the configured spike threshold, or the
+// default the backup patrol uses.
+func JsonlBackupSpikeThreshold(config *JsonlGitBackupConfig) float64 {
+	return spikeThreshold(config)
+}
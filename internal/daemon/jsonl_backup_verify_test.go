@@ -0,0 +1,64 @@
+package daemon
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVerifyJsonlBackup_ReportsSpikeAndPollutionReadOnly(t *testing.T) {
+	gitRepo := t.TempDir()
+	initGitRepo(t, gitRepo)
+
+	for _, db := range []string{"hq", "gastown"} {
+		os.MkdirAll(filepath.Join(gitRepo, db), 0755)
+		writeNLines(t, filepath.Join(gitRepo, db, "issues.jsonl"), 100)
+	}
+	commitAll(t, gitRepo, "baseline")
+
+	// hq drops to 40 records (60% drop), one of which is test pollution;
+	// gastown is unchanged.
+	hqPath := filepath.Join(gitRepo, "hq", "issues.jsonl")
+	writeNLines(t, hqPath, 39)
+	f, err := os.OpenFile(hqPath, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(`{"id":"bd-1","title":"Test Issue 1"}` + "\n")
+	f.Close()
+	before, _ := os.ReadFile(hqPath)
+
+	result, err := VerifyJsonlBackup(gitRepo, nil, 0.20)
+	if err != nil {
+		t.Fatalf("VerifyJsonlBackup: %v", err)
+	}
+
+	if len(result.Databases) != 2 || result.Databases[0].DB != "gastown" || result.Databases[1].DB != "hq" {
+		t.Fatalf("databases = %+v, want gastown and hq", result.Databases)
+	}
+	hq := result.Databases[1]
+	if !hq.Spike || hq.Previous != 100 || hq.Current != 40 || hq.Pollution != 1 {
+		t.Errorf("hq = %+v, want spike 100 → 40 with 1 pollution record", hq)
+	}
+	if result.Databases[0].Spike {
+		t.Errorf("gastown should not spike: %+v", result.Databases[0])
+	}
+	if result.Spikes() != 1 || !strings.Contains(result.SpikeReport, "hq: 100 → 40") {
+		t.Errorf("Spikes() = %d, report = %q", result.Spikes(), result.SpikeReport)
+	}
+
+	// Read-only: no baseline written, export untouched.
+	if sb := loadSpikeBaseline(gitRepo); sb != nil {
+		t.Errorf("verify must not save a spike baseline, got %+v", sb)
+	}
+	if after, _ := os.ReadFile(hqPath); string(after) != string(before) {
+		t.Error("verify must not rewrite issues.jsonl")
+	}
+}
+
+func TestVerifyJsonlBackup_RejectsNonRepo(t *testing.T) {
+	if _, err := VerifyJsonlBackup(t.TempDir(), nil, 0.20); err == nil {
+		t.Error("expected an error for a directory that is not a git repo")
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"os/exec"
@@ -91,15 +93,10 @@ func (d *Daemon) syncJsonlGitBackup() {
 
 	config := d.patrolConfig.Patrols.JsonlGitBackup
 
-	// Resolve git repo path.
-	gitRepo := config.GitRepo
-	if gitRepo == "" {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			d.logger.Printf("jsonl_git_backup: cannot determine home dir: %v", err)
-			return
-		}
-		gitRepo = filepath.Join(homeDir, ".dolt-archive", "git")
+	gitRepo, err := JsonlBackupGitRepo(config)
+	if err != nil {
+		d.logger.Printf("jsonl_git_backup: %v", err)
+		return
 	}
 
 	// Verify git repo exists.
@@ -500,8 +497,9 @@ func (d *Daemon) runGitCmd(dir string, timeout time.Duration, args ...string) er
 	return nil
 }
 
-// escalate sends an escalation message to the mayor via gt escalate.
-func (d *Daemon) escalate(source, message string) {
+// sendEscalation sends an escalation message to the mayor via gt escalate.
+// Patrols call escalate, which deduplicates before sending.
+func (d *Daemon) sendEscalation(source, message string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -511,11 +509,24 @@ func (d *Daemon) escalate(source, message string) {
 	cmd.Env = append(os.Environ(), "BD_ACTOR=daemon")
 	util.SetDetachedProcessGroup(cmd)
 	if output, err := cmd.CombinedOutput(); err != nil {
-		d.logger.Printf("jsonl_git_backup: escalation failed: %v (%s)", err, strings.TrimSpace(string(output)))
+		d.logger.Printf("%s: escalation failed: %v (%s)", source, err, strings.TrimSpace(string(output)))
+	}
+}
+
+// JsonlBackupGitRepo returns the configured backup git repo path, or the
+// default ~/.dolt-archive/git.
+func JsonlBackupGitRepo(config *JsonlGitBackupConfig) (string, error) {
+	if config != nil && config.GitRepo != "" {
+		return config.GitRepo, nil
 	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("cannot determine home dir: %w", err)
+	}
+	return filepath.Join(homeDir, ".dolt-archive", "git"), nil
 }
 
-// spikeThreshold returns the configured spike threshold or the default (20%).
+// spikeThreshold returns the configured spike threshold or the default (50%).
 func spikeThreshold(config *JsonlGitBackupConfig) float64 {
 	if config != nil && config.SpikeThreshold != nil {
 		t := *config.SpikeThreshold
@@ -694,11 +705,26 @@ func removeSpikeBaseline(gitRepo string) {
 // proceeds. This prevents permanent blocking after legitimate large changes
 // (e.g., Reaper purges, filter updates).
 func (d *Daemon) verifyExportCounts(gitRepo string, databases []string, counts map[string]int, threshold float64) []spikeInfo {
+	spikes := detectExportSpikes(gitRepo, databases, counts, threshold, loadSpikeBaseline(gitRepo), d.logger.Printf)
+
+	// Save or clear spike baseline depending on results.
+	if len(spikes) > 0 {
+		if err := saveSpikeBaseline(gitRepo, counts); err != nil {
+			d.logger.Printf("jsonl_git_backup: failed to save spike baseline: %v", err)
+		}
+	}
+
+	return spikes
+}
+
+// detectExportSpikes is the read-only core of verifyExportCounts: it compares
+// counts to each database's issues.jsonl in HEAD and returns the spikes,
+// accepting counts that are stable relative to spikeBase. logf receives the
+// per-database diagnostics.
+func detectExportSpikes(gitRepo string, databases []string, counts map[string]int, threshold float64, spikeBase *spikeBaseline, logf func(format string, args ...interface{})) []spikeInfo {
 	const minAbsoluteDelta = 20 // ignore changes smaller than this many records
 
 	var spikes []spikeInfo
-	spikeBase := loadSpikeBaseline(gitRepo)
-
 	for _, db := range databases {
 		currentCount, ok := counts[db]
 		if !ok {
@@ -708,12 +734,12 @@ func (d *Daemon) verifyExportCounts(gitRepo string, databases []string, counts m
 		relPath := filepath.Join(db, "issues.jsonl")
 		prevCount, err := previousCommitLineCount(gitRepo, relPath)
 		if err != nil {
-			d.logger.Printf("jsonl_git_backup: verify: %s: error reading baseline: %v", db, err)
+			logf("jsonl_git_backup: verify: %s: error reading baseline: %v", db, err)
 			continue
 		}
 		if prevCount == 0 {
 			// First export — no baseline to compare against.
-			d.logger.Printf("jsonl_git_backup: verify: %s: first export (%d records), skipping spike check", db, currentCount)
+			logf("jsonl_git_backup: verify: %s: first export (%d records), skipping spike check", db, currentCount)
 			continue
 		}
 
@@ -743,7 +769,7 @@ func (d *Daemon) verifyExportCounts(gitRepo string, databases []string, counts m
 				if baseCount, ok := spikeBase.Counts[db]; ok && baseCount > 0 {
 					baseDelta := math.Abs(float64(currentCount-baseCount)) / float64(baseCount)
 					if baseDelta <= threshold {
-						d.logger.Printf("jsonl_git_backup: %s: count stable vs spike baseline (%d → %d, %.1f%% vs baseline %d), accepting new level",
+						logf("jsonl_git_backup: %s: count stable vs spike baseline (%d → %d, %.1f%% vs baseline %d), accepting new level",
 							db, prevCount, currentCount, fractionalDelta*100, baseCount)
 						continue // Stable relative to spike baseline — not a new spike.
 					}
@@ -763,18 +789,11 @@ func (d *Daemon) verifyExportCounts(gitRepo string, databases []string, counts m
 			if currentCount < prevCount {
 				direction = "drop"
 			}
-			d.logger.Printf("jsonl_git_backup: SPIKE DETECTED: %s: %s from %d to %d (%.1f%% %s, threshold %.1f%%)",
+			logf("jsonl_git_backup: SPIKE DETECTED: %s: %s from %d to %d (%.1f%% %s, threshold %.1f%%)",
 				db, direction, prevCount, currentCount, fractionalDelta*100, direction, effectiveThreshold*100)
 		}
 	}
 
-	// Save or clear spike baseline depending on results.
-	if len(spikes) > 0 {
-		if err := saveSpikeBaseline(gitRepo, counts); err != nil {
-			d.logger.Printf("jsonl_git_backup: failed to save spike baseline: %v", err)
-		}
-	}
-
 	return spikes
 }
 
@@ -794,7 +813,10 @@ func formatSpikeReport(spikes []spikeInfo) string {
 	return b.String()
 }
 
-// countFileLines counts the number of non-empty lines in a file.
+// countFileLines counts the number of non-empty lines in a file. It streams
+// through a fixed-size buffer, so memory stays bounded regardless of file
+// size or line length (a bufio.Scanner would fail on records over its token
+// limit). A final line without a trailing newline is counted.
 func countFileLines(path string) (int, error) {
 	f, err := os.Open(path)
 	if err != nil {
@@ -802,15 +824,31 @@ func countFileLines(path string) (int, error) {
 	}
 	defer f.Close()
 
+	r := bufio.NewReaderSize(f, 256*1024)
 	count := 0
-	scanner := bufio.NewScanner(f)
-	scanner.Buffer(make([]byte, 0, 256*1024), 1024*1024)
-	for scanner.Scan() {
-		if len(scanner.Bytes()) > 0 {
-			count++
+	lineHasData := false
+	for {
+		chunk, err := r.ReadSlice('\n')
+		if len(bytes.TrimRight(chunk, "\r\n")) > 0 {
+			lineHasData = true
+		}
+		switch {
+		case err == nil:
+			if lineHasData {
+				count++
+			}
+			lineHasData = false
+		case errors.Is(err, bufio.ErrBufferFull):
+			// Line continues past the buffer; keep reading it.
+		case errors.Is(err, io.EOF):
+			if lineHasData {
+				count++
+			}
+			return count, nil
+		default:
+			return count, err
 		}
 	}
-	return count, scanner.Err()
 }
 
 // recountAfterFilter re-reads the issues.jsonl file for each database to get
@@ -886,13 +924,29 @@ func (d *Daemon) verifyNoPollution(gitRepo string, databases []string) int {
 	return total
 }
 
-// parseLineCount parses a line count from `wc -l` style output or plain integer.
+// parseLineCount parses a line count from `wc -l` style output or a plain
+// integer. Accepted forms, with any leading/trailing whitespace (including
+// a trailing newline or CRLF):
+//
+//	"42"                      plain count
+//	"  42 issues.jsonl"       GNU wc: count, space, filename
+//	"      42 issues.jsonl"   BSD/macOS wc: count right-aligned in a wide column
+//	"42\tissues.jsonl"        tab-separated fields
+//
+// Only the first field is read and it must be an unsigned decimal integer;
+// anything else (empty input, "abc", "-1", "+4") is an error.
 func parseLineCount(s string) (int, error) {
-	s = strings.TrimSpace(s)
-	// wc -l output format: "  42 filename" or just "42"
 	fields := strings.Fields(s)
 	if len(fields) == 0 {
 		return 0, fmt.Errorf("empty input")
 	}
-	return strconv.Atoi(fields[0])
+	count := fields[0]
+	if count[0] < '0' || count[0] > '9' {
+		return 0, fmt.Errorf("invalid line count %q", count)
+	}
+	n, err := strconv.Atoi(count)
+	if err != nil {
+		return 0, fmt.Errorf("invalid line count %q: %w", count, err)
+	}
+	return n, nil
 }
@@ -1,7 +1,9 @@
 package daemon
 
 import (
+	"bufio"
 	"encoding/json"
+	"fmt"
 	"io"
 	"log"
 	"os"
@@ -527,6 +529,51 @@ func TestCountFileLines_Empty(t *testing.T) {
 	}
 }
 
+func TestCountFileLines_NoTrailingNewline(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "partial.jsonl")
+	os.WriteFile(path, []byte("{\"id\":\"a\"}\n\n{\"id\":\"b\"}\n{\"id\":\"c\"}"), 0644)
+
+	got, err := countFileLines(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Blank lines are skipped; the unterminated final line counts.
+	if got != 3 {
+		t.Errorf("expected 3 lines, got %d", got)
+	}
+}
+
+func TestCountFileLines_Large(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "large.jsonl")
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := bufio.NewWriter(f)
+	const n = 200000
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(w, "{\"id\":\"rec-%d\",\"title\":\"Record %d\"}\n", i, i)
+	}
+	// One record far longer than the read buffer and bufio.Scanner's default
+	// token limit must still count as a single line.
+	fmt.Fprintf(w, "{\"id\":\"big\",\"description\":\"%s\"}\n", strings.Repeat("x", 3*1024*1024))
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	got, err := countFileLines(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != n+1 {
+		t.Errorf("expected %d lines, got %d", n+1, got)
+	}
+}
+
 func TestParseLineCount(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -536,8 +583,19 @@ func TestParseLineCount(t *testing.T) {
 		{"42", 42, false},
 		{"  42 filename.jsonl", 42, false},
 		{"  0", 0, false},
+		{"42\n", 42, false},
+		{"42\r\n", 42, false},
+		{"42\tfilename.jsonl", 42, false},
+		{"\t42\tfilename.jsonl\n", 42, false},
+		{"      42 filename.jsonl", 42, false},
+		{"42   filename with spaces.jsonl", 42, false},
 		{"", 0, true},
+		{" \t\n", 0, true},
 		{"abc", 0, true},
+		{"abc 42", 0, true},
+		{"-1", 0, true},
+		{"+42", 0, true},
+		{"4x2 filename.jsonl", 0, true},
 	}
 	for _, tt := range tests {
 		got, err := parseLineCount(tt.input)
@@ -42,7 +42,7 @@ const MaxLifecycleMessageAge = 6 * time.Hour
 // ProcessLifecycleRequests checks for and processes lifecycle requests from the deacon inbox.
 func (d *Daemon) ProcessLifecycleRequests() {
 	// Get mail for deacon identity (using gt mail, not bd mail)
-	cmd := exec.Command(d.gtPath, "mail", "inbox", "--identity", "deacon/", "--json")
+	cmd := exec.CommandContext(d.subprocessCtx(), d.gtPath, "mail", "inbox", "--identity", "deacon/", "--json")
 	cmd.Dir = d.config.TownRoot
 	cmd.Env = bdReadOnlyPinnedEnv(filepath.Join(d.config.TownRoot, ".beads"))
 	util.SetDetachedProcessGroup(cmd)
@@ -745,7 +745,7 @@ func (d *Daemon) syncWorkspace(workDir string) {
 // isWorkingTreeDirty checks if a git working tree has uncommitted changes.
 func (d *Daemon) isWorkingTreeDirty(workDir string) bool {
 	// "git status --porcelain" outputs nothing if clean
-	cmd := exec.Command("git", "status", "--porcelain")
+	cmd := exec.CommandContext(d.subprocessCtx(), "git", "status", "--porcelain")
 	cmd.Dir = workDir
 	cmd.Env = os.Environ()
 	util.SetDetachedProcessGroup(cmd)
@@ -833,7 +833,7 @@ func (d *Daemon) getAgentBeadState(agentBeadID string) (string, error) {
 // kills mid-work after 3x threshold (hq-3kri). Pin the lookup to the town
 // .beads so the reaper sees the truth.
 func (d *Daemon) getAgentBeadInfo(agentBeadID string) (*AgentBeadInfo, error) {
-	cmd := exec.Command(d.bdPath, "show", agentBeadID, "--json")
+	cmd := exec.CommandContext(d.subprocessCtx(), d.bdPath, "show", agentBeadID, "--json")
 	cmd.Dir = d.config.TownRoot
 	cmd.Env = bdReadOnlyPinnedEnv(filepath.Join(d.config.TownRoot, ".beads"))
 	util.SetDetachedProcessGroup(cmd)
@@ -894,7 +894,7 @@ func (d *Daemon) getAgentBeadInfo(agentBeadID string) (*AgentBeadInfo, error) {
 // Used for TOCTOU re-verification before taking destructive action on agents.
 // Returns empty string on error or if no hook_bead is set.
 func (d *Daemon) getAgentHookBead(agentBeadID string) string {
-	cmd := exec.Command(d.bdPath, "show", agentBeadID, "--json")
+	cmd := exec.CommandContext(d.subprocessCtx(), d.bdPath, "show", agentBeadID, "--json")
 	cmd.Dir = d.config.TownRoot
 	cmd.Env = bdReadOnlyPinnedEnv(filepath.Join(d.config.TownRoot, ".beads"))
 	util.SetDetachedProcessGroup(cmd)
@@ -986,7 +986,7 @@ const GUPPViolationTimeout = constants.GUPPViolationTimeout
 // The wisps query is best-effort (gracefully ignored if table doesn't exist).
 func (d *Daemon) listAgentBeadsJSON(dest interface{}) error {
 	// Query issues table (backward compat during migration)
-	cmd := exec.Command(d.bdPath, "list", "--label=gt:agent", "--json", "--flat") //nolint:gosec // G204: bd is a trusted internal tool
+	cmd := exec.CommandContext(d.subprocessCtx(), d.bdPath, "list", "--label=gt:agent", "--json", "--flat") //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Dir = d.config.TownRoot
 	cmd.Env = bdReadOnlyPinnedEnv(filepath.Join(d.config.TownRoot, ".beads"))
 	util.SetDetachedProcessGroup(cmd)
@@ -994,7 +994,7 @@ func (d *Daemon) listAgentBeadsJSON(dest interface{}) error {
 	issuesOutput, issuesErr := cmd.Output()
 
 	// Query wisps table (primary source after agent bead migration)
-	wispCmd := exec.Command(d.bdPath, "mol", "wisp", "list", "--json") //nolint:gosec // G204: bd is a trusted internal tool
+	wispCmd := exec.CommandContext(d.subprocessCtx(), d.bdPath, "mol", "wisp", "list", "--json") //nolint:gosec // G204: bd is a trusted internal tool
 	wispCmd.Dir = d.config.TownRoot
 	wispCmd.Env = bdReadOnlyPinnedEnv(filepath.Join(d.config.TownRoot, ".beads"))
 	util.SetDetachedProcessGroup(wispCmd)
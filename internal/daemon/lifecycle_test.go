@@ -1,12 +1,14 @@
 package daemon
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -574,3 +576,38 @@ func TestGetStartCommand_ClaudeAgentFallsThrough(t *testing.T) {
 		t.Errorf("getStartCommand returned literal TOML start_command verbatim — beacon injection was skipped: %q", startCmd)
 	}
 }
+
+func TestCloseMessage_CompletesAfterDaemonShutdown(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses Unix shell script mocks")
+	}
+
+	binDir := t.TempDir()
+	marker := filepath.Join(binDir, "deleted")
+	gtPath := filepath.Join(binDir, "gt")
+	script := "#!/bin/sh\necho \"$@\" > " + marker + "\n"
+	if err := os.WriteFile(gtPath, []byte(script), 0o755); err != nil {
+		t.Fatalf("write fake gt: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // daemon is shutting down
+	d := &Daemon{
+		config: &Config{TownRoot: t.TempDir()},
+		logger: log.New(io.Discard, "", 0),
+		gtPath: gtPath,
+		ctx:    ctx,
+	}
+
+	// Mail deletion is a mutation: shutdown must not kill it part-way.
+	if err := d.closeMessage("hq-msg1"); err != nil {
+		t.Fatalf("closeMessage after shutdown: %v", err)
+	}
+	got, err := os.ReadFile(marker)
+	if err != nil {
+		t.Fatalf("fake gt did not run: %v", err)
+	}
+	if strings.TrimSpace(string(got)) != "mail delete hq-msg1" {
+		t.Errorf("gt args = %q, want %q", strings.TrimSpace(string(got)), "mail delete hq-msg1")
+	}
+}
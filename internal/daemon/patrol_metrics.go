@@ -0,0 +1,164 @@
+package daemon
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/atomicfile"
+)
+
+// PatrolMetrics is the persisted form of the daemon's patrol counters
+// (daemon/patrol_metrics.json). Counts are cumulative across daemon restarts:
+// the daemon loads the file at startup and flushes it after every heartbeat
+// and on shutdown. Unlike the OTel instruments in metrics.go, these need no
+// collector and are read by 'gt daemon metrics'.
+type PatrolMetrics struct {
+	// Runs counts invocations per patrol name (e.g. "heartbeat",
+	// "wisp_reaper"), including runs that returned early because the patrol
+	// was disabled or found nothing to do.
+	Runs map[string]int64 `json:"runs"`
+
+	// LastRun is when each patrol last started a run.
+	LastRun map[string]time.Time `json:"last_run"`
+
+	// Actions counts things patrols did, keyed "<patrol>.<action>"
+	// (e.g. "wisp_reaper.reaped", "doctor_dog.molecules_poured").
+	Actions map[string]int64 `json:"actions"`
+
+	// Since is when counting began (first daemon start with metrics).
+	Since time.Time `json:"since"`
+
+	// UpdatedAt is when the counters were last flushed.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// PatrolMetricsFile returns the path to the persisted patrol counters.
+func PatrolMetricsFile(townRoot string) string {
+	return filepath.Join(townRoot, "daemon", "patrol_metrics.json")
+}
+
+// LoadPatrolMetrics reads the persisted patrol counters. A missing file
+// yields empty metrics, not an error.
+func LoadPatrolMetrics(townRoot string) (*PatrolMetrics, error) {
+	m := &PatrolMetrics{}
+	data, err := os.ReadFile(PatrolMetricsFile(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return m.normalize(), nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	return m.normalize(), nil
+}
+
+// SavePatrolMetrics writes the patrol counters to disk using atomic write.
+func SavePatrolMetrics(townRoot string, m *PatrolMetrics) error {
+	path := PatrolMetricsFile(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return atomicfile.WriteJSON(path, m)
+}
+
+func (m *PatrolMetrics) normalize() *PatrolMetrics {
+	if m.Runs == nil {
+		m.Runs = make(map[string]int64)
+	}
+	if m.Actions == nil {
+		m.Actions = make(map[string]int64)
+	}
+	if m.LastRun == nil {
+		m.LastRun = make(map[string]time.Time)
+	}
+	return m
+}
+
+// patrolCounters is the daemon's in-memory view of PatrolMetrics. Patrols run
+// on the main loop goroutine but the mutex keeps snapshots safe to take from
+// anywhere. All methods are nil-safe so tests can build a bare Daemon.
+type patrolCounters struct {
+	mu sync.Mutex
+	m  PatrolMetrics
+}
+
+// newPatrolCounters starts counting from loaded (which may be nil).
+func newPatrolCounters(loaded *PatrolMetrics) *patrolCounters {
+	c := &patrolCounters{}
+	if loaded != nil {
+		c.m = *loaded
+	}
+	c.m.normalize()
+	if c.m.Since.IsZero() {
+		c.m.Since = time.Now()
+	}
+	return c
+}
+
+// recordRun counts one run of the named patrol and stamps its last-run time.
+func (c *patrolCounters) recordRun(patrol string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.m.Runs[patrol]++
+	c.m.LastRun[patrol] = time.Now()
+}
+
+// recordAction adds n to the patrol's action counter. Non-positive n is
+// ignored so callers can pass per-cycle totals unconditionally.
+func (c *patrolCounters) recordAction(patrol, action string, n int) {
+	if c == nil || n <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.m.Actions[patrol+"."+action] += int64(n)
+}
+
+// snapshot returns a deep copy of the current counters.
+func (c *patrolCounters) snapshot() *PatrolMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &PatrolMetrics{
+		Runs:      make(map[string]int64, len(c.m.Runs)),
+		LastRun:   make(map[string]time.Time, len(c.m.LastRun)),
+		Actions:   make(map[string]int64, len(c.m.Actions)),
+		Since:     c.m.Since,
+		UpdatedAt: c.m.UpdatedAt,
+	}
+	for k, v := range c.m.Runs {
+		s.Runs[k] = v
+	}
+	for k, v := range c.m.LastRun {
+		s.LastRun[k] = v
+	}
+	for k, v := range c.m.Actions {
+		s.Actions[k] = v
+	}
+	return s
+}
+
+// flush persists the counters under townRoot.
+func (c *patrolCounters) flush(townRoot string) error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	c.m.UpdatedAt = time.Now()
+	c.mu.Unlock()
+	return SavePatrolMetrics(townRoot, c.snapshot())
+}
+
+// flushPatrolCounters persists the patrol counters, logging on failure.
+func (d *Daemon) flushPatrolCounters() {
+	if err := d.patrolCounters.flush(d.config.TownRoot); err != nil {
+		d.logger.Printf("Warning: failed to save patrol metrics: %v", err)
+	}
+}
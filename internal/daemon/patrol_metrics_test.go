@@ -0,0 +1,155 @@
+package daemon
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestPatrolCounters_Increment(t *testing.T) {
+	c := newPatrolCounters(nil)
+	c.recordRun("heartbeat")
+	c.recordRun("heartbeat")
+	c.recordRun("wisp_reaper")
+	c.recordAction("wisp_reaper", "reaped", 7)
+	c.recordAction("wisp_reaper", "reaped", 3)
+	c.recordAction("wisp_reaper", "purged", 0)  // ignored
+	c.recordAction("wisp_reaper", "purged", -1) // ignored
+
+	s := c.snapshot()
+	if s.Runs["heartbeat"] != 2 || s.Runs["wisp_reaper"] != 1 {
+		t.Errorf("Runs = %v, want heartbeat=2 wisp_reaper=1", s.Runs)
+	}
+	if s.Actions["wisp_reaper.reaped"] != 10 {
+		t.Errorf("wisp_reaper.reaped = %d, want 10", s.Actions["wisp_reaper.reaped"])
+	}
+	if _, ok := s.Actions["wisp_reaper.purged"]; ok {
+		t.Errorf("non-positive action counts should not create a key: %v", s.Actions)
+	}
+	if s.Since.IsZero() {
+		t.Error("Since not set for fresh counters")
+	}
+	if s.LastRun["heartbeat"].IsZero() || s.LastRun["wisp_reaper"].IsZero() {
+		t.Errorf("LastRun not stamped: %v", s.LastRun)
+	}
+
+	// The snapshot is a copy.
+	s.Runs["heartbeat"] = 99
+	if c.snapshot().Runs["heartbeat"] != 2 {
+		t.Error("mutating a snapshot changed the counters")
+	}
+}
+
+func TestPatrolCounters_WispReaperActions(t *testing.T) {
+	c := newPatrolCounters(nil)
+	c.recordWispReaperActions(wispReaperActions{
+		Reaped:         4,
+		Purged:         2,
+		PluginClosed:   1,
+		DispatchClosed: 5,
+		AutoClosed:     3,
+		StaleLabeled:   6,
+	})
+
+	s := c.snapshot()
+	for key, want := range map[string]int64{
+		"wisp_reaper.reaped":          4,
+		"wisp_reaper.purged":          2,
+		"wisp_reaper.plugin_closed":   1,
+		"wisp_reaper.dispatch_closed": 5,
+		"wisp_reaper.auto_closed":     3,
+		"wisp_reaper.stale_labeled":   6,
+	} {
+		if got := s.Actions[key]; got != want {
+			t.Errorf("%s = %d, want %d", key, got, want)
+		}
+	}
+	if _, ok := s.Actions["wisp_reaper.mail_purged"]; ok {
+		t.Errorf("zero totals should not create a key: %v", s.Actions)
+	}
+}
+
+func TestPatrolCounters_NilReceiver(t *testing.T) {
+	var c *patrolCounters
+	c.recordRun("heartbeat")
+	c.recordAction("doctor_dog", "molecules_poured", 1)
+	if err := c.flush(t.TempDir()); err != nil {
+		t.Errorf("nil flush = %v, want nil", err)
+	}
+}
+
+func TestPatrolMetrics_RoundTripAcrossRestart(t *testing.T) {
+	townRoot := t.TempDir()
+
+	empty, err := LoadPatrolMetrics(townRoot)
+	if err != nil {
+		t.Fatalf("LoadPatrolMetrics with no file: %v", err)
+	}
+	if len(empty.Runs) != 0 || len(empty.Actions) != 0 || empty.Runs == nil || empty.Actions == nil {
+		t.Errorf("missing file should load as empty, non-nil maps: %+v", empty)
+	}
+
+	first := newPatrolCounters(empty)
+	since := first.snapshot().Since
+	first.recordRun("doctor_dog")
+	first.recordAction("doctor_dog", "molecules_poured", 1)
+	if err := first.flush(townRoot); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	// A restarted daemon resumes from the flushed file.
+	loaded, err := LoadPatrolMetrics(townRoot)
+	if err != nil {
+		t.Fatalf("LoadPatrolMetrics: %v", err)
+	}
+	if loaded.UpdatedAt.IsZero() {
+		t.Error("UpdatedAt not set by flush")
+	}
+	firstRun := loaded.LastRun["doctor_dog"]
+	second := newPatrolCounters(loaded)
+	second.recordRun("doctor_dog")
+	second.recordAction("doctor_dog", "molecules_poured", 1)
+
+	s := second.snapshot()
+	if s.Runs["doctor_dog"] != 2 {
+		t.Errorf("doctor_dog runs = %d, want 2", s.Runs["doctor_dog"])
+	}
+	if s.Actions["doctor_dog.molecules_poured"] != 2 {
+		t.Errorf("doctor_dog.molecules_poured = %d, want 2", s.Actions["doctor_dog.molecules_poured"])
+	}
+	if firstRun.IsZero() || s.LastRun["doctor_dog"].Before(firstRun) {
+		t.Errorf("LastRun not persisted/advanced: loaded %v, now %v", firstRun, s.LastRun["doctor_dog"])
+	}
+	if !s.Since.Equal(since) {
+		t.Errorf("Since = %v, want preserved %v", s.Since, since)
+	}
+}
+
+func TestLoadPatrolMetrics_Corrupt(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := SavePatrolMetrics(townRoot, &PatrolMetrics{Since: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(PatrolMetricsFile(townRoot), []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadPatrolMetrics(townRoot); err == nil {
+		t.Error("expected error for corrupt metrics file")
+	}
+}
+
+func TestRunPatrolCountsRuns(t *testing.T) {
+	d := &Daemon{
+		config:         &Config{TownRoot: t.TempDir()},
+		logger:         log.New(&bytes.Buffer{}, "", 0),
+		patrolCounters: newPatrolCounters(nil),
+	}
+	d.runPatrol("checkpoint_dog", func() {})
+	d.runPatrol("checkpoint_dog", func() {})
+
+	if got := d.patrolCounters.snapshot().Runs["checkpoint_dog"]; got != 2 {
+		t.Errorf("checkpoint_dog runs = %d, want 2", got)
+	}
+}
@@ -0,0 +1,22 @@
+package daemon
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// runPatrol runs one patrol, recovering from a panic so that a single broken
+// patrol (e.g. a nil map access on malformed config) cannot take down the
+// daemon and with it all other monitoring. The panic is logged with the
+// patrol name and stack trace and escalated; the daemon then carries on
+// with its other patrols. Each run is counted in the patrol metrics.
+func (d *Daemon) runPatrol(name string, fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			d.logger.Printf("PANIC in patrol %s: %v\n%s", name, r, debug.Stack())
+			d.escalate(name, fmt.Sprintf("patrol panicked: %v (daemon continued, stack in daemon.log)", r))
+		}
+	}()
+	d.patrolCounters.recordRun(name)
+	fn()
+}
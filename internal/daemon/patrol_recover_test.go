@@ -0,0 +1,133 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// installFakeEscalateGT puts a fake gt on PATH that records its arguments,
+// since escalate shells out to gt. It returns the path of the record file.
+func installFakeEscalateGT(t *testing.T) string {
+	t.Helper()
+	binDir := t.TempDir()
+	escalated := filepath.Join(binDir, "escalated.log")
+	script := "#!/bin/sh\nprintf '%s\\n' \"$*\" >> \"" + escalated + "\"\n"
+	if err := os.WriteFile(filepath.Join(binDir, "gt"), []byte(script), 0o755); err != nil {
+		t.Fatalf("write fake gt: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return escalated
+}
+
+func TestRunPatrolRecoversPanicAndContinues(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses Unix shell script mocks")
+	}
+
+	escalated := installFakeEscalateGT(t)
+
+	var logBuf bytes.Buffer
+	d := &Daemon{
+		config: &Config{TownRoot: t.TempDir()},
+		logger: log.New(&logBuf, "", 0),
+	}
+
+	d.runPatrol("broken_patrol", func() {
+		var m map[string]int
+		m["boom"]++ // assignment to entry in nil map
+	})
+
+	ran := false
+	d.runPatrol("healthy_patrol", func() { ran = true })
+	if !ran {
+		t.Fatal("patrol after the panicking one did not run")
+	}
+
+	logged := logBuf.String()
+	if !strings.Contains(logged, "PANIC in patrol broken_patrol") || !strings.Contains(logged, "nil map") {
+		t.Errorf("log missing panic with patrol name:\n%s", logged)
+	}
+	if !strings.Contains(logged, "goroutine ") {
+		t.Errorf("log missing stack trace:\n%s", logged)
+	}
+
+	data, err := os.ReadFile(escalated)
+	if err != nil {
+		t.Fatalf("panic was not escalated: %v", err)
+	}
+	if got := string(data); !strings.Contains(got, "escalate") || !strings.Contains(got, "broken_patrol: patrol panicked") {
+		t.Errorf("escalation args = %q", got)
+	}
+}
+
+func TestHeartbeatStepPanicDoesNotSkipLaterStepsOrSave(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses Unix shell script mocks")
+	}
+	installFakeEscalateGT(t)
+
+	townRoot := t.TempDir()
+	var logBuf bytes.Buffer
+	d := &Daemon{
+		config: &Config{TownRoot: townRoot},
+		logger: log.New(&logBuf, "", 0),
+	}
+
+	var ran []string
+	state := &State{HeartbeatCount: 4}
+	d.runHeartbeatSteps(state, []heartbeatStep{
+		{"first", func() { ran = append(ran, "first") }},
+		{"broken", func() { panic("bad rig config") }},
+		{"last", func() { ran = append(ran, "last") }},
+	})
+
+	if strings.Join(ran, ",") != "first,last" {
+		t.Errorf("steps run = %v, want [first last]", ran)
+	}
+	if !strings.Contains(logBuf.String(), "PANIC in patrol broken: bad rig config") {
+		t.Errorf("log missing step panic:\n%s", logBuf.String())
+	}
+
+	saved, err := LoadState(townRoot)
+	if err != nil {
+		t.Fatalf("LoadState: %v", err)
+	}
+	if saved.HeartbeatCount != 5 {
+		t.Errorf("saved HeartbeatCount = %d, want 5", saved.HeartbeatCount)
+	}
+	if saved.LastHeartbeat.IsZero() {
+		t.Error("saved LastHeartbeat not set")
+	}
+}
+
+func TestRigWorkerPoolRecoversRigPanic(t *testing.T) {
+	var logBuf bytes.Buffer
+	pool := newRigWorkerPool(2, 0, log.New(&logBuf, "", 0))
+
+	done := make(chan string, 3)
+	pool.runPerRig(context.Background(), []string{"good1", "bad", "good2"}, func(ctx context.Context, rigName string) error {
+		if rigName == "bad" {
+			panic("malformed rig config")
+		}
+		done <- rigName
+		return nil
+	})
+	close(done)
+
+	if n := len(done); n != 2 {
+		t.Errorf("%d healthy rigs completed, want 2", n)
+	}
+	logged := logBuf.String()
+	if !strings.Contains(logged, "rig_worker: bad: PANIC: malformed rig config") {
+		t.Errorf("log missing rig panic:\n%s", logged)
+	}
+	if !strings.Contains(logged, "1/3 rig(s) had errors") {
+		t.Errorf("panic not counted as a rig error:\n%s", logged)
+	}
+}
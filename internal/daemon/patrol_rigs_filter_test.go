@@ -1,11 +1,14 @@
 package daemon
 
 import (
+	"io"
 	"log"
 	"os"
 	"path/filepath"
+	"runtime"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/wisp"
 )
@@ -48,3 +51,84 @@ func TestGetPatrolRigs_FiltersNonOperationalRigs(t *testing.T) {
 		t.Fatalf("getPatrolRigs() = %v, want %v (all rigs excluded when Dolt unavailable - fail-safe)", got, want)
 	}
 }
+
+// writeFakeRigBD installs a fake bd on PATH. When ok, "bd show" returns a rig
+// bead with no status labels; otherwise every call fails as if Dolt were down.
+func writeFakeRigBD(t *testing.T, dir string, ok bool) {
+	t.Helper()
+	script := "#!/bin/sh\necho 'dolt unavailable' >&2\nexit 1\n"
+	if ok {
+		script = "#!/bin/sh\necho '[{\"id\":\"gt-rig-x\",\"labels\":[]}]'\n"
+	}
+	if err := os.WriteFile(filepath.Join(dir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("writing fake bd: %v", err)
+	}
+}
+
+func TestGetPatrolRigs_CacheInvalidatedByParking(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses Unix shell script mocks")
+	}
+	binDir := t.TempDir()
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(townRoot, "mayor", "rigs.json"), []byte(`{"rigs":{"alpha":{},"beta":{}}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, rig := range []string{"alpha", "beta"} {
+		if err := os.MkdirAll(filepath.Join(townRoot, rig), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := wisp.NewConfig(townRoot, rig).Set("status", "active"); err != nil {
+			t.Fatalf("set %s active: %v", rig, err)
+		}
+	}
+
+	d := &Daemon{
+		config: &Config{TownRoot: townRoot},
+		logger: log.New(io.Discard, "", 0),
+	}
+	patrolRigs := func() []string {
+		got := slices.Clone(d.getPatrolRigs("witness"))
+		slices.Sort(got)
+		return got
+	}
+
+	// Fail-safe exclusions (Dolt down) are not cached.
+	writeFakeRigBD(t, binDir, false)
+	if got := patrolRigs(); len(got) != 0 {
+		t.Fatalf("with Dolt down getPatrolRigs() = %v, want none", got)
+	}
+	writeFakeRigBD(t, binDir, true)
+	if got, want := patrolRigs(), []string{"alpha", "beta"}; !slices.Equal(got, want) {
+		t.Fatalf("after Dolt recovery getPatrolRigs() = %v, want %v", got, want)
+	}
+
+	// Unchanged config: served from cache without consulting bd.
+	writeFakeRigBD(t, binDir, false)
+	if got, want := patrolRigs(), []string{"alpha", "beta"}; !slices.Equal(got, want) {
+		t.Fatalf("cached getPatrolRigs() = %v, want %v", got, want)
+	}
+
+	// Parking beta rewrites its wisp config, invalidating the entry.
+	writeFakeRigBD(t, binDir, true)
+	if err := wisp.NewConfig(townRoot, "beta").Set("status", "parked"); err != nil {
+		t.Fatalf("set beta parked: %v", err)
+	}
+	if got, want := patrolRigs(), []string{"alpha"}; !slices.Equal(got, want) {
+		t.Fatalf("after parking beta getPatrolRigs() = %v, want %v", got, want)
+	}
+
+	// Entries expire after the TTL even with no file change.
+	writeFakeRigBD(t, binDir, false)
+	entry := d.patrolRigsCache["witness"]
+	entry.cachedAt = time.Now().Add(-patrolRigsCacheTTL)
+	d.patrolRigsCache["witness"] = entry
+	if got := patrolRigs(); len(got) != 0 {
+		t.Fatalf("expired entry reused: getPatrolRigs() = %v, want recomputed (none, Dolt down)", got)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 )
@@ -108,3 +109,10 @@ func generateNonce() (string, error) {
 	}
 	return hex.EncodeToString(b), nil
 }
+
+// VerifyPIDFile reports the PID recorded in the town's daemon PID file and
+// whether that process is alive, using the same ownership rules the daemon
+// relies on (see verifyPIDOwnership). A missing PID file yields (0, false, nil).
+func VerifyPIDFile(townRoot string) (pid int, alive bool, err error) {
+	return verifyPIDOwnership(filepath.Join(townRoot, "daemon", "daemon.pid"))
+}
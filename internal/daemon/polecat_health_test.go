@@ -124,6 +124,8 @@ func TestCheckPolecatHealth_DetectsCrashedPolecat(t *testing.T) {
 	bdPath := writeFakeTestBD(t, binDir, "working", "working", "gt-xyz", recentTime)
 
 	t.Setenv("PATH", binDir+":"+os.Getenv("PATH"))
+	// Session-death events resolve the town from cwd; keep them out of the tree.
+	t.Chdir(t.TempDir())
 
 	var logBuf strings.Builder
 	d := &Daemon{
@@ -155,6 +157,8 @@ func TestCheckPolecatHealth_SpawningGuardExpires(t *testing.T) {
 	bdPath := writeFakeTestBD(t, binDir, "spawning", "spawning", "gt-xyz", oldTime)
 
 	t.Setenv("PATH", binDir+":"+os.Getenv("PATH"))
+	// Session-death events resolve the town from cwd; keep them out of the tree.
+	t.Chdir(t.TempDir())
 
 	var logBuf strings.Builder
 	d := &Daemon{
@@ -268,6 +272,8 @@ func TestCheckPolecatHealth_NotifiesWitnessOnCrash(t *testing.T) {
 	}
 
 	t.Setenv("PATH", binDir+":"+os.Getenv("PATH"))
+	// Session-death events resolve the town from cwd; keep them out of the tree.
+	t.Chdir(t.TempDir())
 
 	townRoot := t.TempDir()
 	var logBuf strings.Builder
@@ -453,7 +459,7 @@ func TestReapIdlePolecat_SkipsWhenBeadLookupFailsButHasWork(t *testing.T) {
 	d := &Daemon{
 		config: &Config{TownRoot: townRoot},
 		logger: log.New(&logBuf, "", 0),
-		tmux:   tmux.NewTmuxWithSocket(""),
+		tmux:   tmux.NewTmuxWithSocket("default"),
 		bdPath: bdPath,
 	}
 
@@ -491,13 +497,15 @@ func TestReapIdlePolecat_ReapsWhenBeadLookupFailsAndNoWork(t *testing.T) {
 	bdPath := writeFakeBDLookupFail(t, binDir, false /* no work */)
 
 	t.Setenv("PATH", binDir+":"+os.Getenv("PATH"))
+	// Session-death events resolve the town from cwd; keep them out of the tree.
+	t.Chdir(t.TempDir())
 
 	townRoot := t.TempDir()
 	var logBuf strings.Builder
 	d := &Daemon{
 		config: &Config{TownRoot: townRoot},
 		logger: log.New(&logBuf, "", 0),
-		tmux:   tmux.NewTmuxWithSocket(""),
+		tmux:   tmux.NewTmuxWithSocket("default"),
 		bdPath: bdPath,
 	}
 
@@ -595,13 +603,15 @@ func TestReapIdlePolecat_ReapsIdleNoHook(t *testing.T) {
 	bdPath := writeFakeTestBD(t, binDir, "working", "working", "", recentTime)
 
 	t.Setenv("PATH", binDir+":"+os.Getenv("PATH"))
+	// Session-death events resolve the town from cwd; keep them out of the tree.
+	t.Chdir(t.TempDir())
 
 	townRoot := t.TempDir()
 	var logBuf strings.Builder
 	d := &Daemon{
 		config: &Config{TownRoot: townRoot},
 		logger: log.New(&logBuf, "", 0),
-		tmux:   tmux.NewTmuxWithSocket(""),
+		tmux:   tmux.NewTmuxWithSocket("default"),
 		bdPath: bdPath,
 	}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 )
@@ -277,6 +278,38 @@ func (rt *RestartTracker) GetBackoffRemaining(agentID string) time.Duration {
 	return remaining
 }
 
+// AgentIDs returns the IDs of all agents with recorded restart state, sorted.
+func (rt *RestartTracker) AgentIDs() []string {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	ids := make([]string, 0, len(rt.state.Agents))
+	for id := range rt.state.Agents {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// RestartCount returns the number of restarts recorded for the agent since
+// its backoff last reset.
+func (rt *RestartTracker) RestartCount(agentID string) int {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	info, exists := rt.state.Agents[agentID]
+	if !exists {
+		return 0
+	}
+	return info.RestartCount
+}
+
+// CrashLoopCount returns the configured number of restarts within the
+// crash-loop window that puts an agent into crash-loop state.
+func (rt *RestartTracker) CrashLoopCount() int {
+	return rt.config.CrashLoopCount
+}
+
 // ClearCrashLoop manually clears the crash loop state for an agent.
 func (rt *RestartTracker) ClearCrashLoop(agentID string) {
 	rt.mu.Lock()
@@ -290,6 +323,21 @@ func (rt *RestartTracker) ClearCrashLoop(agentID string) {
 	}
 }
 
+// LoadRestartTracker loads the persisted restart state for read-only
+// inspection (e.g. gt status), using the thresholds configured under
+// patrols.restart_tracker in mayor/daemon.json. Callers must not Save it.
+func LoadRestartTracker(townRoot string) (*RestartTracker, error) {
+	var cfg RestartTrackerConfig
+	if pc := LoadPatrolConfig(townRoot); pc != nil && pc.Patrols != nil && pc.Patrols.RestartTracker != nil {
+		cfg = *pc.Patrols.RestartTracker
+	}
+	rt := NewRestartTracker(townRoot, cfg)
+	if err := rt.Load(); err != nil {
+		return nil, fmt.Errorf("loading restart state: %w", err)
+	}
+	return rt, nil
+}
+
 // ClearAgentBackoff clears the crash loop and backoff state for an agent on disk.
 // Used by 'gt daemon clear-backoff' to reset an agent stuck in crash loop.
 // The daemon reloads this on next heartbeat (or immediately on SIGUSR2).
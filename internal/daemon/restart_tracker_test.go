@@ -0,0 +1,66 @@
+package daemon
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadRestartTracker_ReadsStateAndConfig(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "daemon"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	state := RestartState{Agents: map[string]*AgentRestartInfo{
+		"witness": {RestartCount: 2, BackoffUntil: time.Now().Add(time.Minute)},
+		"deacon":  {RestartCount: 7, CrashLoopSince: time.Now()},
+	}}
+	data, _ := json.Marshal(state)
+	if err := os.WriteFile(filepath.Join(townRoot, "daemon", "restart_state.json"), data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	cfg := `{"type":"daemon-patrol-config","version":1,"patrols":{"restart_tracker":{"crash_loop_count":9}}}`
+	if err := os.WriteFile(PatrolConfigFile(townRoot), []byte(cfg), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	rt, err := LoadRestartTracker(townRoot)
+	if err != nil {
+		t.Fatalf("LoadRestartTracker: %v", err)
+	}
+
+	if got := rt.AgentIDs(); len(got) != 2 || got[0] != "deacon" || got[1] != "witness" {
+		t.Errorf("AgentIDs() = %v, want [deacon witness]", got)
+	}
+	if !rt.IsInCrashLoop("deacon") {
+		t.Error("deacon should be in crash loop")
+	}
+	if rt.RestartCount("deacon") != 7 {
+		t.Errorf("RestartCount(deacon) = %d, want 7", rt.RestartCount("deacon"))
+	}
+	if rt.GetBackoffRemaining("witness") <= 0 {
+		t.Error("witness should have backoff remaining")
+	}
+	if rt.CrashLoopCount() != 9 {
+		t.Errorf("CrashLoopCount() = %d, want configured 9", rt.CrashLoopCount())
+	}
+}
+
+func TestLoadRestartTracker_NoState(t *testing.T) {
+	rt, err := LoadRestartTracker(t.TempDir())
+	if err != nil {
+		t.Fatalf("LoadRestartTracker: %v", err)
+	}
+	if ids := rt.AgentIDs(); len(ids) != 0 {
+		t.Errorf("AgentIDs() = %v, want empty", ids)
+	}
+	if rt.CrashLoopCount() != DefaultRestartTrackerConfig().CrashLoopCount {
+		t.Errorf("CrashLoopCount() = %d, want default", rt.CrashLoopCount())
+	}
+}
@@ -28,16 +28,24 @@ const (
 	defaultMailDeleteAge = 7 * 24 * time.Hour
 	// Issues stale longer than this are auto-closed. Formula var: stale_issue_age.
 	defaultStaleIssueAge = 7 * 24 * time.Hour
+	// Open wisps older than this are GC'd by the patrols' own `bd mol wisp gc`
+	// when wisp_reaper.max_age is unset. Matches the wisp_gc_age formula default.
+	defaultPatrolWispGCAge = 1 * time.Hour
 )
 
 // WispReaperConfig holds configuration for the wisp_reaper patrol.
 type WispReaperConfig struct {
-	Enabled      bool     `json:"enabled"`
-	DryRun       bool     `json:"dry_run,omitempty"`
-	IntervalStr  string   `json:"interval,omitempty"`
-	MaxAgeStr    string   `json:"max_age,omitempty"`
-	DeleteAgeStr string   `json:"delete_age,omitempty"`
-	Databases    []string `json:"databases,omitempty"`
+	Enabled          bool     `json:"enabled"`
+	DryRun           bool     `json:"dry_run,omitempty"`
+	IntervalStr      string   `json:"interval,omitempty"`
+	MaxAgeStr        string   `json:"max_age,omitempty"`
+	DeleteAgeStr     string   `json:"delete_age,omitempty"`
+	StaleIssueAgeStr string   `json:"stale_issue_age,omitempty"`
+	Databases        []string `json:"databases,omitempty"`
+
+	// LabelStale labels stale issues gt:stale for review instead of
+	// auto-closing them. Nil means true.
+	LabelStale *bool `json:"label_stale,omitempty"`
 }
 
 // wispReaperInterval returns the configured interval, or the default (1h).
@@ -76,6 +84,117 @@ func wispDeleteAge(config *DaemonPatrolConfig) time.Duration {
 	return defaultWispDeleteAge
 }
 
+// wispStaleIssueAge returns the configured stale issue age, or the default (7 days).
+func wispStaleIssueAge(config *DaemonPatrolConfig) time.Duration {
+	if config != nil && config.Patrols != nil && config.Patrols.WispReaper != nil {
+		if config.Patrols.WispReaper.StaleIssueAgeStr != "" {
+			if d, err := time.ParseDuration(config.Patrols.WispReaper.StaleIssueAgeStr); err == nil && d > 0 {
+				return d
+			}
+		}
+	}
+	return defaultStaleIssueAge
+}
+
+// wispLabelStale reports whether stale issues are labeled rather than
+// auto-closed (default true).
+func wispLabelStale(config *DaemonPatrolConfig) bool {
+	if config != nil && config.Patrols != nil && config.Patrols.WispReaper != nil {
+		if config.Patrols.WispReaper.LabelStale != nil {
+			return *config.Patrols.WispReaper.LabelStale
+		}
+	}
+	return true
+}
+
+// WispReaperAges holds the resolved wisp_reaper thresholds.
+type WispReaperAges struct {
+	MaxAge        time.Duration // Open wisps older than this are closed
+	DeleteAge     time.Duration // Closed wisps older than this are deleted
+	StaleIssueAge time.Duration // Issues stale longer than this are auto-closed
+	MailDeleteAge time.Duration // Closed mail older than this is deleted
+}
+
+// ResolveWispReaperAges returns the thresholds the daemon's reaper uses,
+// with defaults applied. `gt daemon wisp-reaper run-once` uses it so an
+// on-demand pass matches a scheduled one.
+func ResolveWispReaperAges(config *DaemonPatrolConfig) WispReaperAges {
+	return WispReaperAges{
+		MaxAge:        wispReaperMaxAge(config),
+		DeleteAge:     wispDeleteAge(config),
+		StaleIssueAge: wispStaleIssueAge(config),
+		MailDeleteAge: defaultMailDeleteAge,
+	}
+}
+
+// WispGCArgs returns the `bd mol wisp gc` flags patrol steps run with.
+func WispGCArgs(config *DaemonPatrolConfig) []string {
+	age, _ := WispGCAge(config)
+	return []string{"--age", age}
+}
+
+// WispGCAge returns the age patrol-run `bd mol wisp gc --age` uses, formatted
+// for the flag. When wisp_reaper.max_age is explicitly configured it is used
+// and ok is true, so patrol GC and the daemon's reaper agree on when an open
+// wisp counts as abandoned. Otherwise patrols keep their own tighter default
+// (1h) rather than the reaper's 24h, and ok is false.
+func WispGCAge(config *DaemonPatrolConfig) (age string, ok bool) {
+	if config != nil && config.Patrols != nil && config.Patrols.WispReaper != nil {
+		if s := config.Patrols.WispReaper.MaxAgeStr; s != "" {
+			if d, err := time.ParseDuration(s); err == nil && d > 0 {
+				return formatGCAge(d), true
+			}
+		}
+	}
+	return formatGCAge(defaultPatrolWispGCAge), false
+}
+
+// formatGCAge renders d compactly ("24h" rather than "24h0m0s").
+func formatGCAge(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-2]
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-2]
+	}
+	return s
+}
+
+// WispReaperSettings is the wisp_reaper configuration with defaults applied.
+type WispReaperSettings struct {
+	Enabled       bool     `json:"enabled"`
+	DryRun        bool     `json:"dry_run"`
+	Interval      string   `json:"interval"`
+	MaxAge        string   `json:"max_age"`
+	DeleteAge     string   `json:"delete_age"`
+	StaleIssueAge string   `json:"stale_issue_age"`
+	MailDeleteAge string   `json:"mail_delete_age"`
+	LabelStale    bool     `json:"label_stale"`
+	Databases     []string `json:"databases,omitempty"`
+	GCArgs        []string `json:"gc_args"`
+}
+
+// ResolveWispReaperSettings resolves the wisp_reaper ages and interval from
+// config, falling back to the defaults the daemon itself uses.
+func ResolveWispReaperSettings(config *DaemonPatrolConfig) WispReaperSettings {
+	s := WispReaperSettings{
+		Enabled:       IsPatrolEnabled(config, "wisp_reaper"),
+		Interval:      formatGCAge(wispReaperInterval(config)),
+		MaxAge:        formatGCAge(wispReaperMaxAge(config)),
+		DeleteAge:     formatGCAge(wispDeleteAge(config)),
+		StaleIssueAge: formatGCAge(wispStaleIssueAge(config)),
+		MailDeleteAge: formatGCAge(defaultMailDeleteAge),
+		LabelStale:    wispLabelStale(config),
+		GCArgs:        WispGCArgs(config),
+	}
+	if config != nil && config.Patrols != nil && config.Patrols.WispReaper != nil {
+		s.DryRun = config.Patrols.WispReaper.DryRun
+		s.Databases = config.Patrols.WispReaper.Databases
+	}
+	return s
+}
+
 // reapWisps is the thin orchestrator for the wisp_reaper patrol.
 // It pours a mol-dog-reaper molecule, then dispatches a Dog to execute it.
 // The Dog reads the formula steps and calls `gt reaper` CLI helpers.
@@ -92,7 +211,7 @@ func (d *Daemon) reapWisps() {
 	vars := map[string]string{
 		"max_age":         maxAge.String(),
 		"purge_age":       deleteAge.String(),
-		"stale_issue_age": defaultStaleIssueAge.String(),
+		"stale_issue_age": wispStaleIssueAge(d.patrolConfig).String(),
 		"mail_delete_age": defaultMailDeleteAge.String(),
 		"alert_threshold": fmt.Sprintf("%d", wispAlertThreshold),
 	}
@@ -100,6 +219,9 @@ func (d *Daemon) reapWisps() {
 	if config.DryRun {
 		vars["dry_run"] = "true"
 	}
+	if wispLabelStale(d.patrolConfig) {
+		vars["label_stale"] = "true"
+	}
 	if len(config.Databases) > 0 {
 		vars["databases"] = strings.Join(config.Databases, ",")
 	}
@@ -119,6 +241,7 @@ func (d *Daemon) reapWisps() {
 		return
 	}
 
+	d.patrolCounters.recordAction("wisp_reaper", "dog_dispatches", 1)
 	d.logger.Printf("wisp_reaper: dispatched to Dog for formula-driven execution")
 }
 
@@ -159,7 +282,9 @@ func (d *Daemon) reapWispsInline(config *WispReaperConfig, maxAge, deleteAge tim
 
 	port := d.doltServerPort()
 	dryRun := config.DryRun
-	var totalReaped, totalMoleculeSteps, totalOpen, totalPurged, totalMailPurged, totalAutoClosed int
+	staleIssueAge := wispStaleIssueAge(d.patrolConfig)
+	labelStale := wispLabelStale(d.patrolConfig)
+	var totalReaped, totalMoleculeSteps, totalOpen, totalPurged, totalMailPurged, totalAutoClosed, totalLabeled int
 
 	// Step 2: Reap
 	reapErrors := 0
@@ -307,7 +432,18 @@ func (d *Daemon) reapWispsInline(config *WispReaperConfig, maxAge, deleteAge tim
 			db.Close()
 			continue
 		}
-		result, err := reaper.AutoClose(db, dbName, defaultStaleIssueAge, dryRun)
+		if labelStale {
+			result, err := reaper.LabelStale(db, dbName, staleIssueAge, dryRun)
+			db.Close()
+			if err != nil {
+				d.logger.Printf("wisp_reaper: %s: stale label error: %v", dbName, err)
+				autoCloseErrors++
+				continue
+			}
+			totalLabeled += result.Labeled
+			continue
+		}
+		result, err := reaper.AutoClose(db, dbName, staleIssueAge, dryRun)
 		db.Close()
 		if err != nil {
 			d.logger.Printf("wisp_reaper: %s: auto-close error: %v", dbName, err)
@@ -331,12 +467,49 @@ func (d *Daemon) reapWispsInline(config *WispReaperConfig, maxAge, deleteAge tim
 	if totalMoleculeSteps > 0 {
 		summary += fmt.Sprintf(" molecule_steps_closed=%d", totalMoleculeSteps)
 	}
-	summary += fmt.Sprintf(" purged=%d mail_purged=%d plugin_closed=%d dispatch_closed=%d auto_closed=%d open=%d databases=%d dryRun=%v",
-		totalPurged, totalMailPurged, totalPluginClosed, totalDispatchClosed, totalAutoClosed, totalOpen, len(databases), dryRun)
+	summary += fmt.Sprintf(" purged=%d mail_purged=%d plugin_closed=%d dispatch_closed=%d auto_closed=%d stale_labeled=%d open=%d databases=%d dryRun=%v",
+		totalPurged, totalMailPurged, totalPluginClosed, totalDispatchClosed, totalAutoClosed, totalLabeled, totalOpen, len(databases), dryRun)
 	d.logger.Printf("%s", summary)
+	if !dryRun {
+		d.patrolCounters.recordWispReaperActions(wispReaperActions{
+			Reaped:              totalReaped,
+			MoleculeStepsClosed: totalMoleculeSteps,
+			Purged:              totalPurged,
+			MailPurged:          totalMailPurged,
+			PluginClosed:        totalPluginClosed,
+			DispatchClosed:      totalDispatchClosed,
+			AutoClosed:          totalAutoClosed,
+			StaleLabeled:        totalLabeled,
+		})
+	}
 	mol.closeStep("report")
 }
 
+// wispReaperActions are the totals of one wisp_reaper cycle.
+type wispReaperActions struct {
+	Reaped              int
+	MoleculeStepsClosed int
+	Purged              int
+	MailPurged          int
+	PluginClosed        int
+	DispatchClosed      int
+	AutoClosed          int
+	StaleLabeled        int
+}
+
+// recordWispReaperActions adds one cycle's totals to the wisp_reaper action
+// counters, one counter per action in the cycle summary log line.
+func (c *patrolCounters) recordWispReaperActions(a wispReaperActions) {
+	c.recordAction("wisp_reaper", "reaped", a.Reaped)
+	c.recordAction("wisp_reaper", "molecule_steps_closed", a.MoleculeStepsClosed)
+	c.recordAction("wisp_reaper", "purged", a.Purged)
+	c.recordAction("wisp_reaper", "mail_purged", a.MailPurged)
+	c.recordAction("wisp_reaper", "plugin_closed", a.PluginClosed)
+	c.recordAction("wisp_reaper", "dispatch_closed", a.DispatchClosed)
+	c.recordAction("wisp_reaper", "auto_closed", a.AutoClosed)
+	c.recordAction("wisp_reaper", "stale_labeled", a.StaleLabeled)
+}
+
 // doltServerPort returns the configured Dolt server port.
 func (d *Daemon) doltServerPort() int {
 	if d.doltServer != nil {
@@ -74,6 +74,95 @@ func TestWispDeleteAge(t *testing.T) {
 	}
 }
 
+func TestWispGCArgs(t *testing.T) {
+	if got := strings.Join(WispGCArgs(nil), " "); got != "--age 1h" {
+		t.Errorf("default args = %q, want %q (patrol default, not reaper max_age)", got, "--age 1h")
+	}
+	if _, ok := WispGCAge(nil); ok {
+		t.Error("WispGCAge(nil) reported an explicitly configured age")
+	}
+
+	config := &DaemonPatrolConfig{
+		Patrols: &PatrolsConfig{
+			WispReaper: &WispReaperConfig{
+				Enabled:   true,
+				MaxAgeStr: "90m",
+			},
+		},
+	}
+	if got := strings.Join(WispGCArgs(config), " "); got != "--age 1h30m" {
+		t.Errorf("args for max_age=90m = %q, want %q", got, "--age 1h30m")
+	}
+
+	config.Patrols.WispReaper.MaxAgeStr = "30s"
+	if got := strings.Join(WispGCArgs(config), " "); got != "--age 30s" {
+		t.Errorf("args for max_age=30s = %q, want %q", got, "--age 30s")
+	}
+}
+
+func TestResolveWispReaperSettings(t *testing.T) {
+	s := ResolveWispReaperSettings(&DaemonPatrolConfig{
+		Patrols: &PatrolsConfig{
+			WispReaper: &WispReaperConfig{
+				Enabled:          true,
+				DryRun:           true,
+				MaxAgeStr:        "48h",
+				DeleteAgeStr:     "336h",
+				StaleIssueAgeStr: "720h",
+				Databases:        []string{"hq"},
+			},
+		},
+	})
+	if !s.Enabled || !s.DryRun {
+		t.Errorf("Enabled/DryRun = %v/%v, want true/true", s.Enabled, s.DryRun)
+	}
+	if s.Interval != "1h" || s.MaxAge != "48h" || s.DeleteAge != "336h" {
+		t.Errorf("interval/max/delete = %s/%s/%s, want 1h/48h/336h", s.Interval, s.MaxAge, s.DeleteAge)
+	}
+	if s.StaleIssueAge != "720h" || s.MailDeleteAge != "168h" {
+		t.Errorf("stale/mail = %s/%s, want 720h/168h", s.StaleIssueAge, s.MailDeleteAge)
+	}
+	if !s.LabelStale {
+		t.Error("LabelStale should default to true")
+	}
+	if strings.Join(s.GCArgs, " ") != "--age 48h" {
+		t.Errorf("GCArgs = %v, want [--age 48h]", s.GCArgs)
+	}
+}
+
+func TestResolveWispReaperAges(t *testing.T) {
+	ages := ResolveWispReaperAges(nil)
+	if ages.MaxAge != defaultWispMaxAge || ages.DeleteAge != defaultWispDeleteAge ||
+		ages.StaleIssueAge != defaultStaleIssueAge || ages.MailDeleteAge != defaultMailDeleteAge {
+		t.Errorf("nil config ages = %+v, want defaults", ages)
+	}
+
+	ages = ResolveWispReaperAges(&DaemonPatrolConfig{
+		Patrols: &PatrolsConfig{
+			WispReaper: &WispReaperConfig{MaxAgeStr: "2h", StaleIssueAgeStr: "not-a-duration"},
+		},
+	})
+	if ages.MaxAge != 2*time.Hour {
+		t.Errorf("MaxAge = %v, want 2h", ages.MaxAge)
+	}
+	if ages.StaleIssueAge != defaultStaleIssueAge {
+		t.Errorf("invalid StaleIssueAgeStr should fall back to default, got %v", ages.StaleIssueAge)
+	}
+}
+
+func TestWispLabelStale(t *testing.T) {
+	if !wispLabelStale(nil) {
+		t.Error("nil config should label stale issues")
+	}
+	off := false
+	config := &DaemonPatrolConfig{
+		Patrols: &PatrolsConfig{WispReaper: &WispReaperConfig{LabelStale: &off}},
+	}
+	if wispLabelStale(config) {
+		t.Error("label_stale=false should disable labeling")
+	}
+}
+
 func TestDefaultReaperIntervalIsOneHour(t *testing.T) {
 	// Verify the default changed from 30m to 1h per issue gt-caf7.
 	if defaultWispReaperInterval != 1*time.Hour {
@@ -2,7 +2,9 @@ package daemon
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"runtime/debug"
 	"sync"
 	"time"
 )
@@ -76,7 +78,7 @@ func (p *RigWorkerPool) runPerRig(
 			ctx, cancel := context.WithTimeout(parent, p.timeout)
 			defer cancel()
 
-			if err := fn(ctx, rigName); err != nil {
+			if err := p.callRecovered(ctx, rigName, fn); err != nil {
 				mu.Lock()
 				errCount++
 				mu.Unlock()
@@ -97,3 +99,18 @@ func (p *RigWorkerPool) runPerRig(
 		p.logger.Printf("rig_worker: %d/%d rig(s) had errors", count, len(rigs))
 	}
 }
+
+// callRecovered runs fn for one rig, converting a panic into an error so a
+// single rig cannot crash the daemon from inside a pool goroutine, where the
+// patrol-level recover in runPatrol cannot reach.
+func (p *RigWorkerPool) callRecovered(ctx context.Context, rigName string, fn func(ctx context.Context, rigName string) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if p.logger != nil {
+				p.logger.Printf("rig_worker: %s: PANIC: %v\n%s", rigName, r, debug.Stack())
+			}
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn(ctx, rigName)
+}
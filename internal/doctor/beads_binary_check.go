@@ -1,14 +1,23 @@
 package doctor
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/deps"
 )
 
-// BeadsBinaryCheck verifies that the beads (bd) binary is installed and meets
-// the minimum version requirement. This is an informational check with no
-// auto-fix — the user must install or upgrade bd manually.
+// checkBdVersion probes the installed bd against a minimum version. It goes
+// through the cached beads.CheckVersion so repeated doctor runs in one process
+// do not re-run bd. Overridden in tests.
+var checkBdVersion = beads.CheckVersion
+
+// BeadsBinaryCheck verifies that the beads (bd) binary is installed, meets
+// the minimum version requirement, and is not a release with a known
+// data-loss bug (beads.KnownBuggyBdVersions). This is an informational check
+// with no auto-fix — the user must install or upgrade bd manually.
 type BeadsBinaryCheck struct {
 	BaseCheck
 }
@@ -26,20 +35,13 @@ func NewBeadsBinaryCheck() *BeadsBinaryCheck {
 
 // Run checks if bd is available in PATH and reports its version status.
 func (c *BeadsBinaryCheck) Run(ctx *CheckContext) *CheckResult {
-	status, version := deps.CheckBeads()
+	ok, version, err := checkBdVersion(beads.MinBdVersion)
 
-	switch status {
-	case deps.BeadsOK:
+	switch {
+	case errors.Is(err, beads.ErrNotInstalled):
 		return &CheckResult{
 			Name:    c.Name(),
-			Status:  StatusOK,
-			Message: fmt.Sprintf("bd %s", version),
-		}
-
-	case deps.BeadsNotFound:
-		return &CheckResult{
-			Name:   c.Name(),
-			Status: StatusError,
+			Status:  StatusError,
 			Message: "beads (bd) not found in PATH",
 			Details: []string{
 				"The bd CLI is required for beads operations",
@@ -47,29 +49,57 @@ func (c *BeadsBinaryCheck) Run(ctx *CheckContext) *CheckResult {
 			FixHint: fmt.Sprintf("Install: go install %s", deps.BeadsInstallPath),
 		}
 
-	case deps.BeadsTooOld:
+	case err != nil:
 		return &CheckResult{
-			Name:   c.Name(),
-			Status: StatusError,
-			Message: fmt.Sprintf("bd %s is too old (minimum: %s)", version, deps.MinBeadsVersion),
-			Details: []string{
-				fmt.Sprintf("Installed version %s does not meet the minimum requirement of %s", version, deps.MinBeadsVersion),
-			},
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "bd found but version could not be determined",
+			FixHint: fmt.Sprintf("Try reinstalling: go install %s", deps.BeadsInstallPath),
+		}
+
+	case !ok:
+		message := fmt.Sprintf("bd %s is too old (minimum: %s)", version, beads.MinBdVersion)
+		if issue := beads.KnownBdVersionIssue(version); issue != "" {
+			message += fmt.Sprintf(" and has a known bug: %s", issue)
+		}
+		details := []string{
+			fmt.Sprintf("Installed version %s does not meet the minimum requirement of %s", version, beads.MinBdVersion),
+		}
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: message,
+			Details: append(details, knownBuggyBdDetails()...),
 			FixHint: fmt.Sprintf("Upgrade: go install %s", deps.BeadsInstallPath),
 		}
+	}
 
-	case deps.BeadsUnknown:
+	if issue := beads.KnownBdVersionIssue(version); issue != "" {
 		return &CheckResult{
 			Name:    c.Name(),
 			Status:  StatusWarning,
-			Message: "bd found but version could not be determined",
-			FixHint: fmt.Sprintf("Try reinstalling: go install %s", deps.BeadsInstallPath),
+			Message: fmt.Sprintf("bd %s has a known bug: %s", version, issue),
+			Details: knownBuggyBdDetails(),
+			FixHint: fmt.Sprintf("Upgrade: go install %s", deps.BeadsInstallPath),
 		}
 	}
-
 	return &CheckResult{
 		Name:    c.Name(),
 		Status:  StatusOK,
-		Message: "bd available",
+		Message: fmt.Sprintf("bd %s", version),
+	}
+}
+
+// knownBuggyBdDetails lists the known-buggy bd releases in version order.
+func knownBuggyBdDetails() []string {
+	versions := make([]string, 0, len(beads.KnownBuggyBdVersions))
+	for v := range beads.KnownBuggyBdVersions {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+	details := make([]string, 0, len(versions))
+	for _, v := range versions {
+		details = append(details, fmt.Sprintf("Known-buggy: bd %s (%s)", v, beads.KnownBuggyBdVersions[v]))
 	}
+	return details
 }
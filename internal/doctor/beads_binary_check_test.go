@@ -149,6 +149,43 @@ func TestBeadsBinaryCheck_BdTooOld(t *testing.T) {
 	}
 }
 
+func TestBeadsBinaryCheck_KnownBuggyVersion(t *testing.T) {
+	origCheck := checkBdVersion
+	checkBdVersion = func(string) (bool, string, error) { return true, "0.47.2", nil }
+	defer func() { checkBdVersion = origCheck }()
+
+	check := NewBeadsBinaryCheck()
+	ctx := &CheckContext{TownRoot: t.TempDir()}
+
+	result := check.Run(ctx)
+	if result.Status != StatusWarning {
+		t.Fatalf("expected StatusWarning for known-buggy bd, got %v: %s", result.Status, result.Message)
+	}
+	if !strings.Contains(result.Message, "known bug") {
+		t.Errorf("expected known bug callout in message, got %q", result.Message)
+	}
+	if !strings.Contains(strings.Join(result.Details, "\n"), "Known-buggy: bd 0.47.2") {
+		t.Errorf("expected known-buggy versions in details, got %v", result.Details)
+	}
+}
+
+func TestBeadsBinaryCheck_KnownBuggyAndTooOld(t *testing.T) {
+	origCheck := checkBdVersion
+	checkBdVersion = func(string) (bool, string, error) { return false, "0.47.2", nil }
+	defer func() { checkBdVersion = origCheck }()
+
+	check := NewBeadsBinaryCheck()
+	ctx := &CheckContext{TownRoot: t.TempDir()}
+
+	result := check.Run(ctx)
+	if result.Status != StatusError {
+		t.Fatalf("expected StatusError for too-old known-buggy bd, got %v: %s", result.Status, result.Message)
+	}
+	if !strings.Contains(result.Message, "too old") || !strings.Contains(result.Message, "known bug") {
+		t.Errorf("expected both too-old and known bug in message, got %q", result.Message)
+	}
+}
+
 func TestBeadsBinaryCheck_BdVersionUnparseable(t *testing.T) {
 	fakeDir := t.TempDir()
 	writeFakeBd(t, fakeDir,
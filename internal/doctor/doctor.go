@@ -4,23 +4,36 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sync"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/ui"
 )
 
+// DefaultConcurrency is how many checks RunStreaming runs at once. Most checks
+// are I/O-bound on tmux, git, and bd subprocesses.
+const DefaultConcurrency = 8
+
 // Doctor manages and executes health checks.
 type Doctor struct {
-	checks []Check
+	checks      []Check
+	concurrency int
 }
 
 // NewDoctor creates a new Doctor with no registered checks.
 func NewDoctor() *Doctor {
 	return &Doctor{
-		checks: make([]Check, 0),
+		checks:      make([]Check, 0),
+		concurrency: DefaultConcurrency,
 	}
 }
 
+// SetConcurrency sets how many checks RunStreaming runs at once.
+// Values below 1 run checks one at a time.
+func (d *Doctor) SetConcurrency(n int) {
+	d.concurrency = n
+}
+
 // Register adds a check to the doctor's check list.
 func (d *Doctor) Register(check Check) {
 	d.checks = append(d.checks, check)
@@ -46,30 +59,73 @@ func (d *Doctor) Run(ctx *CheckContext) *Report {
 	return d.RunStreaming(ctx, nil, 0)
 }
 
+// runCheck runs a single check, timing it and filling in the name and
+// category when the check leaves them empty.
+func runCheck(check Check, ctx *CheckContext) *CheckResult {
+	start := time.Now()
+	result := check.Run(ctx)
+	result.Elapsed = time.Since(start)
+
+	// Ensure check name is populated
+	if result.Name == "" {
+		result.Name = check.Name()
+	}
+	// Set category from check if available
+	if cg, ok := check.(categoryGetter); ok && result.Category == "" {
+		result.Category = cg.Category()
+	}
+	return result
+}
+
 // RunStreaming executes all registered checks with optional real-time output.
-// If w is non-nil, prints each check name as it starts and result when done.
-// If slowThreshold > 0, shows hourglass icon for slow checks.
+// Checks run concurrently on a bounded worker pool (see SetConcurrency), but
+// results are reported and streamed in registration order.
+// If w is non-nil, prints each check name while waiting on it and its result
+// when done.
+// If slowThreshold > 0, shows hourglass icon for slow checks. Each check's
+// elapsed time is shown when it is slow or when ctx.Verbose is set.
 func (d *Doctor) RunStreaming(ctx *CheckContext, w io.Writer, slowThreshold time.Duration) *Report {
 	report := NewReport()
 
-	for _, check := range d.checks {
-		// Stream: print check name before running
+	workers := d.concurrency
+	if workers < 1 {
+		workers = 1
+	}
+	results := make([]*CheckResult, len(d.checks))
+	done := make([]chan struct{}, len(d.checks))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+	// Workers take checks in registration order so the check being streamed
+	// is always among the first to start.
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for n := 0; n < workers; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = runCheck(d.checks[i], ctx)
+				close(done[i])
+			}
+		}()
+	}
+	go func() {
+		for i := range d.checks {
+			jobs <- i
+		}
+		close(jobs)
+	}()
+	defer wg.Wait()
+
+	for i, check := range d.checks {
+		// Stream: print check name while waiting on it
 		if w != nil {
 			fmt.Fprintf(w, "  %s  %s...", ui.RenderMuted("○"), check.Name())
 		}
 
-		start := time.Now()
-		result := check.Run(ctx)
-		result.Elapsed = time.Since(start)
-
-		// Ensure check name is populated
-		if result.Name == "" {
-			result.Name = check.Name()
-		}
-		// Set category from check if available
-		if cg, ok := check.(categoryGetter); ok && result.Category == "" {
-			result.Category = cg.Category()
-		}
+		<-done[i]
+		result := results[i]
 
 		// Stream: overwrite line with result
 		if w != nil {
@@ -93,7 +149,7 @@ func (d *Doctor) RunStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 			if result.Message != "" {
 				fmt.Fprintf(w, "%s", ui.RenderMuted(" "+result.Message))
 			}
-			if isSlow {
+			if isSlow || ctx.Verbose {
 				fmt.Fprintf(w, "%s", ui.RenderMuted(" ("+formatDuration(result.Elapsed)+")"))
 			}
 			fmt.Fprintln(w)
@@ -125,7 +181,8 @@ func safeFixCheck(check Check, ctx *CheckContext) (retErr error) {
 
 // FixStreaming runs all checks with auto-fix and optional real-time output.
 // If w is non-nil, prints each check name as it starts and result when done.
-// If slowThreshold > 0, shows hourglass icon for slow checks.
+// If slowThreshold > 0, shows hourglass icon for slow checks. Each check's
+// elapsed time is shown when it is slow or when ctx.Verbose is set.
 func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time.Duration) *Report {
 	report := NewReport()
 
@@ -164,6 +221,7 @@ func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 			}
 
 			err := safeFixCheck(check, ctx)
+			report.recordFix(check, err, errors.Is(err, ErrSkippedNoStart))
 			if err == nil {
 				// Re-run check to verify fix worked
 				result = check.Run(ctx)
@@ -221,7 +279,7 @@ func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 			if result.Message != "" {
 				fmt.Fprintf(w, "%s", ui.RenderMuted(" "+result.Message))
 			}
-			if isSlow {
+			if isSlow || ctx.Verbose {
 				fmt.Fprintf(w, "%s", ui.RenderMuted(" ("+formatDuration(result.Elapsed)+")"))
 			}
 			fmt.Fprintln(w)
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // mockCheck is a test check that can be configured to return any status.
@@ -332,6 +334,99 @@ func TestDoctor_Fix(t *testing.T) {
 	}
 }
 
+// recordingMockCheck is a mockCheck that reports a fixed set of applied actions.
+type recordingMockCheck struct {
+	*mockCheck
+	actions []FixAction
+}
+
+func (r *recordingMockCheck) AppliedFixActions() []FixAction {
+	return r.actions
+}
+
+func TestDoctor_FixLogPartialFailure(t *testing.T) {
+	d := NewDoctor()
+
+	first := &recordingMockCheck{
+		mockCheck: newMockCheck("first", StatusWarning),
+		actions: []FixAction{{
+			Description: "hq-mayor: set GT_ROLE=mayor",
+			Undo:        "tmux set-environment -t hq-mayor -u GT_ROLE",
+		}},
+	}
+	first.fixable = true
+	d.Register(first)
+
+	middle := newMockCheck("middle", StatusError)
+	middle.fixable = true
+	middle.fixError = fmt.Errorf("permission denied")
+	d.Register(middle)
+
+	last := newMockCheck("last", StatusWarning)
+	last.fixable = true
+	d.Register(last)
+
+	report := d.Fix(&CheckContext{TownRoot: "/test"})
+
+	if !report.HasFixFailures() {
+		t.Fatal("HasFixFailures() = false, want true")
+	}
+	if len(report.FixLog) != 3 {
+		t.Fatalf("FixLog has %d entries, want 3", len(report.FixLog))
+	}
+	want := []struct {
+		check   string
+		outcome FixOutcome
+	}{
+		{"first", FixApplied},
+		{"middle", FixFailed},
+		{"last", FixApplied},
+	}
+	for i, w := range want {
+		got := report.FixLog[i]
+		if got.Check != w.check || got.Outcome != w.outcome {
+			t.Errorf("FixLog[%d] = %s/%s, want %s/%s", i, got.Check, got.Outcome, w.check, w.outcome)
+		}
+	}
+	if len(report.FixLog[0].Actions) != 1 {
+		t.Errorf("FixLog[0] should carry the recorded action, got %v", report.FixLog[0].Actions)
+	}
+
+	var buf bytes.Buffer
+	report.PrintFixLog(&buf)
+	out := buf.String()
+	for _, s := range []string{
+		"2 applied, 1 failed",
+		"middle: failed: permission denied",
+		"hq-mayor: set GT_ROLE=mayor",
+		"undo: tmux set-environment -t hq-mayor -u GT_ROLE",
+		"not rolled back",
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("PrintFixLog output missing %q:\n%s", s, out)
+		}
+	}
+	if strings.Index(out, "first") > strings.Index(out, "middle") ||
+		strings.Index(out, "middle") > strings.Index(out, "last") {
+		t.Errorf("PrintFixLog should list fixes in order:\n%s", out)
+	}
+}
+
+func TestDoctor_FixLogEmptyWithoutFixes(t *testing.T) {
+	d := NewDoctor()
+	d.Register(newMockCheck("ok", StatusOK))
+
+	report := d.Fix(&CheckContext{TownRoot: "/test"})
+	if len(report.FixLog) != 0 || report.HasFixFailures() {
+		t.Errorf("FixLog = %v, want empty", report.FixLog)
+	}
+	var buf bytes.Buffer
+	report.PrintFixLog(&buf)
+	if buf.Len() != 0 {
+		t.Errorf("PrintFixLog should print nothing without fixes, got %q", buf.String())
+	}
+}
+
 func TestBaseCheck(t *testing.T) {
 	b := &BaseCheck{
 		CheckName:        "test",
@@ -423,3 +518,242 @@ func TestFixableCheck(t *testing.T) {
 		t.Error("FixableCheck.CanFix() should return true")
 	}
 }
+
+func TestReport_ExitCode(t *testing.T) {
+	tests := []struct {
+		name             string
+		statuses         []CheckStatus
+		warningsAsErrors bool
+		want             int
+	}{
+		{"all ok", []CheckStatus{StatusOK, StatusOK}, false, ExitOK},
+		{"all ok, warnings as errors", []CheckStatus{StatusOK, StatusOK}, true, ExitOK},
+		{"warning", []CheckStatus{StatusOK, StatusWarning}, false, ExitOK},
+		{"warning, warnings as errors", []CheckStatus{StatusOK, StatusWarning}, true, ExitWarnings},
+		{"error", []CheckStatus{StatusError, StatusOK}, false, ExitErrors},
+		{"error beats warning", []CheckStatus{StatusWarning, StatusError}, true, ExitErrors},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDoctor()
+			for i, status := range tt.statuses {
+				d.Register(newMockCheck(fmt.Sprintf("check-%d", i), status))
+			}
+			report := d.Run(&CheckContext{TownRoot: t.TempDir()})
+			if got := report.ExitCode(tt.warningsAsErrors); got != tt.want {
+				t.Errorf("ExitCode(%v) = %d, want %d", tt.warningsAsErrors, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReport_ExitCodeFixFailure(t *testing.T) {
+	d := NewDoctor()
+	check := newMockCheck("broken", StatusWarning)
+	check.fixable = true
+	check.fixError = fmt.Errorf("boom")
+	d.Register(check)
+
+	report := d.Fix(&CheckContext{TownRoot: t.TempDir()})
+	if got := report.ExitCode(false); got != ExitErrors {
+		t.Errorf("ExitCode(false) after failed fix = %d, want %d", got, ExitErrors)
+	}
+}
+
+func TestCheckStatus_Severity(t *testing.T) {
+	if !(StatusOK.Severity() < StatusWarning.Severity() && StatusWarning.Severity() < StatusError.Severity()) {
+		t.Fatalf("severity order = %d, %d, %d; want OK < Warning < Error",
+			StatusOK.Severity(), StatusWarning.Severity(), StatusError.Severity())
+	}
+	if CheckStatus(99).Severity() != StatusError.Severity() {
+		t.Errorf("unknown status severity = %d, want %d", CheckStatus(99).Severity(), StatusError.Severity())
+	}
+}
+
+func TestWorstStatus(t *testing.T) {
+	r := func(s CheckStatus) *CheckResult { return &CheckResult{Status: s} }
+	tests := []struct {
+		name    string
+		results []*CheckResult
+		want    CheckStatus
+	}{
+		{"empty", nil, StatusOK},
+		{"all ok", []*CheckResult{r(StatusOK), r(StatusOK)}, StatusOK},
+		{"ok and warning", []*CheckResult{r(StatusOK), r(StatusWarning), r(StatusOK)}, StatusWarning},
+		{"error first", []*CheckResult{r(StatusError), r(StatusWarning), r(StatusOK)}, StatusError},
+		{"error last", []*CheckResult{r(StatusWarning), r(StatusOK), r(StatusError)}, StatusError},
+		{"nil ignored", []*CheckResult{nil, r(StatusWarning)}, StatusWarning},
+		{"unknown counts as error", []*CheckResult{r(StatusWarning), r(CheckStatus(99))}, StatusError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WorstStatus(tt.results); got != tt.want {
+				t.Errorf("WorstStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// sleepCheck is a passing check that takes a fixed amount of time.
+type sleepCheck struct {
+	BaseCheck
+	d time.Duration
+}
+
+func (c *sleepCheck) Run(ctx *CheckContext) *CheckResult {
+	time.Sleep(c.d)
+	return &CheckResult{Name: c.CheckName, Status: StatusOK, Message: "done"}
+}
+
+func TestDoctor_RecordsCheckDurations(t *testing.T) {
+	d := NewDoctor()
+	d.Register(&sleepCheck{BaseCheck: BaseCheck{CheckName: "sleepy"}, d: 20 * time.Millisecond})
+	d.Register(newMockCheck("quick", StatusOK))
+
+	report := d.Run(&CheckContext{TownRoot: t.TempDir()})
+	if got := report.Checks[0].Elapsed; got < 20*time.Millisecond {
+		t.Errorf("sleepy Elapsed = %v, want >= 20ms", got)
+	}
+	if report.Summary.SlowestName != "sleepy" {
+		t.Errorf("SlowestName = %q, want sleepy", report.Summary.SlowestName)
+	}
+
+	fixReport := d.Fix(&CheckContext{TownRoot: t.TempDir()})
+	if got := fixReport.Checks[0].Elapsed; got < 20*time.Millisecond {
+		t.Errorf("sleepy Elapsed under Fix = %v, want >= 20ms", got)
+	}
+}
+
+func TestDoctor_TimingsShownOnlyWhenVerbose(t *testing.T) {
+	d := NewDoctor()
+	d.Register(&sleepCheck{BaseCheck: BaseCheck{CheckName: "sleepy"}, d: 5 * time.Millisecond})
+
+	var quiet bytes.Buffer
+	d.RunStreaming(&CheckContext{TownRoot: t.TempDir()}, &quiet, 0)
+	if strings.Contains(quiet.String(), "ms)") {
+		t.Errorf("non-verbose output shows timing: %q", quiet.String())
+	}
+
+	var verbose bytes.Buffer
+	d.RunStreaming(&CheckContext{TownRoot: t.TempDir(), Verbose: true}, &verbose, 0)
+	if !strings.Contains(verbose.String(), "ms)") {
+		t.Errorf("verbose output missing timing: %q", verbose.String())
+	}
+}
+
+// trackingCheck records how many checks are running at once.
+type trackingCheck struct {
+	BaseCheck
+	status   CheckStatus
+	d        time.Duration
+	mu       *sync.Mutex
+	inFlight *int
+	maxSeen  *int
+}
+
+func (c *trackingCheck) Run(ctx *CheckContext) *CheckResult {
+	c.mu.Lock()
+	*c.inFlight++
+	if *c.inFlight > *c.maxSeen {
+		*c.maxSeen = *c.inFlight
+	}
+	c.mu.Unlock()
+
+	time.Sleep(c.d)
+
+	c.mu.Lock()
+	*c.inFlight--
+	c.mu.Unlock()
+	return &CheckResult{Status: c.status, Message: "slept " + c.d.String(), Category: CategoryCore}
+}
+
+func TestDoctor_ConcurrentRunMatchesSequential(t *testing.T) {
+	var mu sync.Mutex
+	var inFlight, maxSeen int
+	build := func(concurrency int) *Doctor {
+		d := NewDoctor()
+		d.SetConcurrency(concurrency)
+		statuses := []CheckStatus{StatusOK, StatusWarning, StatusError}
+		for i := 0; i < 12; i++ {
+			d.Register(&trackingCheck{
+				BaseCheck: BaseCheck{CheckName: fmt.Sprintf("check-%02d", i)},
+				status:    statuses[i%len(statuses)],
+				// Later checks finish first so completion order differs
+				// from registration order.
+				d:        time.Duration(12-i) * time.Millisecond,
+				mu:       &mu,
+				inFlight: &inFlight,
+				maxSeen:  &maxSeen,
+			})
+		}
+		return d
+	}
+
+	sequential := build(1).Run(&CheckContext{TownRoot: t.TempDir()})
+	if maxSeen != 1 {
+		t.Fatalf("sequential run had %d checks in flight, want 1", maxSeen)
+	}
+
+	maxSeen = 0
+	concurrent := build(4).Run(&CheckContext{TownRoot: t.TempDir()})
+	if maxSeen < 2 || maxSeen > 4 {
+		t.Errorf("concurrent run had %d checks in flight, want 2..4", maxSeen)
+	}
+
+	if len(concurrent.Checks) != len(sequential.Checks) {
+		t.Fatalf("got %d results, want %d", len(concurrent.Checks), len(sequential.Checks))
+	}
+	for i := range sequential.Checks {
+		s, c := sequential.Checks[i], concurrent.Checks[i]
+		if c.Name != s.Name || c.Status != s.Status || c.Message != s.Message || c.Category != s.Category {
+			t.Errorf("result %d = %s/%v/%q/%s, want %s/%v/%q/%s",
+				i, c.Name, c.Status, c.Message, c.Category, s.Name, s.Status, s.Message, s.Category)
+		}
+	}
+	if concurrent.Summary.OK != sequential.Summary.OK ||
+		concurrent.Summary.Warnings != sequential.Summary.Warnings ||
+		concurrent.Summary.Errors != sequential.Summary.Errors {
+		t.Errorf("summary = %+v, want %+v", concurrent.Summary, sequential.Summary)
+	}
+}
+
+func TestDoctor_ConcurrentStreamingKeepsRegistrationOrder(t *testing.T) {
+	d := NewDoctor()
+	d.Register(&sleepCheck{BaseCheck: BaseCheck{CheckName: "first-slow"}, d: 20 * time.Millisecond})
+	d.Register(newMockCheck("second-fast", StatusOK))
+
+	var buf bytes.Buffer
+	d.RunStreaming(&CheckContext{TownRoot: t.TempDir()}, &buf, 0)
+	out := buf.String()
+	first, second := strings.Index(out, "first-slow"), strings.Index(out, "second-fast")
+	if first < 0 || second < 0 || first > second {
+		t.Errorf("streamed output out of registration order:\n%s", out)
+	}
+}
+
+// nonIdempotentCheck declares that its fix must not be re-applied.
+type nonIdempotentCheck struct {
+	mockCheck
+}
+
+func (c *nonIdempotentCheck) Idempotent() bool { return false }
+
+func TestIsIdempotentFix(t *testing.T) {
+	tests := []struct {
+		name  string
+		check Check
+		want  bool
+	}{
+		{"undeclared defaults to idempotent", newMockCheck("plain", StatusWarning), true},
+		{"declared non-idempotent", &nonIdempotentCheck{mockCheck: *newMockCheck("once", StatusWarning)}, false},
+		{"env-vars", NewEnvVarsCheck(), true},
+		{"tmux-global-env", NewTmuxGlobalEnvCheck(), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsIdempotentFix(tt.check); got != tt.want {
+				t.Errorf("IsIdempotentFix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
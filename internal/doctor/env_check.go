@@ -2,6 +2,7 @@ package doctor
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/session"
@@ -42,11 +43,53 @@ func (r *tmuxEnvReaderWriter) SetEnvironment(session, key, value string) error {
 	return r.t.SetEnvironment(session, key, value)
 }
 
+// SessionEnvCache wraps a SessionEnvAccessor and remembers each session's
+// environment after the first read, so Run and Fix in one doctor invocation
+// do not shell out to tmux again for sessions they have already seen.
+// SetEnvironment drops the session's entry, so the next read reflects the
+// write. Failed reads are not cached. Maps returned by GetAllEnvironment are
+// shared with the cache and must not be modified.
+type SessionEnvCache struct {
+	SessionEnvAccessor
+	envs map[string]map[string]string
+}
+
+// NewSessionEnvCache returns an empty cache in front of accessor.
+func NewSessionEnvCache(accessor SessionEnvAccessor) *SessionEnvCache {
+	return &SessionEnvCache{
+		SessionEnvAccessor: accessor,
+		envs:               make(map[string]map[string]string),
+	}
+}
+
+// GetAllEnvironment returns the cached environment for session, reading it
+// through the wrapped accessor on a miss.
+func (c *SessionEnvCache) GetAllEnvironment(session string) (map[string]string, error) {
+	if env, ok := c.envs[session]; ok {
+		return env, nil
+	}
+	env, err := c.SessionEnvAccessor.GetAllEnvironment(session)
+	if err != nil {
+		return nil, err
+	}
+	c.envs[session] = env
+	return env, nil
+}
+
+// SetEnvironment writes through to the wrapped accessor and invalidates the
+// session's cached environment, whether or not the write succeeded.
+func (c *SessionEnvCache) SetEnvironment(session, key, value string) error {
+	delete(c.envs, session)
+	return c.SessionEnvAccessor.SetEnvironment(session, key, value)
+}
+
 // EnvVarsCheck verifies that tmux session environment variables match expected values.
 type EnvVarsCheck struct {
 	FixableCheck
-	reader   SessionEnvReader  // nil means use real tmux
+	reader   SessionEnvReader   // nil means use real tmux
 	accessor SessionEnvAccessor // non-nil when Fix() support is needed
+	applied  []FixAction        // changes made by the last Fix() call
+	cache    *SessionEnvCache   // shared by Run and Fix; see envCache
 }
 
 // NewEnvVarsCheck creates a new env vars check.
@@ -77,11 +120,27 @@ func NewEnvVarsCheckWithAccessor(accessor SessionEnvAccessor) *EnvVarsCheck {
 	return c
 }
 
+// envCache returns the check's session env cache, creating it around the
+// configured accessor (or real tmux) on first use. The check lives for one
+// doctor invocation, so the cache does too.
+func (c *EnvVarsCheck) envCache() *SessionEnvCache {
+	if c.cache == nil {
+		accessor := c.accessor
+		if accessor == nil {
+			accessor = &tmuxEnvReaderWriter{t: tmux.NewTmux()}
+		}
+		c.cache = NewSessionEnvCache(accessor)
+	}
+	return c.cache
+}
+
 // Run checks environment variables for all Gas Town sessions.
 func (c *EnvVarsCheck) Run(ctx *CheckContext) *CheckResult {
+	// A read-only test reader is used as-is; anything that can also back
+	// Fix goes through the shared cache.
 	reader := c.reader
-	if reader == nil {
-		reader = &tmuxEnvReaderWriter{t: tmux.NewTmux()}
+	if reader == nil || c.accessor != nil {
+		reader = c.envCache()
 	}
 
 	sessions, err := reader.ListSessions()
@@ -116,28 +175,12 @@ func (c *EnvVarsCheck) Run(ctx *CheckContext) *CheckResult {
 	checkedCount := 0
 
 	for _, sess := range gtSessions {
-		identity, err := session.ParseSessionName(sess)
+		expected, err := ExpectedSessionEnv(sess, ctx.TownRoot)
 		if err != nil {
 			// Skip unparseable sessions
 			continue
 		}
 
-		// Determine role for AgentEnv lookup.
-		// Boot watchdog is parsed as deacon with name "boot", but AgentEnv
-		// uses "boot" as a distinct role for env var generation.
-		role := string(identity.Role)
-		if identity.Role == session.RoleDeacon && identity.Name == "boot" {
-			role = "boot"
-		}
-
-		// Get expected env vars based on role
-		expected := config.AgentEnv(config.AgentEnvConfig{
-			Role:      role,
-			Rig:       identity.Rig,
-			AgentName: identity.Name,
-			TownRoot:  ctx.TownRoot,
-		})
-
 		// Get actual tmux env vars
 		actual, err := reader.GetAllEnvironment(sess)
 		if err != nil {
@@ -147,23 +190,22 @@ func (c *EnvVarsCheck) Run(ctx *CheckContext) *CheckResult {
 
 		checkedCount++
 
-		// Compare each expected var
-		for key, expectedVal := range expected {
-			actualVal, exists := actual[key]
-			if !exists && expectedVal != "" {
-				// Only flag missing vars when the expected value is non-empty.
+		for _, m := range config.DiffEnv(expected, actual) {
+			switch m.Kind {
+			case config.EnvMissing:
+				mismatches = append(mismatches, fmt.Sprintf("%s: missing %s (expected %q)", sess, m.Key, m.Expected))
+			case config.EnvUnset:
 				// An absent var has the same effect as an empty one (e.g. CLAUDECODE=""
 				// prevents nested session detection, but so does CLAUDECODE being unset).
-				mismatches = append(mismatches, fmt.Sprintf("%s: missing %s (expected %q)", sess, key, expectedVal))
-			} else if exists && actualVal != expectedVal {
-				mismatches = append(mismatches, fmt.Sprintf("%s: %s=%q (expected %q)", sess, key, actualVal, expectedVal))
+			case config.EnvBeadsDir:
+				// BEADS_DIR breaks routing-based lookups; warn about it on top of
+				// reporting the wrong value.
+				beadsDirWarnings = append(beadsDirWarnings, fmt.Sprintf("%s: BEADS_DIR=%q (breaks prefix routing)", sess, m.Actual))
+				mismatches = append(mismatches, fmt.Sprintf("%s: %s=%q (expected %q)", sess, m.Key, m.Actual, m.Expected))
+			default:
+				mismatches = append(mismatches, fmt.Sprintf("%s: %s=%q (expected %q)", sess, m.Key, m.Actual, m.Expected))
 			}
 		}
-
-		// Check for BEADS_DIR - this breaks routing-based lookups
-		if beadsDir, exists := actual["BEADS_DIR"]; exists && beadsDir != "" {
-			beadsDirWarnings = append(beadsDirWarnings, fmt.Sprintf("%s: BEADS_DIR=%q (breaks prefix routing)", sess, beadsDir))
-		}
 	}
 
 	// Check for BEADS_DIR issues first (higher priority warning)
@@ -182,7 +224,7 @@ func (c *EnvVarsCheck) Run(ctx *CheckContext) *CheckResult {
 			Status:  StatusWarning,
 			Message: fmt.Sprintf("Found BEADS_DIR set in %d session(s)", len(beadsDirWarnings)),
 			Details: details,
-			FixHint: "Remove BEADS_DIR from session environment: gt shutdown && gt up",
+			FixHint: "Run 'gt doctor --fix' to clear BEADS_DIR in session environments, or 'gt shutdown && gt up' to restart",
 		}
 	}
 
@@ -213,10 +255,9 @@ func (c *EnvVarsCheck) Run(ctx *CheckContext) *CheckResult {
 // The running Claude process is unaffected (it already has env vars from startup);
 // this updates the tmux session store so future processes and gt doctor agree.
 func (c *EnvVarsCheck) Fix(ctx *CheckContext) error {
-	accessor := c.accessor
-	if accessor == nil {
-		accessor = &tmuxEnvReaderWriter{t: tmux.NewTmux()}
-	}
+	accessor := c.envCache()
+
+	c.applied = nil
 
 	sessions, err := accessor.ListSessions()
 	if err != nil {
@@ -224,38 +265,88 @@ func (c *EnvVarsCheck) Fix(ctx *CheckContext) error {
 		return nil
 	}
 
+	var failures []string
 	for _, sess := range sessions {
 		if !session.IsKnownSession(sess) {
 			continue
 		}
-		identity, err := session.ParseSessionName(sess)
+		expected, err := ExpectedSessionEnv(sess, ctx.TownRoot)
 		if err != nil {
 			continue
 		}
 
-		role := string(identity.Role)
-		if identity.Role == session.RoleDeacon && identity.Name == "boot" {
-			role = "boot"
-		}
-
-		expected := config.AgentEnv(config.AgentEnvConfig{
-			Role:      role,
-			Rig:       identity.Rig,
-			AgentName: identity.Name,
-			TownRoot:  ctx.TownRoot,
-		})
-
 		actual, err := accessor.GetAllEnvironment(sess)
 		if err != nil {
 			continue
 		}
 
-		for key, expectedVal := range expected {
-			actualVal, exists := actual[key]
-			if !exists || actualVal != expectedVal {
-				_ = accessor.SetEnvironment(sess, key, expectedVal)
+		// Unlike Run, pin unset keys too: a tmux session without the key
+		// would inherit it from the global environment. That includes
+		// BEADS_DIR, which AgentEnv pins to empty.
+		for _, m := range config.DiffEnv(expected, actual) {
+			_, existed := actual[m.Key]
+			if err := accessor.SetEnvironment(sess, m.Key, m.Expected); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %s: %v", sess, m.Key, err))
+				continue
 			}
+			c.applied = append(c.applied, envFixAction(sess, m.Key, m.Expected, m.Actual, existed))
 		}
 	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to set %d env var(s): %s", len(failures), strings.Join(failures, "; "))
+	}
 	return nil
 }
+
+// ExpectedSessionEnv returns the environment Gas Town expects in the named
+// tmux session, from config.AgentEnv for the role encoded in the name.
+// Returns an error if the name is not a parseable Gas Town session.
+func ExpectedSessionEnv(sess, townRoot string) (map[string]string, error) {
+	identity, err := session.ParseSessionName(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	// Boot watchdog is parsed as deacon with name "boot", but AgentEnv
+	// uses "boot" as a distinct role for env var generation.
+	role := string(identity.Role)
+	if identity.Role == session.RoleDeacon && identity.Name == "boot" {
+		role = "boot"
+	}
+
+	return config.AgentEnv(config.AgentEnvConfig{
+		Role:      role,
+		Rig:       identity.Rig,
+		AgentName: identity.Name,
+		TownRoot:  townRoot,
+	}), nil
+}
+
+// AppliedFixActions returns the env var changes made by the last Fix() call,
+// with the tmux command that restores each previous value.
+func (c *EnvVarsCheck) AppliedFixActions() []FixAction {
+	return c.applied
+}
+
+// Idempotent reports that Fix can be re-applied: it only sets each session's
+// variables to their expected values.
+func (c *EnvVarsCheck) Idempotent() bool {
+	return true
+}
+
+// envFixAction describes a single tmux set-environment change and its undo.
+func envFixAction(sess, key, newVal, oldVal string, existed bool) FixAction {
+	undo := fmt.Sprintf("tmux set-environment -t %s -u %s", sess, key)
+	if existed {
+		undo = fmt.Sprintf("tmux set-environment -t %s %s %s", sess, key, shellQuote(oldVal))
+	}
+	return FixAction{
+		Description: fmt.Sprintf("%s: set %s=%s", sess, key, newVal),
+		Undo:        undo,
+	}
+}
+
+// shellQuote single-quotes s for safe pasting into a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
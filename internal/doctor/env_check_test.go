@@ -15,6 +15,7 @@ type mockEnvReader struct {
 	sessionEnvs map[string]map[string]string
 	listErr     error
 	envErrs     map[string]error
+	reads       int // GetAllEnvironment calls
 }
 
 func (m *mockEnvReader) ListSessions() ([]string, error) {
@@ -25,6 +26,7 @@ func (m *mockEnvReader) ListSessions() ([]string, error) {
 }
 
 func (m *mockEnvReader) GetAllEnvironment(session string) (map[string]string, error) {
+	m.reads++
 	if m.envErrs != nil {
 		if err, ok := m.envErrs[session]; ok {
 			return nil, err
@@ -520,6 +522,7 @@ func TestEnvVarsCheck_BeadsDirWithOtherMismatches(t *testing.T) {
 }
 
 // mockEnvAccessor extends mockEnvReader with SetEnvironment support for Fix() tests.
+// Writes are also applied to sessionEnvs so a re-run sees them.
 type mockEnvAccessor struct {
 	mockEnvReader
 	setCalls map[string]map[string]string // session -> key -> value
@@ -537,6 +540,9 @@ func (m *mockEnvAccessor) SetEnvironment(sess, key, val string) error {
 		m.setCalls[sess] = make(map[string]string)
 	}
 	m.setCalls[sess][key] = val
+	if env := m.sessionEnvs[sess]; env != nil {
+		env[key] = val
+	}
 	return nil
 }
 
@@ -615,3 +621,169 @@ func TestEnvVarsCheck_FixSkipsCorrectVars(t *testing.T) {
 		t.Errorf("Fix() should not call SetEnvironment when vars are correct, got: %v", mock.setCalls)
 	}
 }
+
+func TestEnvVarsCheck_FixRecordsUndo(t *testing.T) {
+	mock := &mockEnvAccessor{
+		mockEnvReader: mockEnvReader{
+			sessions: []string{"hq-mayor"},
+			sessionEnvs: map[string]map[string]string{
+				"hq-mayor": {"GT_ROLE": "it's wrong"},
+			},
+		},
+	}
+	check := NewEnvVarsCheckWithAccessor(mock)
+
+	if err := check.Fix(testCtx()); err != nil {
+		t.Fatalf("Fix() returned error: %v", err)
+	}
+
+	actions := check.AppliedFixActions()
+	if len(actions) != len(mock.setCalls["hq-mayor"]) {
+		t.Fatalf("recorded %d actions, want %d", len(actions), len(mock.setCalls["hq-mayor"]))
+	}
+	var sawRestore, sawUnset bool
+	for _, a := range actions {
+		if a.Undo == `tmux set-environment -t hq-mayor GT_ROLE 'it'\''s wrong'` {
+			sawRestore = true
+		}
+		if strings.HasPrefix(a.Undo, "tmux set-environment -t hq-mayor -u ") {
+			sawUnset = true
+		}
+	}
+	if !sawRestore {
+		t.Errorf("expected undo restoring previous GT_ROLE, got %v", actions)
+	}
+	if !sawUnset {
+		t.Errorf("expected undo unsetting previously-missing vars, got %v", actions)
+	}
+}
+
+func TestEnvVarsCheck_FixReturnsSetErrors(t *testing.T) {
+	mock := &mockEnvAccessor{
+		mockEnvReader: mockEnvReader{
+			sessions: []string{"hq-mayor"},
+			sessionEnvs: map[string]map[string]string{
+				"hq-mayor": {},
+			},
+		},
+		setErr: errors.New("session gone"),
+	}
+	check := NewEnvVarsCheckWithAccessor(mock)
+
+	err := check.Fix(testCtx())
+	if err == nil || !strings.Contains(err.Error(), "session gone") {
+		t.Fatalf("Fix() error = %v, want set failure", err)
+	}
+	if len(check.AppliedFixActions()) != 0 {
+		t.Errorf("no actions should be recorded when every set fails, got %v", check.AppliedFixActions())
+	}
+}
+
+func TestEnvVarsCheck_CachesEnvAcrossRunAndFix(t *testing.T) {
+	mayor := expectedEnv("mayor", "", "")
+	mock := &mockEnvAccessor{
+		mockEnvReader: mockEnvReader{
+			sessions: []string{"hq-mayor", "hq-deacon"},
+			sessionEnvs: map[string]map[string]string{
+				"hq-mayor":  mayor, // already correct
+				"hq-deacon": {},    // needs fixing
+			},
+		},
+	}
+	check := NewEnvVarsCheckWithAccessor(mock)
+
+	check.Run(testCtx())
+	if mock.reads != 2 {
+		t.Fatalf("Run() read env %d times, want 2", mock.reads)
+	}
+
+	if err := check.Fix(testCtx()); err != nil {
+		t.Fatalf("Fix() returned error: %v", err)
+	}
+	if mock.reads != 2 {
+		t.Errorf("Fix() after Run() re-read env: %d reads, want 2", mock.reads)
+	}
+	if len(mock.setCalls["hq-deacon"]) == 0 {
+		t.Fatal("Fix() did not set env on hq-deacon")
+	}
+
+	// Only the session Fix wrote to is read again.
+	check.Run(testCtx())
+	if mock.reads != 3 {
+		t.Errorf("re-Run() after Fix() made %d reads total, want 3", mock.reads)
+	}
+}
+
+func TestSessionEnvCache_DoesNotCacheErrors(t *testing.T) {
+	mock := &mockEnvAccessor{
+		mockEnvReader: mockEnvReader{
+			envErrs: map[string]error{"hq-mayor": errors.New("boom")},
+		},
+	}
+	cache := NewSessionEnvCache(mock)
+
+	if _, err := cache.GetAllEnvironment("hq-mayor"); err == nil {
+		t.Fatal("GetAllEnvironment() error = nil, want boom")
+	}
+	delete(mock.envErrs, "hq-mayor")
+	if _, err := cache.GetAllEnvironment("hq-mayor"); err != nil {
+		t.Fatalf("GetAllEnvironment() after recovery: %v", err)
+	}
+	if _, err := cache.GetAllEnvironment("hq-mayor"); err != nil {
+		t.Fatalf("GetAllEnvironment() cached: %v", err)
+	}
+	if mock.reads != 2 {
+		t.Errorf("underlying reads = %d, want 2 (failure, then one cached success)", mock.reads)
+	}
+}
+
+// TestEnvVarsCheck_FixPinsBeadsDirEmpty verifies Fix clears a stray BEADS_DIR
+// by pinning it to empty, as AgentEnv does, rather than unsetting it: an
+// unset session key would let new panes inherit a global BEADS_DIR.
+func TestEnvVarsCheck_FixPinsBeadsDirEmpty(t *testing.T) {
+	global := map[string]string{"BEADS_DIR": "/global/.beads"}
+	env := expectedEnv("mayor", "", "")
+	env["BEADS_DIR"] = "/some/path/.beads"
+	mock := &mockEnvAccessor{
+		mockEnvReader: mockEnvReader{
+			sessions:    []string{"hq-mayor"},
+			sessionEnvs: map[string]map[string]string{"hq-mayor": env},
+		},
+	}
+	check := NewEnvVarsCheckWithAccessor(mock)
+
+	if result := check.Run(testCtx()); result.Status != StatusWarning {
+		t.Fatalf("Run() before Fix = %v, want warning", result.Status)
+	}
+	if err := check.Fix(testCtx()); err != nil {
+		t.Fatalf("Fix() returned error: %v", err)
+	}
+
+	if got, ok := mock.setCalls["hq-mayor"]["BEADS_DIR"]; !ok || got != "" {
+		t.Errorf("SetEnvironment(BEADS_DIR) = %q (called %v), want pinned to empty", got, ok)
+	}
+	if got := paneEnv(global, mock.sessionEnvs["hq-mayor"])["BEADS_DIR"]; got != "" {
+		t.Errorf("new pane BEADS_DIR = %q, want empty (global value must not leak in)", got)
+	}
+	actions := check.AppliedFixActions()
+	if len(actions) != 1 || actions[0].Undo != "tmux set-environment -t hq-mayor BEADS_DIR '/some/path/.beads'" {
+		t.Errorf("AppliedFixActions() = %+v, want one BEADS_DIR fix with restore undo", actions)
+	}
+
+	if result := check.Run(testCtx()); result.Status != StatusOK {
+		t.Errorf("Run() after Fix = %v (%s), want OK", result.Status, result.Message)
+	}
+}
+
+// paneEnv returns the environment a new pane in a session would start with:
+// the tmux global environment overlaid with the session's own values.
+func paneEnv(global, sess map[string]string) map[string]string {
+	env := make(map[string]string, len(global)+len(sess))
+	for k, v := range global {
+		env[k] = v
+	}
+	for k, v := range sess {
+		env[k] = v
+	}
+	return env
+}
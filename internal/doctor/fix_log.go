@@ -0,0 +1,132 @@
+package doctor
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/ui"
+)
+
+// FixAction describes a single change applied by a check's Fix, along with
+// the command needed to reverse it by hand.
+type FixAction struct {
+	Description string // What was changed, e.g. "hq-mayor: set GT_ROLE=mayor"
+	Undo        string // Command that restores the previous state (empty if unknown)
+}
+
+// FixActionRecorder is implemented by checks that can report the individual
+// changes their last Fix call applied. Actions are reported in the order they
+// were applied, including those applied before a failure.
+type FixActionRecorder interface {
+	AppliedFixActions() []FixAction
+}
+
+// IdempotentFixer is implemented by checks that declare whether their Fix can
+// safely be applied again, e.g. by a loop that re-runs fixes until checks pass.
+// Checks that do not implement it are treated as idempotent.
+type IdempotentFixer interface {
+	Idempotent() bool
+}
+
+// IsIdempotentFix reports whether re-applying check's Fix is safe. It
+// defaults to true for checks that do not implement IdempotentFixer.
+func IsIdempotentFix(check Check) bool {
+	if f, ok := check.(IdempotentFixer); ok {
+		return f.Idempotent()
+	}
+	return true
+}
+
+// FixOutcome is the result of a single fix attempt.
+type FixOutcome string
+
+const (
+	FixApplied FixOutcome = "applied"
+	FixFailed  FixOutcome = "failed"
+	FixSkipped FixOutcome = "skipped"
+)
+
+// FixLogEntry records one attempted fix in the order it ran.
+type FixLogEntry struct {
+	Check   string
+	Outcome FixOutcome
+	Err     error
+	Actions []FixAction
+}
+
+// recordFix appends a fix attempt to the report's fix log.
+func (r *Report) recordFix(check Check, err error, skipped bool) {
+	entry := FixLogEntry{Check: check.Name(), Outcome: FixApplied, Err: err}
+	switch {
+	case skipped:
+		entry.Outcome = FixSkipped
+	case err != nil:
+		entry.Outcome = FixFailed
+	}
+	if rec, ok := check.(FixActionRecorder); ok {
+		entry.Actions = rec.AppliedFixActions()
+	}
+	r.FixLog = append(r.FixLog, entry)
+}
+
+// HasFixFailures returns true if any attempted fix returned an error.
+func (r *Report) HasFixFailures() bool {
+	for _, e := range r.FixLog {
+		if e.Outcome == FixFailed {
+			return true
+		}
+	}
+	return false
+}
+
+// PrintFixLog prints the ordered log of attempted fixes, listing what each
+// applied and how to reverse it. Used after a partial --fix failure so the
+// user can see exactly which changes landed before things went wrong.
+func (r *Report) PrintFixLog(w io.Writer) {
+	if len(r.FixLog) == 0 {
+		return
+	}
+
+	applied, failed := 0, 0
+	for _, e := range r.FixLog {
+		switch e.Outcome {
+		case FixApplied:
+			applied++
+		case FixFailed:
+			failed++
+		}
+	}
+
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintf(w, "Fix log (%d applied, %d failed, in order):\n", applied, failed)
+	for i, e := range r.FixLog {
+		var icon string
+		switch e.Outcome {
+		case FixApplied:
+			icon = ui.RenderPassIcon()
+		case FixFailed:
+			icon = ui.RenderFailIcon()
+		default:
+			icon = ui.RenderMuted("○")
+		}
+		line := fmt.Sprintf("  %d. %s %s: %s", i+1, icon, e.Check, e.Outcome)
+		if e.Err != nil {
+			line += ": " + e.Err.Error()
+		}
+		_, _ = fmt.Fprintln(w, line)
+		for _, a := range e.Actions {
+			_, _ = fmt.Fprintf(w, "       - %s\n", a.Description)
+			if a.Undo != "" {
+				_, _ = fmt.Fprintf(w, "         %s\n", ui.RenderMuted("undo: "+a.Undo))
+			}
+		}
+	}
+
+	if failed > 0 && applied > 0 {
+		_, _ = fmt.Fprintln(w)
+		_, _ = fmt.Fprintln(w, strings.TrimSpace(`
+Earlier fixes were not rolled back. Use the undo commands above to reverse
+them manually, or re-run 'gt doctor --fix' once the failure is resolved.`))
+	}
+}
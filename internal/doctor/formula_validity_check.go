@@ -0,0 +1,90 @@
+package doctor
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/formula"
+)
+
+// FormulaValidityCheck parses every formula in the town and rig
+// .beads/formulas directories and reports any that fail to parse or validate,
+// so a broken formula is caught before an agent tries to launch it.
+type FormulaValidityCheck struct {
+	BaseCheck
+}
+
+// NewFormulaValidityCheck creates a new formula validity check.
+func NewFormulaValidityCheck() *FormulaValidityCheck {
+	return &FormulaValidityCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "formula-validity",
+			CheckDescription: "Check town and rig formulas parse and validate",
+			CheckCategory:    CategoryConfig,
+		},
+	}
+}
+
+// Run parses the .formula.toml files in the town and each registered rig.
+// Files identical to the embedded copy are skipped: those ship with the binary
+// and are validated at build time (some use features the gt parser doesn't model).
+func (c *FormulaValidityCheck) Run(ctx *CheckContext) *CheckResult {
+	rigs, err := discoverRigs(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Could not load rigs.json",
+			Details: []string{err.Error()},
+		}
+	}
+	sort.Strings(rigs)
+
+	dirs := []string{filepath.Join(ctx.TownRoot, ".beads", "formulas")}
+	for _, rigName := range rigs {
+		dirs = append(dirs, filepath.Join(ctx.TownRoot, rigName, ".beads", "formulas"))
+	}
+
+	var checked int
+	var details []string
+	for _, dir := range dirs {
+		paths, _ := filepath.Glob(filepath.Join(dir, "*.formula.toml"))
+		for _, path := range paths {
+			data, err := os.ReadFile(path) //nolint:gosec // G304: path is from a town formulas directory
+			if err != nil {
+				details = append(details, fmt.Sprintf("%s: %v", path, err))
+				continue
+			}
+			if embedded, err := formula.GetEmbeddedFormulaContent(filepath.Base(path)); err == nil && bytes.Equal(data, embedded) {
+				continue
+			}
+			checked++
+			if _, err := formula.Parse(data); err != nil {
+				details = append(details, fmt.Sprintf("%s: %v", path, err))
+			}
+		}
+	}
+
+	if len(details) > 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("%d formula(s) failed to parse or validate", len(details)),
+			Details: details,
+			FixHint: "Fix the listed formula files; check one with 'gt formula show <name>'",
+		}
+	}
+
+	msg := fmt.Sprintf("%d local formula(s) valid", checked)
+	if checked == 0 {
+		msg = "No local formulas to check"
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: msg,
+	}
+}
@@ -0,0 +1,60 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/formula"
+)
+
+func writeFormulaFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name+".formula.toml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestFormulaValidityCheck_NoFormulas(t *testing.T) {
+	townRoot := t.TempDir()
+	writeRigsJSON(t, townRoot, "gastown")
+
+	result := NewFormulaValidityCheck().Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusOK {
+		t.Errorf("Status = %v, want OK: %s", result.Status, result.Message)
+	}
+}
+
+func TestFormulaValidityCheck_ReportsBrokenRigFormula(t *testing.T) {
+	townRoot := t.TempDir()
+	writeRigsJSON(t, townRoot, "gastown")
+	rigFormulas := filepath.Join(townRoot, "gastown", ".beads", "formulas")
+	townFormulas := filepath.Join(townRoot, ".beads", "formulas")
+
+	writeFormulaFile(t, townFormulas, "good", "formula = \"good\"\n[[steps]]\nid = \"s\"\n")
+	broken := writeFormulaFile(t, rigFormulas, "broken", "formula = \"broken\"\ntype = \"workfow\"\n[[steps]]\nid = \"s\"\n")
+
+	// An unmodified embedded formula is skipped without being validated.
+	embedded, err := formula.GetEmbeddedFormulaContent("security-audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFormulaFile(t, rigFormulas, "security-audit", string(embedded))
+
+	result := NewFormulaValidityCheck().Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusWarning {
+		t.Fatalf("Status = %v, want Warning: %s", result.Status, result.Message)
+	}
+	if len(result.Details) != 1 {
+		t.Fatalf("Details = %v, want exactly the broken formula", result.Details)
+	}
+	if !strings.HasPrefix(result.Details[0], broken+": ") || !strings.Contains(result.Details[0], "invalid formula type") {
+		t.Errorf("Details[0] = %q, want path and validation error", result.Details[0])
+	}
+}
@@ -98,7 +98,7 @@ func (c *HookAttachmentValidCheck) checkBeadsDir(beadsDir, _ string) []invalidAt
 
 	for _, pinnedBead := range pinnedBeads {
 		// Parse attachment fields from the pinned bead
-		attachment := beads.ParseAttachmentFields(pinnedBead)
+		attachment := pinnedBead.Attachment()
 		if attachment == nil || attachment.AttachedMolecule == "" {
 			continue // No attachment, skip
 		}
@@ -0,0 +1,113 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// OrphanedHeartbeatCheck detects heartbeat files under .runtime/heartbeats
+// whose tmux session no longer exists. These are left behind when a session
+// dies without running cleanup (RemoveSessionHeartbeat never called).
+type OrphanedHeartbeatCheck struct {
+	FixableCheck
+	sessionLister    SessionLister
+	orphanHeartbeats []string // Session names cached during Run for use in Fix
+}
+
+// NewOrphanedHeartbeatCheck creates a new orphaned heartbeat check.
+func NewOrphanedHeartbeatCheck() *OrphanedHeartbeatCheck {
+	return &OrphanedHeartbeatCheck{
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "orphaned-heartbeats",
+				CheckDescription: "Detect heartbeat files for sessions that no longer exist",
+				CheckCategory:    CategoryCleanup,
+			},
+		},
+	}
+}
+
+// NewOrphanedHeartbeatCheckWithSessionLister creates a check with a custom session lister (for testing).
+func NewOrphanedHeartbeatCheckWithSessionLister(lister SessionLister) *OrphanedHeartbeatCheck {
+	check := NewOrphanedHeartbeatCheck()
+	check.sessionLister = lister
+	return check
+}
+
+// Run checks for heartbeat files without a matching tmux session.
+func (c *OrphanedHeartbeatCheck) Run(ctx *CheckContext) *CheckResult {
+	c.orphanHeartbeats = nil
+
+	heartbeats, err := polecat.ListSessionHeartbeats(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Could not read heartbeat directory",
+			Details: []string{err.Error()},
+		}
+	}
+	if len(heartbeats) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No heartbeat files found",
+		}
+	}
+
+	lister := c.sessionLister
+	if lister == nil {
+		lister = &realSessionLister{t: tmux.NewTmux()}
+	}
+	sessions, err := lister.ListSessions()
+	if err != nil {
+		// Without a session list every heartbeat would look orphaned;
+		// don't offer to delete them.
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Could not list tmux sessions",
+			Details: []string{err.Error()},
+		}
+	}
+
+	live := make(map[string]bool, len(sessions))
+	for _, sess := range sessions {
+		live[sess] = true
+	}
+
+	var details []string
+	for _, sess := range heartbeats {
+		if !live[sess] {
+			c.orphanHeartbeats = append(c.orphanHeartbeats, sess)
+			details = append(details, fmt.Sprintf("Orphaned heartbeat: %s", sess))
+		}
+	}
+
+	if len(c.orphanHeartbeats) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("All %d heartbeat file(s) have live sessions", len(heartbeats)),
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("Found %d orphaned heartbeat file(s)", len(c.orphanHeartbeats)),
+		Details: details,
+		FixHint: "Run 'gt doctor --fix' to remove orphaned heartbeat files",
+	}
+}
+
+// Fix removes heartbeat files for sessions that no longer exist.
+func (c *OrphanedHeartbeatCheck) Fix(ctx *CheckContext) error {
+	for _, sess := range c.orphanHeartbeats {
+		polecat.RemoveSessionHeartbeat(ctx.TownRoot, sess)
+	}
+	c.orphanHeartbeats = nil
+	return nil
+}
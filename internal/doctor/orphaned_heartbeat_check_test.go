@@ -0,0 +1,78 @@
+package doctor
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestOrphanedHeartbeatCheck_NoHeartbeats(t *testing.T) {
+	townRoot := t.TempDir()
+	check := NewOrphanedHeartbeatCheckWithSessionLister(&mockSessionLister{})
+
+	result := check.Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusOK {
+		t.Errorf("Status = %v, want OK: %s", result.Status, result.Message)
+	}
+}
+
+func TestOrphanedHeartbeatCheck_DetectsAndFixesOrphan(t *testing.T) {
+	townRoot := t.TempDir()
+	polecat.TouchSessionHeartbeat(townRoot, "gt-live")
+	polecat.TouchSessionHeartbeat(townRoot, "gt-dead")
+
+	check := NewOrphanedHeartbeatCheckWithSessionLister(&mockSessionLister{
+		sessions: []string{"gt-live", "hq-mayor"},
+	})
+	ctx := &CheckContext{TownRoot: townRoot}
+
+	result := check.Run(ctx)
+	if result.Status != StatusWarning {
+		t.Fatalf("Status = %v, want Warning: %s", result.Status, result.Message)
+	}
+	if check.Category() != CategoryCleanup {
+		t.Errorf("Category = %q, want %q", check.Category(), CategoryCleanup)
+	}
+	if len(result.Details) != 1 || result.Details[0] != "Orphaned heartbeat: gt-dead" {
+		t.Errorf("Details = %v, want only gt-dead", result.Details)
+	}
+
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+
+	dir := filepath.Join(townRoot, ".runtime", "heartbeats")
+	if _, err := os.Stat(filepath.Join(dir, "gt-dead.json")); !os.IsNotExist(err) {
+		t.Errorf("orphaned heartbeat still exists after Fix (err=%v)", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "gt-live.json")); err != nil {
+		t.Errorf("live heartbeat removed by Fix: %v", err)
+	}
+
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Errorf("Status after Fix = %v, want OK: %v", result.Status, result.Details)
+	}
+}
+
+func TestOrphanedHeartbeatCheck_ListErrorFlagsNothing(t *testing.T) {
+	townRoot := t.TempDir()
+	polecat.TouchSessionHeartbeat(townRoot, "gt-dead")
+
+	check := NewOrphanedHeartbeatCheckWithSessionLister(&mockSessionLister{
+		err: errors.New("no server running"),
+	})
+	ctx := &CheckContext{TownRoot: townRoot}
+
+	if result := check.Run(ctx); result.Status != StatusWarning {
+		t.Fatalf("Status = %v, want Warning", result.Status)
+	}
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(townRoot, ".runtime", "heartbeats", "gt-dead.json")); err != nil {
+		t.Errorf("heartbeat removed despite session list error: %v", err)
+	}
+}
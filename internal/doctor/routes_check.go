@@ -309,8 +309,8 @@ func (c *RoutesCheck) Fix(ctx *CheckContext) error {
 		return fmt.Errorf(".beads directory does not exist; run 'bd init' first")
 	}
 
-	// Load existing routes
-	routes, err := beads.LoadRoutes(beadsDir)
+	// Load existing routes (duplicate prefixes are dropped, keeping the first)
+	routes, err := beads.ReadRoutes(beadsDir)
 	if err != nil {
 		routes = []beads.Route{} // Start fresh if can't load
 	}
@@ -99,3 +99,9 @@ func (c *TmuxGlobalEnvCheck) Fix(ctx *CheckContext) error {
 	}
 	return accessor.SetGlobalEnvironment("GT_TOWN_ROOT", ctx.TownRoot)
 }
+
+// Idempotent reports that Fix can be re-applied: it always sets GT_TOWN_ROOT
+// to the town root.
+func (c *TmuxGlobalEnvCheck) Idempotent() bool {
+	return true
+}
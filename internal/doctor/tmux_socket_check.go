@@ -144,6 +144,8 @@ func (c *SocketSplitBrainCheck) Fix(ctx *CheckContext) error {
 	if c.defaultListerForTest != nil {
 		defaultLister = c.defaultListerForTest
 	}
+	// A session whose processes survive the kill must not stop cleanup of
+	// the rest; report the last failure once every session has been tried.
 	var lastErr error
 
 	for _, s := range c.staleSessions {
@@ -266,3 +266,19 @@ func TestSocketSplitBrainCheck_Fix_KillsStale(t *testing.T) {
 		t.Errorf("unexpected kill order: %v", mock.killed)
 	}
 }
+
+func TestSocketSplitBrainCheck_Fix_ContinuesPastKillFailure(t *testing.T) {
+	check := NewSocketSplitBrainCheck()
+	check.staleSessions = []string{"ga-refinery", "ga-witness"}
+
+	mock := &mockSocketLister{killErr: fmt.Errorf("killing session ga-refinery: processes survived SIGKILL")}
+	check.defaultListerForTest = mock
+
+	ctx := &CheckContext{TownRoot: t.TempDir()}
+	if err := check.Fix(ctx); err == nil {
+		t.Fatal("Fix() returned nil, want the kill error")
+	}
+	if len(mock.killed) != 2 {
+		t.Errorf("expected every stale session to be attempted, got %v", mock.killed)
+	}
+}
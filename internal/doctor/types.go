@@ -32,6 +32,7 @@ var CategoryOrder = []string{
 }
 
 // CheckStatus represents the result status of a health check.
+// Statuses are ordered by severity: OK < Warning < Error.
 type CheckStatus int
 
 const (
@@ -57,6 +58,35 @@ func (s CheckStatus) String() string {
 	}
 }
 
+// Severity ranks the status for comparison: 0 for OK, 1 for Warning, and 2
+// for Error. Unknown statuses rank as errors so they never pass silently.
+func (s CheckStatus) Severity() int {
+	switch s {
+	case StatusOK:
+		return 0
+	case StatusWarning:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// WorstStatus returns the most severe status among results, or StatusOK if
+// there are none. Nil results are ignored and unknown statuses count as
+// StatusError.
+func WorstStatus(results []*CheckResult) CheckStatus {
+	worst := StatusOK
+	for _, r := range results {
+		if r != nil && r.Status.Severity() > worst.Severity() {
+			worst = r.Status
+		}
+	}
+	if worst.Severity() >= StatusError.Severity() {
+		return StatusError
+	}
+	return worst
+}
+
 // CheckContext provides context for running checks.
 type CheckContext struct {
 	TownRoot        string // Root directory of the Gas Town workspace
@@ -126,6 +156,7 @@ type Report struct {
 	Timestamp time.Time
 	Checks    []*CheckResult
 	Summary   ReportSummary
+	FixLog    []FixLogEntry // Fix attempts in the order they ran (--fix only)
 }
 
 // NewReport creates an empty report with the current timestamp.
@@ -177,6 +208,31 @@ func (r *Report) IsHealthy() bool {
 	return r.Summary.Errors == 0 && r.Summary.Warnings == 0
 }
 
+// Exit codes returned by gt doctor. Warnings do not fail a run unless the
+// caller asks for them to (gt doctor --warnings-as-errors).
+const (
+	ExitOK       = 0 // No errors; warnings allowed
+	ExitErrors   = 1 // At least one check reported an error, or a fix failed
+	ExitWarnings = 2 // Warnings only, and warnings are treated as errors
+)
+
+// ExitCode maps the report to the gt doctor exit code contract. Errors and
+// failed fixes take precedence over warnings.
+func (r *Report) ExitCode(warningsAsErrors bool) int {
+	if r.HasFixFailures() {
+		return ExitErrors
+	}
+	switch WorstStatus(r.Checks) {
+	case StatusError:
+		return ExitErrors
+	case StatusWarning:
+		if warningsAsErrors {
+			return ExitWarnings
+		}
+	}
+	return ExitOK
+}
+
 // PrintSummaryOnly outputs just the summary and warnings section.
 // Used after streaming output where checks were already printed as they ran.
 // Slow checks are already counted during streaming, so slowThreshold is only
@@ -211,7 +267,8 @@ func (r *Report) PrintSummaryOnly(w io.Writer, verbose bool, slowThreshold time.
 
 // Print outputs the report to the given writer.
 // Matches bd doctor UX: grouped by category, semantic icons, warnings section.
-// If slowThreshold > 0, displays elapsed time for checks exceeding the threshold.
+// If slowThreshold > 0, displays elapsed time for checks exceeding the threshold;
+// verbose displays it for every check.
 func (r *Report) Print(w io.Writer, verbose bool, slowThreshold time.Duration) {
 	// Print header with version placeholder (caller should set via PrintWithVersion)
 	_, _ = fmt.Fprintln(w)
@@ -287,7 +344,7 @@ func (r *Report) printCheck(w io.Writer, check *CheckResult, verbose bool, slowT
 		r.Summary.Slow++ // Count slow checks during print
 	}
 
-	// Print check line: icon + name + muted message + optional timing
+	// Print check line: icon + name + muted message + timing (slow or verbose)
 	// For slow checks, hourglass replaces spaces to maintain alignment
 	slowIndicator := "  "
 	if isSlow {
@@ -297,7 +354,7 @@ func (r *Report) printCheck(w io.Writer, check *CheckResult, verbose bool, slowT
 	if check.Message != "" {
 		_, _ = fmt.Fprintf(w, "%s", ui.RenderMuted(" "+check.Message))
 	}
-	if isSlow {
+	if isSlow || verbose {
 		_, _ = fmt.Fprintf(w, "%s", ui.RenderMuted(" ("+formatDuration(check.Elapsed)+")"))
 	}
 	_, _ = fmt.Fprintln(w)
@@ -311,8 +368,11 @@ func (r *Report) printCheck(w io.Writer, check *CheckResult, verbose bool, slowT
 }
 
 // formatDuration formats a duration in a human-readable way.
-// Examples: "1.2s", "45s", "1m 30s", "2h 5m"
+// Examples: "12ms", "1.2s", "45s", "1m 30s", "2h 5m"
 func formatDuration(d time.Duration) string {
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
 	if d < time.Minute {
 		return fmt.Sprintf("%.1fs", d.Seconds())
 	}
@@ -65,6 +65,8 @@ func TestZombieSessionCheck_FixProtectsCrewSessions(t *testing.T) {
 	}
 
 	ctx := &CheckContext{TownRoot: t.TempDir()}
+	// Fix logs a feed event relative to cwd; keep it out of the source tree.
+	t.Chdir(ctx.TownRoot)
 
 	// Fix should skip crew sessions due to safeguard
 	// (We can't fully test this without mocking tmux, but the safeguard is in place)
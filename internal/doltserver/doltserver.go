@@ -33,6 +33,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
 	"net"
 	"os"
 	"os/exec"
@@ -3045,7 +3046,7 @@ func FindOrphanedDatabases(townRoot string) ([]OrphanedDatabase, error) {
 			continue
 		}
 		dbPath := filepath.Join(config.DataDir, dbName)
-		size := dirSize(dbPath)
+		size, _ := dirSize(dbPath) // partial size is fine for reporting
 		orphans = append(orphans, OrphanedDatabase{
 			Name:      dbName,
 			Path:      dbPath,
@@ -3288,7 +3289,11 @@ func RemoveDatabase(townRoot, dbName string, force bool) error {
 			// Server is down — check via filesystem size as a safety proxy. (gt-xvh)
 			// Databases with >1MB of data are almost certainly not empty orphans.
 			// Without the server, we can't query tables, so size is the best heuristic.
-			size := dirSize(dbPath)
+			size, sizeErr := dirSize(dbPath)
+			if sizeErr != nil && !errors.Is(sizeErr, fs.ErrNotExist) {
+				return fmt.Errorf("database %q could not be fully sized (server offline, cannot verify contents): %w — start server or use --force to remove",
+					dbName, sizeErr)
+			}
 			const safeRemoveThreshold = 1 << 20 // 1MB
 			if size > safeRemoveThreshold {
 				return fmt.Errorf("database %q has %s of data (server offline, cannot verify contents) — start server or use --force to remove",
@@ -4016,7 +4021,11 @@ func GetHealthMetrics(townRoot string) *HealthMetrics {
 	}
 
 	// 3. Disk usage
-	diskBytes := dirSize(config.DataDir)
+	diskBytes, err := dirSize(config.DataDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		metrics.Warnings = append(metrics.Warnings,
+			fmt.Sprintf("disk usage is a lower bound; some files could not be read: %v", err))
+	}
 	metrics.DiskUsageBytes = diskBytes
 	metrics.DiskUsageHuman = formatBytes(diskBytes)
 
@@ -4274,19 +4283,83 @@ func GetLastCommitAge(townRoot string) (time.Duration, string, error) {
 	return time.Since(mostRecent), mostRecentDB, nil
 }
 
-// dirSize returns the total size of a directory tree in bytes.
-func dirSize(path string) int64 {
+// dirSizeWorkers bounds how many top-level subdirectories dirSize walks
+// concurrently. Dolt data dirs have one subdirectory per database, so this
+// is where large trees fan out.
+var dirSizeWorkers = min(runtime.NumCPU(), 8)
+
+// dirSize returns the total size of the regular files under path in bytes.
+// Symlinks are neither followed nor counted, so a link cycle cannot hang the
+// walk. Unreadable entries are skipped: the size of everything readable is
+// returned together with an error describing what was skipped.
+func dirSize(path string) (int64, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		total int64
+		errs  []error
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		sem   = make(chan struct{}, dirSizeWorkers)
+	)
+	for _, entry := range entries {
+		child := filepath.Join(path, entry.Name())
+		switch {
+		case entry.Type()&os.ModeSymlink != 0:
+			continue
+		case entry.IsDir():
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				size, err := walkDirSize(child)
+				mu.Lock()
+				total += size
+				if err != nil {
+					errs = append(errs, err)
+				}
+				mu.Unlock()
+			}()
+		case entry.Type().IsRegular():
+			info, err := entry.Info()
+			mu.Lock()
+			if err != nil {
+				errs = append(errs, err)
+			} else {
+				total += info.Size()
+			}
+			mu.Unlock()
+		}
+	}
+	wg.Wait()
+	return total, errors.Join(errs...)
+}
+
+// walkDirSize sums regular files under root without following symlinks.
+func walkDirSize(root string) (int64, error) {
 	var total int64
-	_ = filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+	var errs []error
+	_ = filepath.WalkDir(root, func(_ string, d fs.DirEntry, err error) error {
 		if err != nil {
-			return nil // skip errors
+			errs = append(errs, err)
+			return nil // skip the unreadable entry, keep walking
+		}
+		if !d.Type().IsRegular() {
+			return nil
 		}
-		if !info.IsDir() {
-			total += info.Size()
+		info, err := d.Info()
+		if err != nil {
+			errs = append(errs, err)
+			return nil
 		}
+		total += info.Size()
 		return nil
 	})
-	return total
+	return total, errors.Join(errs...)
 }
 
 // formatBytes returns a human-readable size string.
@@ -3,8 +3,10 @@ package doltserver
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net"
 	"os"
 	"os/exec"
@@ -60,7 +62,10 @@ func TestDirSize(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	size := dirSize(tmpDir)
+	size, err := dirSize(tmpDir)
+	if err != nil {
+		t.Fatalf("dirSize: %v", err)
+	}
 	if size != 300 {
 		t.Errorf("dirSize = %d, want 300", size)
 	}
@@ -68,16 +73,110 @@ func TestDirSize(t *testing.T) {
 
 func TestDirSize_EmptyDir(t *testing.T) {
 	tmpDir := t.TempDir()
-	size := dirSize(tmpDir)
-	if size != 0 {
-		t.Errorf("dirSize of empty dir = %d, want 0", size)
+	size, err := dirSize(tmpDir)
+	if err != nil || size != 0 {
+		t.Errorf("dirSize of empty dir = %d, %v; want 0, nil", size, err)
 	}
 }
 
 func TestDirSize_NonexistentDir(t *testing.T) {
-	size := dirSize("/nonexistent/path/that/does/not/exist")
-	if size != 0 {
-		t.Errorf("dirSize of nonexistent dir = %d, want 0", size)
+	size, err := dirSize("/nonexistent/path/that/does/not/exist")
+	if size != 0 || !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("dirSize of nonexistent dir = %d, %v; want 0, not-exist error", size, err)
+	}
+}
+
+func TestDirSize_NestedSubdirs(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]int{
+		"top.bin":              10,
+		"db1/a.bin":            100,
+		"db1/.dolt/noms/b.bin": 1000,
+		"db2/c.bin":            20,
+		"db2/x/y/z/d.bin":      3,
+	}
+	for rel, n := range files {
+		p := filepath.Join(tmpDir, rel)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, make([]byte, n), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	size, err := dirSize(tmpDir)
+	if err != nil {
+		t.Fatalf("dirSize: %v", err)
+	}
+	if size != 1133 {
+		t.Errorf("dirSize = %d, want 1133", size)
+	}
+}
+
+func TestDirSize_SymlinkLoop(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks require privileges on Windows")
+	}
+	tmpDir := t.TempDir()
+	sub := filepath.Join(tmpDir, "sub")
+	if err := os.MkdirAll(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(sub, "f.bin"), make([]byte, 50), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// sub/loop -> .. and top-level loop -> . form cycles if followed.
+	if err := os.Symlink("..", filepath.Join(sub, "loop")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(".", filepath.Join(tmpDir, "loop")); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	var size int64
+	var err error
+	go func() {
+		size, err = dirSize(tmpDir)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("dirSize did not return on a symlink loop")
+	}
+	if err != nil || size != 50 {
+		t.Errorf("dirSize = %d, %v; want 50, nil", size, err)
+	}
+}
+
+func TestDirSize_UnreadableSubdirReturnsPartial(t *testing.T) {
+	if runtime.GOOS == "windows" || os.Geteuid() == 0 {
+		t.Skip("requires POSIX permissions enforced for a non-root user")
+	}
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "ok.bin"), make([]byte, 40), 0644); err != nil {
+		t.Fatal(err)
+	}
+	locked := filepath.Join(tmpDir, "locked")
+	if err := os.MkdirAll(locked, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(locked, "hidden.bin"), make([]byte, 500), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(locked, 0); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chmod(locked, 0755) })
+
+	size, err := dirSize(tmpDir)
+	if err == nil {
+		t.Error("expected an error for the unreadable subdirectory")
+	}
+	if size != 40 {
+		t.Errorf("dirSize = %d, want partial size 40", size)
 	}
 }
 
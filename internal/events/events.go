@@ -74,6 +74,9 @@ const (
 	TypeSchedulerDispatch       = "scheduler_dispatch"        // Bead dispatched from scheduler
 	TypeSchedulerDispatchFailed = "scheduler_dispatch_failed" // Bead dispatch failed (requeued)
 	TypeSchedulerCloseRetry     = "scheduler_close_retry"     // Context close needed last-resort attempt
+
+	// Health events
+	TypeDoctorRun = "doctor_run" // Summary of a gt doctor run (--emit-events)
 )
 
 // EventsFile is the name of the raw events log.
@@ -368,3 +371,25 @@ func SchedulerDispatchFailedPayload(beadID, rig, errMsg string) map[string]inter
 		"error": errMsg,
 	}
 }
+
+// DoctorRunPayload creates a payload for doctor run summary events.
+// status: worst check status, as in gt doctor --json ("OK", "Warning", "Error")
+// failing: names of checks that reported errors
+// warned: names of checks that reported warnings
+// fix: whether the run was gt doctor --fix
+func DoctorRunPayload(status string, total int, failing, warned []string, fix bool) map[string]interface{} {
+	p := map[string]interface{}{
+		"status":   status,
+		"total":    total,
+		"errors":   len(failing),
+		"warnings": len(warned),
+		"fix":      fix,
+	}
+	if len(failing) > 0 {
+		p["failing"] = failing
+	}
+	if len(warned) > 0 {
+		p["warned"] = warned
+	}
+	return p
+}
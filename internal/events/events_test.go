@@ -257,3 +257,24 @@ func TestSessionPayload_Minimal(t *testing.T) {
 		t.Error("expected no cwd key when empty")
 	}
 }
+
+func TestDoctorRunPayload(t *testing.T) {
+	p := DoctorRunPayload("Error", 12, []string{"daemon"}, []string{"env-vars", "wisp-gc"}, true)
+	if p["status"] != "Error" || p["total"] != 12 || p["fix"] != true {
+		t.Errorf("unexpected payload: %v", p)
+	}
+	if p["errors"] != 1 || p["warnings"] != 2 {
+		t.Errorf("counts = %v/%v, want 1/2", p["errors"], p["warnings"])
+	}
+	if failing, ok := p["failing"].([]string); !ok || len(failing) != 1 || failing[0] != "daemon" {
+		t.Errorf("failing = %v, want [daemon]", p["failing"])
+	}
+
+	clean := DoctorRunPayload("OK", 12, nil, nil, false)
+	if _, ok := clean["failing"]; ok {
+		t.Error("clean payload should omit failing")
+	}
+	if _, ok := clean["warned"]; ok {
+		t.Error("clean payload should omit warned")
+	}
+}
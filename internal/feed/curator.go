@@ -17,6 +17,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -538,6 +539,27 @@ func (c *Curator) generateSummary(event *events.Event) string {
 		}
 		return "Multiple sessions died simultaneously"
 
+	case events.TypeDoctorRun:
+		status, _ := event.Payload["status"].(string)
+		failing, _ := event.Payload["failing"].([]interface{})
+		warnings, _ := event.Payload["warnings"].(float64) // JSON numbers are float64
+		if len(failing) > 0 {
+			names := make([]string, 0, len(failing))
+			for _, f := range failing {
+				if name, ok := f.(string); ok {
+					names = append(names, name)
+				}
+			}
+			return fmt.Sprintf("Doctor: %d check(s) failing: %s", len(failing), strings.Join(names, ", "))
+		}
+		if warnings > 0 {
+			return fmt.Sprintf("Doctor: %d warning(s)", int(warnings))
+		}
+		if status != "" {
+			return fmt.Sprintf("Doctor: %s", status)
+		}
+		return "Doctor run"
+
 	default:
 		return fmt.Sprintf("%s: %s", event.Actor, event.Type)
 	}
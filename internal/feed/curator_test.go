@@ -372,6 +372,34 @@ func TestCurator_GeneratesSummary(t *testing.T) {
 			},
 			expected: "gastown/witness handed off to fresh session",
 		},
+		{
+			// Payload as decoded from JSON: numbers are float64, lists []interface{}.
+			event: &events.Event{
+				Type:  events.TypeDoctorRun,
+				Actor: "gt",
+				Payload: map[string]interface{}{
+					"status": "Error", "errors": float64(2), "warnings": float64(1),
+					"failing": []interface{}{"daemon", "town-git"},
+				},
+			},
+			expected: "Doctor: 2 check(s) failing: daemon, town-git",
+		},
+		{
+			event: &events.Event{
+				Type:    events.TypeDoctorRun,
+				Actor:   "gt",
+				Payload: map[string]interface{}{"status": "Warning", "warnings": float64(3)},
+			},
+			expected: "Doctor: 3 warning(s)",
+		},
+		{
+			event: &events.Event{
+				Type:    events.TypeDoctorRun,
+				Actor:   "gt",
+				Payload: map[string]interface{}{"status": "OK", "warnings": float64(0)},
+			},
+			expected: "Doctor: OK",
+		},
 	}
 
 	for _, tc := range tests {
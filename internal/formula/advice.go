@@ -0,0 +1,173 @@
+package formula
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Advice injects steps around matching steps of a formula that composes the
+// aspect (compose.aspects). It is declared in aspect formulas as [[advice]].
+type Advice struct {
+	// Target is a step ID or path.Match glob (e.g. "implement", "*.review").
+	Target string `toml:"target"`
+
+	// Order ranks this advice against other advice on the same step: before
+	// steps run in ascending order ahead of the step, after steps in ascending
+	// order behind it. Equal orders (including the default 0) keep
+	// declaration order: compose.aspects order, then [[advice]] order.
+	Order int `toml:"order"`
+
+	Around *AroundAdvice `toml:"around"`
+}
+
+// AroundAdvice lists the steps injected before and after a target step.
+type AroundAdvice struct {
+	Before []AdviceStep `toml:"before"`
+	After  []AdviceStep `toml:"after"`
+}
+
+// AdviceStep is a step template injected by advice. {step.id} and
+// {step.title} expand to the target step's values.
+type AdviceStep struct {
+	ID          string `toml:"id"`
+	Title       string `toml:"title"`
+	Description string `toml:"description"`
+}
+
+// validate checks that the advice has a usable target and order.
+func (a *Advice) validate() error {
+	if a.Target == "" {
+		return fmt.Errorf("advice missing required target field")
+	}
+	if _, err := path.Match(a.Target, ""); err != nil {
+		return fmt.Errorf("advice target %q: %w", a.Target, err)
+	}
+	if a.Order < 0 {
+		return fmt.Errorf("advice on %q has negative order %d", a.Target, a.Order)
+	}
+	if a.Around == nil {
+		return nil
+	}
+	for _, s := range append(append([]AdviceStep(nil), a.Around.Before...), a.Around.After...) {
+		if s.ID == "" {
+			return fmt.Errorf("advice on %q has a step missing required id field", a.Target)
+		}
+	}
+	return nil
+}
+
+// matches reports whether the advice targets stepID.
+func (a *Advice) matches(stepID string) bool {
+	ok, _ := path.Match(a.Target, stepID)
+	return ok
+}
+
+// adviceFor returns the advice from aspects that targets stepID, sorted by
+// Order with declaration order breaking ties.
+func adviceFor(aspects []*Formula, stepID string) []Advice {
+	var matched []Advice
+	for _, aspect := range aspects {
+		for _, a := range aspect.Advice {
+			if a.Around != nil && a.matches(stepID) {
+				matched = append(matched, a)
+			}
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].Order < matched[j].Order })
+	return matched
+}
+
+// applyAspects weaves the advice of the named aspect formulas into steps.
+// For each step with matching advice, the before steps form a chain that
+// inherits the step's needs and ends in the step; the after steps form a chain
+// that starts from the step, and dependents of the step are rewired to the
+// end of that chain.
+func applyAspects(steps []Step, names []string, searchPaths []string) ([]Step, error) {
+	aspects := make([]*Formula, 0, len(names))
+	for _, name := range names {
+		aspect, err := loadFormulaByName(name, searchPaths)
+		if err != nil {
+			return nil, fmt.Errorf("aspect %q: %w", name, err)
+		}
+		if aspect.Type != TypeAspect {
+			return nil, fmt.Errorf("formula %q is type %q, want %q", name, aspect.Type, TypeAspect)
+		}
+		aspects = append(aspects, aspect)
+	}
+
+	// tail maps an advised step ID to the last step of its after chain, for
+	// rewiring dependents. afterSteps marks injected after steps, whose needs
+	// already point into their own chain.
+	tail := make(map[string]string)
+	afterSteps := make(map[string]bool)
+	result := make([]Step, 0, len(steps))
+	for _, step := range steps {
+		advice := adviceFor(aspects, step.ID)
+		if len(advice) == 0 {
+			result = append(result, step)
+			continue
+		}
+
+		prev := append([]string(nil), step.Needs...)
+		for _, a := range advice {
+			for _, tmpl := range a.Around.Before {
+				injected := expandAdviceStep(tmpl, step)
+				injected.Needs = prev
+				result = append(result, injected)
+				prev = []string{injected.ID}
+			}
+		}
+		step.Needs = prev
+		result = append(result, step)
+
+		last := step.ID
+		for _, a := range advice {
+			for _, tmpl := range a.Around.After {
+				injected := expandAdviceStep(tmpl, step)
+				injected.Needs = []string{last}
+				result = append(result, injected)
+				afterSteps[injected.ID] = true
+				last = injected.ID
+			}
+		}
+		if last != step.ID {
+			tail[step.ID] = last
+		}
+	}
+
+	// Steps that needed an advised step now need the end of its after chain.
+	for i := range result {
+		if afterSteps[result[i].ID] {
+			continue
+		}
+		var needs []string
+		for j, need := range result[i].Needs {
+			if last, ok := tail[need]; ok {
+				if needs == nil {
+					needs = append([]string(nil), result[i].Needs...)
+				}
+				needs[j] = last
+			}
+		}
+		if needs != nil {
+			result[i].Needs = needs
+		}
+	}
+	return result, nil
+}
+
+// expandAdviceStep instantiates an advice step template for target.
+func expandAdviceStep(tmpl AdviceStep, target Step) Step {
+	expand := func(s string) string {
+		s = strings.ReplaceAll(s, "{step.id}", target.ID)
+		s = strings.ReplaceAll(s, "{step.title}", target.Title)
+		return s
+	}
+	return Step{
+		ID:          expand(tmpl.ID),
+		Title:       expand(tmpl.Title),
+		Description: expand(tmpl.Description),
+	}
+}
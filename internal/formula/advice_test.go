@@ -0,0 +1,151 @@
+package formula
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeAspect writes an aspect formula named name whose advice on "build"
+// injects {name}-pre before and {name}-post after it. order "" omits the field.
+func writeAspect(t *testing.T, dir, name, order string) {
+	t.Helper()
+	content := `formula = "` + name + `"
+type = "aspect"
+version = 1
+
+[[advice]]
+target = "build"
+`
+	if order != "" {
+		content += "order = " + order + "\n"
+	}
+	content += `[advice.around]
+
+[[advice.around.before]]
+id = "{step.id}-` + name + `-pre"
+title = "` + name + ` before {step.title}"
+
+[[advice.around.after]]
+id = "{step.id}-` + name + `-post"
+title = "` + name + ` after {step.title}"
+`
+	if err := os.WriteFile(filepath.Join(dir, name+".formula.toml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// resolveWithAspects resolves a three-step workflow composing aspects.
+func resolveWithAspects(t *testing.T, dir string, aspects ...string) *Formula {
+	t.Helper()
+	f := mustParse(t, `formula = "pipeline"
+type = "workflow"
+version = 1
+
+[[steps]]
+id = "fetch"
+title = "Fetch"
+
+[[steps]]
+id = "build"
+title = "Build"
+needs = ["fetch"]
+
+[[steps]]
+id = "ship"
+title = "Ship"
+needs = ["build"]
+
+[compose]
+aspects = ["`+strings.Join(aspects, `", "`)+`"]
+`)
+	resolved, err := Resolve(f, []string{dir})
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	return resolved
+}
+
+func TestApplyAspects_OrderControlsInjection(t *testing.T) {
+	dir := t.TempDir()
+	writeAspect(t, dir, "lint", "2")
+	writeAspect(t, dir, "security", "1")
+
+	// lint is composed first, but security's lower order puts its advice
+	// closest to the start of the chain on both sides.
+	resolved := resolveWithAspects(t, dir, "lint", "security")
+
+	want := []string{
+		"fetch",
+		"build-security-pre", "build-lint-pre", "build",
+		"build-security-post", "build-lint-post",
+		"ship",
+	}
+	if got := stepIDs(resolved); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("steps = %v, want %v", got, want)
+	}
+
+	wantNeeds := map[string]string{
+		"build-security-pre":  "fetch",
+		"build-lint-pre":      "build-security-pre",
+		"build":               "build-lint-pre",
+		"build-security-post": "build",
+		"build-lint-post":     "build-security-post",
+		"ship":                "build-lint-post",
+	}
+	for id, want := range wantNeeds {
+		if got := strings.Join(resolved.GetStep(id).Needs, ","); got != want {
+			t.Errorf("%s.Needs = %q, want %q", id, got, want)
+		}
+	}
+	if title := resolved.GetStep("build-lint-pre").Title; title != "lint before Build" {
+		t.Errorf("title = %q, want placeholders expanded", title)
+	}
+}
+
+func TestApplyAspects_DeclarationOrderWithoutOrder(t *testing.T) {
+	dir := t.TempDir()
+	writeAspect(t, dir, "lint", "")
+	writeAspect(t, dir, "security", "")
+
+	for _, aspects := range [][]string{{"lint", "security"}, {"security", "lint"}} {
+		first, second := aspects[0], aspects[1]
+		want := []string{
+			"fetch",
+			"build-" + first + "-pre", "build-" + second + "-pre", "build",
+			"build-" + first + "-post", "build-" + second + "-post",
+			"ship",
+		}
+		if got := stepIDs(resolveWithAspects(t, dir, aspects...)); strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("aspects %v: steps = %v, want %v", aspects, got, want)
+		}
+	}
+}
+
+func TestParseAdvice_NegativeOrderRejected(t *testing.T) {
+	_, err := Parse([]byte(`formula = "bad-aspect"
+type = "aspect"
+
+[[advice]]
+target = "build"
+order = -1
+[advice.around]
+[[advice.around.before]]
+id = "{step.id}-pre"
+`))
+	if err == nil || !strings.Contains(err.Error(), "negative order") {
+		t.Fatalf("Parse error = %v, want negative order rejected", err)
+	}
+}
+
+func TestApplyAspects_RejectsNonAspectFormula(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "plain.formula.toml"), []byte("formula = \"plain\"\n[[steps]]\nid = \"s\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f := mustParse(t, "formula = \"pipeline\"\n[[steps]]\nid = \"build\"\n[compose]\naspects = [\"plain\"]\n")
+	if _, err := Resolve(f, []string{dir}); err == nil || !strings.Contains(err.Error(), `want "aspect"`) {
+		t.Fatalf("Resolve error = %v, want non-aspect rejected", err)
+	}
+}
@@ -0,0 +1,133 @@
+package formula
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/steveyegge/gastown/internal/suggest"
+)
+
+// StepDeps is the transitive dependency closure of one step.
+type StepDeps struct {
+	Step string `json:"step"`
+
+	// Upstream lists every step the step transitively needs, in topological
+	// order.
+	Upstream []string `json:"upstream"`
+
+	// Downstream lists every step that transitively needs the step, in
+	// topological order.
+	Downstream []string `json:"downstream"`
+}
+
+// StepDependencies returns the transitive needs of step id and the steps that
+// transitively depend on it, both in TopologicalSort order. For convoy and
+// aspect formulas, whose legs are parallel, both lists are empty.
+func (f *Formula) StepDependencies(id string) (*StepDeps, error) {
+	items, deps, err := f.dependencyGraph()
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, item := range items {
+		if item == id {
+			found = true
+			break
+		}
+	}
+	if !found {
+		msg := fmt.Sprintf("step %q not found in formula %q", id, f.Name)
+		if match := suggest.Closest(id, items, 2); match != "" {
+			msg += fmt.Sprintf("; did you mean %q?", match)
+		}
+		return nil, errors.New(msg)
+	}
+
+	order, err := f.TopologicalSort()
+	if err != nil {
+		return nil, err
+	}
+
+	dependents := make(map[string][]string)
+	for _, item := range items {
+		for _, dep := range deps[item] {
+			dependents[dep] = append(dependents[dep], item)
+		}
+	}
+	upstream := reachable(id, deps)
+	downstream := reachable(id, dependents)
+
+	result := &StepDeps{Step: id, Upstream: []string{}, Downstream: []string{}}
+	for _, item := range order {
+		if upstream[item] {
+			result.Upstream = append(result.Upstream, item)
+		}
+		if downstream[item] {
+			result.Downstream = append(result.Downstream, item)
+		}
+	}
+	return result, nil
+}
+
+// reachable returns the IDs reachable from id along edges, excluding id.
+func reachable(id string, edges map[string][]string) map[string]bool {
+	seen := make(map[string]bool)
+	stack := append([]string(nil), edges[id]...)
+	for len(stack) > 0 {
+		next := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[next] || next == id {
+			continue
+		}
+		seen[next] = true
+		stack = append(stack, edges[next]...)
+	}
+	return seen
+}
+
+// CriticalPath returns the longest dependency chain through the formula, from
+// a step with no needs to its final step. Each step currently weighs 1, so
+// this is the chain with the most steps. Ties go to the step first in
+// TopologicalSort order and, within a step, to its first-listed need. For
+// convoy and aspect formulas, whose legs are parallel, the path is the first
+// leg alone.
+func (f *Formula) CriticalPath() ([]string, error) {
+	_, deps, err := f.dependencyGraph()
+	if err != nil {
+		return nil, err
+	}
+	order, err := f.TopologicalSort()
+	if err != nil {
+		return nil, err
+	}
+
+	// length is the weight of the heaviest chain ending at each step; prev is
+	// the step before it on that chain.
+	length := make(map[string]int, len(order))
+	prev := make(map[string]string, len(order))
+	end := ""
+	for _, id := range order {
+		best, from := 0, ""
+		for _, dep := range deps[id] {
+			if length[dep] > best {
+				best, from = length[dep], dep
+			}
+		}
+		length[id] = best + 1 // Per-step weight; step durations would go here.
+		prev[id] = from
+		if end == "" || length[id] > length[end] {
+			end = id
+		}
+	}
+	if end == "" {
+		return nil, nil
+	}
+
+	var path []string
+	for id := end; id != ""; id = prev[id] {
+		path = append(path, id)
+	}
+	slices.Reverse(path)
+	return path, nil
+}
@@ -0,0 +1,138 @@
+package formula
+
+import (
+	"strings"
+	"testing"
+)
+
+const depsTestFormula = `
+formula = "deps-test"
+type = "workflow"
+version = 1
+
+[[steps]]
+id = "design"
+
+[[steps]]
+id = "schema"
+
+[[steps]]
+id = "implement"
+needs = ["design", "schema"]
+
+[[steps]]
+id = "docs"
+needs = ["design"]
+
+[[steps]]
+id = "review"
+needs = ["implement"]
+
+[[steps]]
+id = "submit"
+needs = ["review", "docs"]
+`
+
+func TestStepDependencies(t *testing.T) {
+	f := mustParse(t, depsTestFormula)
+
+	tests := []struct {
+		step       string
+		upstream   []string
+		downstream []string
+	}{
+		{"implement", []string{"design", "schema"}, []string{"review", "submit"}},
+		{"design", nil, []string{"docs", "implement", "review", "submit"}},
+		{"submit", []string{"design", "schema", "docs", "implement", "review"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.step, func(t *testing.T) {
+			d, err := f.StepDependencies(tt.step)
+			if err != nil {
+				t.Fatalf("StepDependencies: %v", err)
+			}
+			if got, want := strings.Join(d.Upstream, ","), strings.Join(tt.upstream, ","); got != want {
+				t.Errorf("Upstream = %v, want %v", d.Upstream, tt.upstream)
+			}
+			if got, want := strings.Join(d.Downstream, ","), strings.Join(tt.downstream, ","); got != want {
+				t.Errorf("Downstream = %v, want %v", d.Downstream, tt.downstream)
+			}
+		})
+	}
+}
+
+func TestStepDependencies_UnknownStep(t *testing.T) {
+	f := mustParse(t, depsTestFormula)
+
+	_, err := f.StepDependencies("implemnt")
+	if err == nil {
+		t.Fatal("expected error for unknown step")
+	}
+	if !strings.Contains(err.Error(), `did you mean "implement"?`) {
+		t.Errorf("error = %v, want suggestion for implement", err)
+	}
+}
+
+func TestCriticalPath_Diamond(t *testing.T) {
+	// start fans out to a short branch (quick) and a long one (build → test);
+	// both rejoin at ship. The critical path is the long branch.
+	f := mustParse(t, `
+formula = "diamond"
+type = "workflow"
+version = 1
+
+[[steps]]
+id = "start"
+
+[[steps]]
+id = "quick"
+needs = ["start"]
+
+[[steps]]
+id = "build"
+needs = ["start"]
+
+[[steps]]
+id = "test"
+needs = ["build"]
+
+[[steps]]
+id = "ship"
+needs = ["quick", "test"]
+`)
+
+	path, err := f.CriticalPath()
+	if err != nil {
+		t.Fatalf("CriticalPath: %v", err)
+	}
+	if got, want := strings.Join(path, ","), "start,build,test,ship"; got != want {
+		t.Errorf("CriticalPath = %v, want %s", path, want)
+	}
+}
+
+func TestCriticalPath_LinearChain(t *testing.T) {
+	f := mustParse(t, `
+formula = "chain"
+type = "workflow"
+version = 1
+
+[[steps]]
+id = "a"
+
+[[steps]]
+id = "b"
+needs = ["a"]
+
+[[steps]]
+id = "c"
+needs = ["b"]
+`)
+
+	path, err := f.CriticalPath()
+	if err != nil {
+		t.Fatalf("CriticalPath: %v", err)
+	}
+	if got, want := strings.Join(path, ","), "a,b,c"; got != want {
+		t.Errorf("CriticalPath = %v, want %s", path, want)
+	}
+}
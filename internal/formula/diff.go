@@ -0,0 +1,131 @@
+package formula
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Edge is a dependency edge: Step needs Needs.
+type Edge struct {
+	Step  string `json:"step"`
+	Needs string `json:"needs"`
+}
+
+// StepChange describes a step present in both formulas whose definition
+// differs. Fields lists the changed field names (dependencies are reported
+// separately as edges).
+type StepChange struct {
+	ID     string   `json:"id"`
+	Fields []string `json:"fields"`
+	Before Step     `json:"before"`
+	After  Step     `json:"after"`
+}
+
+// Diff is the step-level difference between two workflow formulas, with
+// steps aligned by ID.
+type Diff struct {
+	From         string       `json:"from"`
+	To           string       `json:"to"`
+	Added        []Step       `json:"added,omitempty"`
+	Removed      []Step       `json:"removed,omitempty"`
+	Changed      []StepChange `json:"changed,omitempty"`
+	AddedEdges   []Edge       `json:"added_edges,omitempty"`
+	RemovedEdges []Edge       `json:"removed_edges,omitempty"`
+}
+
+// Empty reports whether the two formulas have identical steps and edges.
+func (d *Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 &&
+		len(d.AddedEdges) == 0 && len(d.RemovedEdges) == 0
+}
+
+// DiffSteps compares the steps of a and b. Callers typically pass formulas
+// already passed through Resolve so that extends/compose are applied.
+// Added and changed steps follow b's step order; removed steps follow a's.
+func DiffSteps(a, b *Formula) *Diff {
+	d := &Diff{From: a.Name, To: b.Name}
+
+	for _, sb := range b.Steps {
+		sa := a.GetStep(sb.ID)
+		if sa == nil {
+			d.Added = append(d.Added, sb)
+			continue
+		}
+		if fields := changedStepFields(*sa, sb); len(fields) > 0 {
+			d.Changed = append(d.Changed, StepChange{ID: sb.ID, Fields: fields, Before: *sa, After: sb})
+		}
+	}
+	for _, sa := range a.Steps {
+		if b.GetStep(sa.ID) == nil {
+			d.Removed = append(d.Removed, sa)
+		}
+	}
+
+	edgesA, edgesB := stepEdges(a), stepEdges(b)
+	for e := range edgesB {
+		if !edgesA[e] {
+			d.AddedEdges = append(d.AddedEdges, e)
+		}
+	}
+	for e := range edgesA {
+		if !edgesB[e] {
+			d.RemovedEdges = append(d.RemovedEdges, e)
+		}
+	}
+	sortEdges(d.AddedEdges)
+	sortEdges(d.RemovedEdges)
+
+	return d
+}
+
+// changedStepFields returns the names of non-dependency fields that differ.
+func changedStepFields(a, b Step) []string {
+	var fields []string
+	if a.Title != b.Title {
+		fields = append(fields, "title")
+	}
+	if a.Description != b.Description {
+		fields = append(fields, "description")
+	}
+	if a.Target != b.Target {
+		fields = append(fields, "target")
+	}
+	if a.Parallel != b.Parallel {
+		fields = append(fields, "parallel")
+	}
+	if a.ParallelGroup != b.ParallelGroup {
+		fields = append(fields, "parallel_group")
+	}
+	if !reflect.DeepEqual(a.Idle, b.Idle) {
+		fields = append(fields, "idle")
+	}
+	if !reflect.DeepEqual(a.Gate, b.Gate) {
+		fields = append(fields, "gate")
+	}
+	if a.Interactive != b.Interactive {
+		fields = append(fields, "interactive")
+	}
+	if a.Acceptance != b.Acceptance {
+		fields = append(fields, "acceptance")
+	}
+	return fields
+}
+
+func stepEdges(f *Formula) map[Edge]bool {
+	edges := make(map[Edge]bool)
+	for _, s := range f.Steps {
+		for _, need := range s.Needs {
+			edges[Edge{Step: s.ID, Needs: need}] = true
+		}
+	}
+	return edges
+}
+
+func sortEdges(edges []Edge) {
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Step != edges[j].Step {
+			return edges[i].Step < edges[j].Step
+		}
+		return edges[i].Needs < edges[j].Needs
+	})
+}
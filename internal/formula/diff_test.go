@@ -0,0 +1,87 @@
+package formula
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffSteps(t *testing.T) {
+	a := &Formula{Name: "a", Steps: []Step{
+		{ID: "design", Title: "Design"},
+		{ID: "build", Title: "Build", Needs: []string{"design"}},
+		{ID: "ship", Title: "Ship", Needs: []string{"build"}},
+	}}
+	b := &Formula{Name: "b", Steps: []Step{
+		{ID: "design", Title: "Design"},
+		{ID: "build", Title: "Build it", Needs: []string{"design"}},
+		{ID: "audit", Title: "Audit", Needs: []string{"build"}},
+		{ID: "release", Title: "Release", Needs: []string{"audit"}},
+	}}
+
+	d := DiffSteps(a, b)
+
+	if d.From != "a" || d.To != "b" {
+		t.Errorf("From/To = %s/%s, want a/b", d.From, d.To)
+	}
+	if len(d.Added) != 2 || d.Added[0].ID != "audit" || d.Added[1].ID != "release" {
+		t.Errorf("Added = %+v, want [audit release]", d.Added)
+	}
+	if len(d.Removed) != 1 || d.Removed[0].ID != "ship" {
+		t.Errorf("Removed = %+v, want [ship]", d.Removed)
+	}
+	if len(d.Changed) != 1 || d.Changed[0].ID != "build" || len(d.Changed[0].Fields) != 1 || d.Changed[0].Fields[0] != "title" {
+		t.Errorf("Changed = %+v, want build(title)", d.Changed)
+	}
+	wantAdded := []Edge{{"audit", "build"}, {"release", "audit"}}
+	if len(d.AddedEdges) != 2 || d.AddedEdges[0] != wantAdded[0] || d.AddedEdges[1] != wantAdded[1] {
+		t.Errorf("AddedEdges = %v, want %v", d.AddedEdges, wantAdded)
+	}
+	if len(d.RemovedEdges) != 1 || d.RemovedEdges[0] != (Edge{"ship", "build"}) {
+		t.Errorf("RemovedEdges = %v, want [ship→build]", d.RemovedEdges)
+	}
+}
+
+func TestDiffSteps_Identical(t *testing.T) {
+	f := &Formula{Name: "x", Steps: []Step{{ID: "a"}, {ID: "b", Needs: []string{"a"}}}}
+	if d := DiffSteps(f, f); !d.Empty() {
+		t.Errorf("diff of a formula with itself should be empty, got %+v", d)
+	}
+}
+
+// TestDiffSteps_CompositionAgainstBase diffs shiny-enterprise against its base
+// to show exactly what the compose expand rule injected.
+func TestDiffSteps_CompositionAgainstBase(t *testing.T) {
+	load := func(name string) *Formula {
+		t.Helper()
+		data, err := GetEmbeddedFormulaContent(name)
+		if err != nil {
+			t.Fatalf("GetEmbeddedFormulaContent(%s): %v", name, err)
+		}
+		f, err := Parse(data)
+		if err != nil {
+			t.Fatalf("Parse(%s): %v", name, err)
+		}
+		resolved, err := Resolve(f, nil)
+		if err != nil {
+			t.Fatalf("Resolve(%s): %v", name, err)
+		}
+		return resolved
+	}
+
+	d := DiffSteps(load("shiny"), load("shiny-enterprise"))
+
+	if len(d.Removed) != 1 || d.Removed[0].ID != "implement" {
+		t.Errorf("Removed = %+v, want [implement]", d.Removed)
+	}
+	if len(d.Added) != 5 {
+		t.Errorf("expected 5 expanded implement.* steps, got %+v", d.Added)
+	}
+	for _, s := range d.Added {
+		if !strings.HasPrefix(s.ID, "implement.") {
+			t.Errorf("unexpected added step %q", s.ID)
+		}
+	}
+	if len(d.AddedEdges) == 0 || len(d.RemovedEdges) == 0 {
+		t.Errorf("expansion should rewire dependency edges, got +%v -%v", d.AddedEdges, d.RemovedEdges)
+	}
+}
@@ -0,0 +1,112 @@
+package formula
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+)
+
+// Fingerprint returns a stable SHA-256 hex digest of the formula definition.
+// Tooling compares it against the fingerprint recorded when a molecule was
+// launched to detect that the formula has since drifted.
+//
+// Content is normalized before hashing so that cosmetic differences don't
+// change the result: steps are put in canonical topological order (see
+// canonicalStepOrder), legs and aspects are ordered by ID, and dependency
+// lists are sorted. Map-valued sections (vars, inputs, prompts,
+// parallelism) are emitted in key order by encoding/json. Template order is
+// preserved because expansion wires dependents to the last template step.
+func (f *Formula) Fingerprint() string {
+	n := *f
+
+	n.Steps = make([]Step, 0, len(f.Steps))
+	for _, i := range canonicalStepOrder(f.Steps) {
+		s := f.Steps[i]
+		s.Needs = sortedCopy(s.Needs)
+		n.Steps = append(n.Steps, s)
+	}
+
+	n.Legs = append([]Leg(nil), f.Legs...)
+	sort.Slice(n.Legs, func(i, j int) bool { return n.Legs[i].ID < n.Legs[j].ID })
+
+	n.Aspects = append([]Aspect(nil), f.Aspects...)
+	sort.Slice(n.Aspects, func(i, j int) bool { return n.Aspects[i].ID < n.Aspects[j].ID })
+
+	n.Template = make([]Template, len(f.Template))
+	for i, t := range f.Template {
+		t.Needs = sortedCopy(t.Needs)
+		n.Template[i] = t
+	}
+
+	if f.Synthesis != nil {
+		syn := *f.Synthesis
+		syn.DependsOn = sortedCopy(syn.DependsOn)
+		n.Synthesis = &syn
+	}
+
+	// Formula contains only plain data, so Marshal cannot fail.
+	data, _ := json.Marshal(n)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// canonicalStepOrder returns the indexes of steps in topological order,
+// always taking the earliest-declared step whose needs are met. Reordering
+// steps that are ordered by their needs anyway leaves the result unchanged,
+// but swapping independent steps does not: ParallelReadySteps dispatches
+// ready steps in declaration order, so that swap changes runtime behavior.
+// Steps left over by a cycle or an unknown need keep declaration order.
+func canonicalStepOrder(steps []Step) []int {
+	index := make(map[string]int, len(steps))
+	for i, s := range steps {
+		index[s.ID] = i
+	}
+
+	pending := make([]int, len(steps))
+	dependents := make([][]int, len(steps))
+	for i, s := range steps {
+		for _, need := range s.Needs {
+			if j, ok := index[need]; ok && j != i {
+				pending[i]++
+				dependents[j] = append(dependents[j], i)
+			}
+		}
+	}
+
+	order := make([]int, 0, len(steps))
+	done := make([]bool, len(steps))
+	for len(order) < len(steps) {
+		next := -1
+		for i := range steps {
+			if !done[i] && pending[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			for i := range steps {
+				if !done[i] {
+					order = append(order, i)
+				}
+			}
+			break
+		}
+		done[next] = true
+		order = append(order, next)
+		for _, d := range dependents[next] {
+			pending[d]--
+		}
+	}
+	return order
+}
+
+// sortedCopy returns a sorted copy of s, leaving s untouched.
+func sortedCopy(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	out := append([]string(nil), s...)
+	sort.Strings(out)
+	return out
+}
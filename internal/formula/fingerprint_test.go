@@ -0,0 +1,144 @@
+package formula
+
+import "testing"
+
+const fingerprintBase = `formula = "fp"
+type = "workflow"
+version = 1
+
+[vars.target]
+description = "Target"
+default = "main"
+
+[vars.branch]
+default = "dev"
+
+[[steps]]
+id = "design"
+title = "Design"
+
+[[steps]]
+id = "implement"
+title = "Implement"
+needs = ["design"]
+
+[[steps]]
+id = "review"
+title = "Review"
+needs = ["implement", "design"]
+acceptance = "Reviewer signs off"
+`
+
+func mustParse(t *testing.T, s string) *Formula {
+	t.Helper()
+	f, err := Parse([]byte(s))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	return f
+}
+
+func TestFingerprint_StableAcrossStepOrder(t *testing.T) {
+	base := mustParse(t, fingerprintBase)
+
+	reordered := mustParse(t, `formula = "fp"
+type = "workflow"
+version = 1
+
+[vars.branch]
+default = "dev"
+
+[vars.target]
+description = "Target"
+default = "main"
+
+[[steps]]
+id = "review"
+title = "Review"
+needs = ["design", "implement"]
+acceptance = "Reviewer signs off"
+
+[[steps]]
+id = "implement"
+title = "Implement"
+needs = ["design"]
+
+[[steps]]
+id = "design"
+title = "Design"
+`)
+
+	if base.Fingerprint() != reordered.Fingerprint() {
+		t.Error("reordering steps, needs, or vars should not change the fingerprint")
+	}
+	if base.Fingerprint() != base.Fingerprint() {
+		t.Error("Fingerprint() should be deterministic")
+	}
+	if got := base.Steps[2].Needs; got[0] != "implement" {
+		t.Errorf("Fingerprint() must not mutate the formula, needs = %v", got)
+	}
+}
+
+func TestFingerprint_ChangesWithDefinition(t *testing.T) {
+	base := mustParse(t, fingerprintBase).Fingerprint()
+
+	changes := map[string]func(f *Formula){
+		"dep":        func(f *Formula) { f.Steps[2].Needs = []string{"design"} },
+		"version":    func(f *Formula) { f.Version = 2 },
+		"name":       func(f *Formula) { f.Name = "fp2" },
+		"acceptance": func(f *Formula) { f.Steps[2].Acceptance = "Two reviewers sign off" },
+		"var":        func(f *Formula) { f.Vars["target"] = Var{Default: "release"} },
+	}
+	for name, mutate := range changes {
+		t.Run(name, func(t *testing.T) {
+			f := mustParse(t, fingerprintBase)
+			mutate(f)
+			if f.Fingerprint() == base {
+				t.Errorf("changing %s should change the fingerprint", name)
+			}
+		})
+	}
+}
+
+func TestFingerprint_ChangesWithIndependentStepOrder(t *testing.T) {
+	base := mustParse(t, `formula = "fp"
+type = "workflow"
+version = 1
+
+[[steps]]
+id = "lint"
+title = "Lint"
+
+[[steps]]
+id = "test"
+title = "Test"
+
+[[steps]]
+id = "merge"
+title = "Merge"
+needs = ["lint", "test"]
+`)
+
+	// Independent steps dispatch in declaration order, so swapping them is
+	// a behavioral change drift detection must see.
+	swapped := mustParse(t, `formula = "fp"
+type = "workflow"
+version = 1
+
+[[steps]]
+id = "test"
+title = "Test"
+
+[[steps]]
+id = "lint"
+title = "Lint"
+
+[[steps]]
+id = "merge"
+title = "Merge"
+needs = ["lint", "test"]
+`)
+	if base.Fingerprint() == swapped.Fingerprint() {
+		t.Error("swapping independent steps should change the fingerprint")
+	}
+}
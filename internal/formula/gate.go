@@ -0,0 +1,103 @@
+package formula
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/suggest"
+)
+
+// GateType is the kind of gate on a step.
+type GateType string
+
+// Gate types. Conditional gates are evaluated by agents from a named
+// condition; the rest are bd's async gates, resolved by bd itself.
+const (
+	// GateConditional runs the step only when a named condition from an
+	// earlier step holds.
+	GateConditional GateType = "conditional"
+
+	GateGHRun GateType = "gh:run" // Wait for a GitHub Actions run
+	GateGHPR  GateType = "gh:pr"  // Wait for a GitHub pull request
+	GateTimer GateType = "timer"  // Wait until a time elapses
+	GateHuman GateType = "human"  // Wait for a human to approve
+	GateMail  GateType = "mail"   // Wait for a mail message
+)
+
+// validGateTypes lists the accepted gate types in display order.
+var validGateTypes = []GateType{GateConditional, GateGHRun, GateGHPR, GateTimer, GateHuman, GateMail}
+
+// IsValid reports whether t is a known gate type.
+func (t GateType) IsValid() bool {
+	return slices.Contains(validGateTypes, t)
+}
+
+// Gate holds a step until something happens: an earlier step's outcome
+// (gate = { type = "conditional", condition = "no_response_1" }) or one of
+// bd's async events (gate = { type = "gh:run", ... }). Fields specific to bd
+// gate types are left to bd.
+type Gate struct {
+	Type      GateType `toml:"type"`      // One of validGateTypes
+	Condition string   `toml:"condition"` // Conditional gates: one of KnownGateConditions
+}
+
+// knownGateConditions lists the condition keys agents know how to evaluate.
+// A gate naming any other key never opens, so Parse rejects it. Add new keys
+// here together with the step that sets them.
+var knownGateConditions = []string{
+	"alive_detected",    // mol-shutdown-dance: the target answered an interrogation
+	"no_response_1",     // mol-shutdown-dance: no answer to the first interrogation
+	"no_response_2",     // mol-shutdown-dance: no answer to the second interrogation
+	"no_response_final", // mol-shutdown-dance: no answer to the final interrogation
+}
+
+// KnownGateConditions returns the valid gate condition keys, sorted. The
+// slice is a copy.
+func KnownGateConditions() []string {
+	return slices.Clone(knownGateConditions)
+}
+
+// IsKnownGateCondition reports whether cond is a registered gate condition.
+func IsKnownGateCondition(cond string) bool {
+	return slices.Contains(knownGateConditions, cond)
+}
+
+// validate checks that the gate has a known type and, for conditional gates,
+// names a known condition, suggesting the closest match for near-misses like
+// "condtional" or "no_respons_1".
+func (g *Gate) validate() error {
+	if !g.Type.IsValid() {
+		return invalidGateTypeError(g.Type)
+	}
+	if g.Type != GateConditional {
+		return nil
+	}
+	if g.Condition == "" {
+		return fmt.Errorf("conditional gate requires a condition")
+	}
+	if !IsKnownGateCondition(g.Condition) {
+		msg := fmt.Sprintf("unknown gate condition %q (known: %s)", g.Condition, strings.Join(knownGateConditions, ", "))
+		if match := suggest.Closest(g.Condition, knownGateConditions, 3); match != "" {
+			msg += fmt.Sprintf("; did you mean %q?", match)
+		}
+		return errors.New(msg)
+	}
+	return nil
+}
+
+func invalidGateTypeError(t GateType) error {
+	valid := make([]string, len(validGateTypes))
+	for i, v := range validGateTypes {
+		valid[i] = string(v)
+	}
+	if t == "" {
+		return fmt.Errorf("gate requires a type (valid types: %s)", strings.Join(valid, ", "))
+	}
+	msg := fmt.Sprintf("unknown gate type %q (valid types: %s)", t, strings.Join(valid, ", "))
+	if match := suggest.Closest(string(t), valid, 2); match != "" {
+		msg += fmt.Sprintf("; did you mean %q?", match)
+	}
+	return errors.New(msg)
+}
@@ -0,0 +1,163 @@
+package formula
+
+import (
+	"strings"
+	"testing"
+)
+
+const gateFormula = `formula = "gated"
+type = "workflow"
+version = 1
+
+[[steps]]
+id = "ask"
+title = "Ask"
+
+[[steps]]
+id = "retry"
+title = "Retry"
+needs = ["ask"]
+gate = { type = "conditional", condition = "%s" }
+`
+
+func TestParseStepGate_KnownCondition(t *testing.T) {
+	f := mustParse(t, strings.Replace(gateFormula, "%s", "no_response_1", 1))
+
+	if f.GetStep("ask").Gate != nil {
+		t.Error("ask step should have no gate")
+	}
+	gate := f.GetStep("retry").Gate
+	if gate == nil {
+		t.Fatal("retry step should have a gate")
+	}
+	if gate.Type != GateConditional || gate.Condition != "no_response_1" {
+		t.Errorf("gate = %+v, want conditional/no_response_1", gate)
+	}
+}
+
+func TestParseStepGate_UnknownConditionRejected(t *testing.T) {
+	_, err := Parse([]byte(strings.Replace(gateFormula, "%s", "no_respons_1", 1)))
+	if err == nil {
+		t.Fatal("expected error for unknown gate condition")
+	}
+	for _, want := range []string{`step "retry" gate`, `unknown gate condition "no_respons_1"`, `did you mean "no_response_1"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should contain %q", err, want)
+		}
+	}
+}
+
+func TestParseStepGate_MisspelledTypeRejected(t *testing.T) {
+	src := strings.Replace(gateFormula, `type = "conditional"`, `type = "condtional"`, 1)
+	src = strings.Replace(src, "%s", "no_response_1", 1)
+	_, err := Parse([]byte(src))
+	if err == nil {
+		t.Fatal("expected error for misspelled gate type")
+	}
+	for _, want := range []string{`step "retry" gate`, `unknown gate type "condtional"`, "valid types: conditional", `did you mean "conditional"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should contain %q", err, want)
+		}
+	}
+}
+
+func TestParseStepGate_BdNativeGateParses(t *testing.T) {
+	for _, typ := range []GateType{GateGHRun, GateGHPR, GateTimer, GateHuman, GateMail} {
+		t.Run(string(typ), func(t *testing.T) {
+			src := strings.Replace(gateFormula, `gate = { type = "conditional", condition = "%s" }`,
+				`gate = { type = "`+string(typ)+`", await_id = "ci-main", timeout = "30m" }`, 1)
+			f := mustParse(t, src)
+			if gate := f.GetStep("retry").Gate; gate == nil || gate.Type != typ {
+				t.Errorf("gate = %+v, want type %q", gate, typ)
+			}
+		})
+	}
+}
+
+func TestGateValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		gate Gate
+		want string // substring of the error; "" means valid
+	}{
+		{"known", Gate{Type: GateConditional, Condition: "alive_detected"}, ""},
+		{"missing condition", Gate{Type: GateConditional}, "requires a condition"},
+		{"unknown type", Gate{Type: "cron", Condition: "alive_detected"}, "unknown gate type"},
+		{"bd gate needs no condition", Gate{Type: GateTimer}, ""},
+		{"missing type", Gate{Condition: "alive_detected"}, "gate requires a type"},
+		{"unrelated condition", Gate{Type: GateConditional, Condition: "sunny"}, "unknown gate condition"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.gate.validate()
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("validate() = %v, want error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestKnownGateConditions(t *testing.T) {
+	conds := KnownGateConditions()
+	if len(conds) == 0 {
+		t.Fatal("no known gate conditions")
+	}
+	for i, c := range conds {
+		if !IsKnownGateCondition(c) {
+			t.Errorf("IsKnownGateCondition(%q) = false for a listed condition", c)
+		}
+		if i > 0 && conds[i-1] >= c {
+			t.Errorf("KnownGateConditions not sorted: %v", conds)
+		}
+	}
+	if IsKnownGateCondition("") || IsKnownGateCondition("NO_RESPONSE_1") {
+		t.Error("empty and wrong-case conditions should be unknown")
+	}
+
+	conds[0] = "mutated"
+	if !IsKnownGateCondition(KnownGateConditions()[0]) {
+		t.Error("mutating the returned slice changed the registry")
+	}
+}
+
+// Every gate in the shipped formulas must name a registered condition;
+// otherwise the gated step can never run.
+func TestEmbeddedFormulaGatesUseKnownConditions(t *testing.T) {
+	entries, err := formulasFS.ReadDir("formulas")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gates := 0
+	for _, e := range entries {
+		content, err := formulasFS.ReadFile("formulas/" + e.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := Parse(content)
+		if err != nil {
+			// Other validation failures are CheckFormulaHealth's concern.
+			if strings.Contains(err.Error(), "gate") {
+				t.Errorf("%s: %v", e.Name(), err)
+			}
+			continue
+		}
+		for _, s := range f.Steps {
+			if s.Gate == nil {
+				continue
+			}
+			gates++
+			if s.Gate.Type != GateConditional {
+				t.Errorf("%s step %q: gate type = %q, want %q", e.Name(), s.ID, s.Gate.Type, GateConditional)
+			}
+		}
+	}
+	if gates == 0 {
+		t.Error("expected shipped formulas to declare gates (mol-shutdown-dance)")
+	}
+}
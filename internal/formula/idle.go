@@ -0,0 +1,91 @@
+package formula
+
+import (
+	"fmt"
+	"time"
+)
+
+// Await kinds for Idle.Await.
+const (
+	// AwaitSignal waits via 'gt mol step await-signal' (activity feed).
+	AwaitSignal = "signal"
+	// AwaitEvent waits via 'gt mol step await-event' (file-based event channel).
+	AwaitEvent = "event"
+)
+
+// Backoff strategies for Backoff.Strategy.
+const (
+	BackoffExponential = "exponential"
+	BackoffFixed       = "fixed"
+)
+
+// Idle describes how a patrol step waits between cycles. Declaring it
+// structurally (instead of only in the step description) lets tests and
+// tooling verify that a patrol cannot spin in a tight loop when idle.
+type Idle struct {
+	Await   string   `toml:"await"`   // "signal" or "event"
+	Channel string   `toml:"channel"` // Event channel; required when await = "event"
+	Backoff *Backoff `toml:"backoff"`
+}
+
+// Backoff configures the wait between idle patrol cycles.
+type Backoff struct {
+	Strategy   string `toml:"strategy"`   // "exponential" (default) or "fixed"
+	Base       string `toml:"base"`       // Initial wait, e.g. "30s"
+	Multiplier int    `toml:"multiplier"` // Growth factor for exponential backoff (default 2)
+	Max        string `toml:"max"`        // Cap on the wait, e.g. "5m"
+}
+
+// Durations parses Base and Max. Max defaults to Base when unset.
+func (b *Backoff) Durations() (base, max time.Duration, err error) {
+	base, err = time.ParseDuration(b.Base)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid backoff base %q: %w", b.Base, err)
+	}
+	if base <= 0 {
+		return 0, 0, fmt.Errorf("backoff base must be positive, got %s", b.Base)
+	}
+	max = base
+	if b.Max != "" {
+		max, err = time.ParseDuration(b.Max)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid backoff max %q: %w", b.Max, err)
+		}
+		if max < base {
+			return 0, 0, fmt.Errorf("backoff max %s is less than base %s", b.Max, b.Base)
+		}
+	}
+	return base, max, nil
+}
+
+// validate checks that the idle spec names a known await kind and, when present,
+// a well-formed backoff.
+func (l *Idle) validate() error {
+	switch l.Await {
+	case AwaitSignal:
+	case AwaitEvent:
+		if l.Channel == "" {
+			return fmt.Errorf("await = %q requires a channel", AwaitEvent)
+		}
+	case "":
+		return fmt.Errorf("idle requires await (%q or %q)", AwaitSignal, AwaitEvent)
+	default:
+		return fmt.Errorf("unknown await %q (want %q or %q)", l.Await, AwaitSignal, AwaitEvent)
+	}
+
+	if l.Backoff == nil {
+		return nil
+	}
+	switch l.Backoff.Strategy {
+	case "", BackoffExponential, BackoffFixed:
+	default:
+		return fmt.Errorf("unknown backoff strategy %q (want %q or %q)", l.Backoff.Strategy, BackoffExponential, BackoffFixed)
+	}
+	if l.Backoff.Multiplier < 0 {
+		return fmt.Errorf("backoff multiplier must not be negative, got %d", l.Backoff.Multiplier)
+	}
+	if _, _, err := l.Backoff.Durations(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package formula
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseStepIdle(t *testing.T) {
+	f := mustParse(t, `formula = "patrol"
+type = "workflow"
+version = 1
+
+[[steps]]
+id = "work"
+title = "Work"
+
+[[steps]]
+id = "loop"
+title = "Loop"
+needs = ["work"]
+
+[steps.idle]
+await = "event"
+channel = "refinery"
+
+[steps.idle.backoff]
+base = "30s"
+multiplier = 2
+max = "15m"
+`)
+
+	if f.GetStep("work").Idle != nil {
+		t.Error("work step should have no idle spec")
+	}
+	idle := f.GetStep("loop").Idle
+	if idle == nil {
+		t.Fatal("loop step should have an idle spec")
+	}
+	if idle.Await != AwaitEvent || idle.Channel != "refinery" {
+		t.Errorf("idle = %+v, want await=event channel=refinery", idle)
+	}
+	base, max, err := idle.Backoff.Durations()
+	if err != nil {
+		t.Fatalf("Durations: %v", err)
+	}
+	if base != 30*time.Second || max != 15*time.Minute || idle.Backoff.Multiplier != 2 {
+		t.Errorf("backoff = %s/%s x%d, want 30s/15m x2", base, max, idle.Backoff.Multiplier)
+	}
+}
+
+func TestValidateStepIdle(t *testing.T) {
+	tests := []struct {
+		name    string
+		idle    string
+		wantErr string
+	}{
+		{"signal without backoff", `await = "signal"`, ""},
+		{"missing await", `channel = "x"`, "requires await"},
+		{"unknown await", `await = "sleep"`, "unknown await"},
+		{"event without channel", `await = "event"`, "requires a channel"},
+		{"bad strategy", "await = \"signal\"\n[steps.idle.backoff]\nstrategy = \"random\"\nbase = \"1s\"", "unknown backoff strategy"},
+		{"bad base", "await = \"signal\"\n[steps.idle.backoff]\nbase = \"soon\"", "invalid backoff base"},
+		{"max below base", "await = \"signal\"\n[steps.idle.backoff]\nbase = \"1m\"\nmax = \"30s\"", "less than base"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(`formula = "patrol"
+type = "workflow"
+version = 1
+
+[[steps]]
+id = "loop"
+title = "Loop"
+
+[steps.idle]
+` + tt.idle + "\n"))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -10,9 +10,7 @@ import (
 // Composition formulas (extends/compose) are now also resolved and validated.
 func TestParseRealFormulas(t *testing.T) {
 	// Formulas that use aspect-oriented features not yet implemented.
-	skipFormulas := map[string]string{
-		"security-audit.formula.toml": "uses aspect-oriented features (advice/pointcuts)",
-	}
+	skipFormulas := map[string]string{}
 
 	entries, err := fs.ReadDir(formulasFS, "formulas")
 	if err != nil {
@@ -1,6 +1,9 @@
 package formula
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -53,3 +56,145 @@ func TestParallelReadySteps(t *testing.T) {
 		t.Errorf("patrol-cleanup should need check-swarm-completion, got %v", patrolCleanup.Needs)
 	}
 }
+
+func TestParse_ParallelGroups(t *testing.T) {
+	f, err := Parse([]byte(`formula = "build-matrix"
+type = "workflow"
+version = 1
+
+[parallelism]
+builds = 2
+
+[[steps]]
+id = "linux"
+title = "Build linux"
+parallel_group = "builds"
+
+[[steps]]
+id = "darwin"
+title = "Build darwin"
+parallel_group = "builds"
+
+[[steps]]
+id = "publish"
+title = "Publish"
+needs = ["linux", "darwin"]
+`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if got := f.GetStep("linux").ParallelGroup; got != "builds" {
+		t.Errorf("linux parallel_group = %q, want builds", got)
+	}
+	if limit, ok := f.GroupLimit("builds"); !ok || limit != 2 {
+		t.Errorf("GroupLimit(builds) = %d, %v; want 2, true", limit, ok)
+	}
+	if _, ok := f.GroupLimit("missing"); ok {
+		t.Error("GroupLimit(missing) should report undeclared")
+	}
+}
+
+func TestParse_ParallelGroupValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		toml    string
+		wantErr string
+	}{
+		{
+			name: "undeclared group",
+			toml: `formula = "x"
+type = "workflow"
+version = 1
+
+[[steps]]
+id = "a"
+title = "A"
+parallel_group = "nope"
+`,
+			wantErr: `undeclared parallel_group "nope"`,
+		},
+		{
+			name: "zero limit",
+			toml: `formula = "x"
+type = "workflow"
+version = 1
+
+[parallelism]
+builds = 0
+
+[[steps]]
+id = "a"
+title = "A"
+`,
+			wantErr: "positive limit",
+		},
+		{
+			name: "negative limit",
+			toml: `formula = "x"
+type = "workflow"
+version = 1
+
+[parallelism]
+builds = -1
+
+[[steps]]
+id = "a"
+title = "A"
+parallel_group = "builds"
+`,
+			wantErr: "positive limit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.toml))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Parse() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolve_InheritsParallelism(t *testing.T) {
+	dir := t.TempDir()
+	parent := []byte(`formula = "parallel-parent"
+type = "workflow"
+version = 1
+
+[parallelism]
+builds = 3
+
+[[steps]]
+id = "build"
+title = "Build"
+parallel_group = "builds"
+`)
+	if err := os.WriteFile(filepath.Join(dir, "parallel-parent.formula.toml"), parent, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The child uses a group only the parent declares; Parse defers that check.
+	child, err := Parse([]byte(`formula = "parallel-child"
+type = "workflow"
+version = 1
+extends = ["parallel-parent"]
+
+[[steps]]
+id = "extra-build"
+title = "Extra build"
+parallel_group = "builds"
+`))
+	if err != nil {
+		t.Fatalf("Parse child: %v", err)
+	}
+
+	resolved, err := Resolve(child, []string{dir})
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if limit, ok := resolved.GroupLimit("builds"); !ok || limit != 3 {
+		t.Errorf("GroupLimit(builds) = %d, %v; want inherited 3, true", limit, ok)
+	}
+}
@@ -1,6 +1,7 @@
 package formula
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/BurntSushi/toml"
+	"github.com/steveyegge/gastown/internal/suggest"
 )
 
 // ParseFile reads and parses a formula.toml file.
@@ -63,8 +65,15 @@ func (f *Formula) Validate() error {
 		return fmt.Errorf("formula field is required")
 	}
 
+	// version is the formula's own revision (shipped formulas are at v2..v16),
+	// so it can't gate schema compatibility; only reject nonsense values.
+	// Omitted (0) is accepted for hand-written formulas.
+	if f.Version < 0 {
+		return fmt.Errorf("invalid formula version %d (must be a non-negative revision number)", f.Version)
+	}
+
 	if !f.Type.IsValid() {
-		return fmt.Errorf("invalid formula type %q (must be convoy, workflow, expansion, or aspect)", f.Type)
+		return invalidTypeError(f.Type)
 	}
 
 	// Type-specific validation
@@ -82,6 +91,17 @@ func (f *Formula) Validate() error {
 	return nil
 }
 
+// invalidTypeError lists the valid formula types and, for a near-miss like
+// "workfow", suggests the closest one.
+func invalidTypeError(t FormulaType) error {
+	valid := []string{string(TypeWorkflow), string(TypeConvoy), string(TypeExpansion), string(TypeAspect)}
+	msg := fmt.Sprintf("invalid formula type %q (valid types: %s)", t, strings.Join(valid, ", "))
+	if match := suggest.Closest(string(t), valid, 2); match != "" {
+		msg += fmt.Sprintf("; did you mean %q?", match)
+	}
+	return errors.New(msg)
+}
+
 func (f *Formula) validateConvoy() error {
 	if len(f.Legs) == 0 {
 		return fmt.Errorf("convoy formula requires at least one leg")
@@ -147,6 +167,32 @@ func (f *Formula) validateWorkflow() error {
 		}
 	}
 
+	if err := f.validateParallelism(); err != nil {
+		return err
+	}
+
+	if err := f.validateCompose(); err != nil {
+		return err
+	}
+
+	for _, step := range f.Steps {
+		if step.Idle == nil {
+			continue
+		}
+		if err := step.Idle.validate(); err != nil {
+			return fmt.Errorf("step %q idle: %w", step.ID, err)
+		}
+	}
+
+	for _, step := range f.Steps {
+		if step.Gate == nil {
+			continue
+		}
+		if err := step.Gate.validate(); err != nil {
+			return fmt.Errorf("step %q gate: %w", step.ID, err)
+		}
+	}
+
 	// Check for cycles
 	if err := f.checkCycles(); err != nil {
 		return err
@@ -155,6 +201,54 @@ func (f *Formula) validateWorkflow() error {
 	return nil
 }
 
+// validateParallelism checks that every [parallelism] limit is positive and
+// every step's parallel_group is declared. Formulas with extends may reference
+// groups declared by a parent; those are checked after Resolve() merges them.
+func (f *Formula) validateParallelism() error {
+	for group, limit := range f.Parallelism {
+		if limit <= 0 {
+			return fmt.Errorf("parallelism group %q must have a positive limit, got %d", group, limit)
+		}
+	}
+	if len(f.Extends) > 0 {
+		return nil
+	}
+	for _, step := range f.Steps {
+		if step.ParallelGroup == "" {
+			continue
+		}
+		if _, ok := f.Parallelism[step.ParallelGroup]; !ok {
+			return fmt.Errorf("step %q references undeclared parallel_group %q", step.ID, step.ParallelGroup)
+		}
+	}
+	return nil
+}
+
+// validateCompose checks that each [[compose.expand]] rule names both a target
+// step and an expansion formula. Whether they exist is checked by Resolve,
+// once inherited steps are merged and the expansion formula is loaded.
+func (f *Formula) validateCompose() error {
+	if f.Compose == nil {
+		return nil
+	}
+	for i, rule := range f.Compose.Expand {
+		if rule == nil || rule.Target == "" {
+			return fmt.Errorf("compose expand rule %d missing required target field", i+1)
+		}
+		if rule.With == "" {
+			return fmt.Errorf("compose expand rule for %q missing required with field", rule.Target)
+		}
+	}
+	return nil
+}
+
+// GroupLimit returns the maximum number of steps in group that may run
+// concurrently, and whether the group is declared in [parallelism].
+func (f *Formula) GroupLimit(group string) (int, bool) {
+	limit, ok := f.Parallelism[group]
+	return limit, ok
+}
+
 func (f *Formula) validateExpansion() error {
 	if len(f.Template) == 0 {
 		return fmt.Errorf("expansion formula requires at least one template")
@@ -190,8 +284,14 @@ func (f *Formula) validateExpansion() error {
 }
 
 func (f *Formula) validateAspect() error {
-	if len(f.Aspects) == 0 {
-		return fmt.Errorf("aspect formula requires at least one aspect")
+	if len(f.Aspects) == 0 && len(f.Advice) == 0 {
+		return fmt.Errorf("aspect formula requires at least one aspect or advice")
+	}
+
+	for i := range f.Advice {
+		if err := f.Advice[i].validate(); err != nil {
+			return err
+		}
 	}
 
 	// Check aspect IDs are unique
@@ -273,40 +373,13 @@ func checkDependencyCycles(deps map[string][]string) error {
 // Only applicable to workflow and expansion formulas.
 // Returns an error if there are cycles.
 func (f *Formula) TopologicalSort() ([]string, error) {
-	var items []string
-	var deps map[string][]string
-
-	switch f.Type {
-	case TypeWorkflow:
-		for _, step := range f.Steps {
-			items = append(items, step.ID)
-		}
-		deps = make(map[string][]string)
-		for _, step := range f.Steps {
-			deps[step.ID] = step.Needs
-		}
-	case TypeExpansion:
-		for _, tmpl := range f.Template {
-			items = append(items, tmpl.ID)
-		}
-		deps = make(map[string][]string)
-		for _, tmpl := range f.Template {
-			deps[tmpl.ID] = tmpl.Needs
-		}
-	case TypeConvoy:
-		// Convoy legs are parallel; return all leg IDs
-		for _, leg := range f.Legs {
-			items = append(items, leg.ID)
-		}
-		return items, nil
-	case TypeAspect:
-		// Aspect aspects are parallel; return all aspect IDs
-		for _, aspect := range f.Aspects {
-			items = append(items, aspect.ID)
-		}
+	items, deps, err := f.dependencyGraph()
+	if err != nil {
+		return nil, err
+	}
+	if deps == nil {
+		// Convoy legs and aspects are parallel; keep declaration order.
 		return items, nil
-	default:
-		return nil, fmt.Errorf("unsupported formula type for topological sort")
 	}
 
 	// Kahn's algorithm
@@ -360,6 +433,37 @@ func (f *Formula) TopologicalSort() ([]string, error) {
 	return result, nil
 }
 
+// dependencyGraph returns the formula's step (or template) IDs in declaration
+// order and each one's needs. deps is nil for convoy and aspect formulas,
+// whose legs and aspects are parallel.
+func (f *Formula) dependencyGraph() (items []string, deps map[string][]string, err error) {
+	switch f.Type {
+	case TypeWorkflow:
+		deps = make(map[string][]string)
+		for _, step := range f.Steps {
+			items = append(items, step.ID)
+			deps[step.ID] = step.Needs
+		}
+	case TypeExpansion:
+		deps = make(map[string][]string)
+		for _, tmpl := range f.Template {
+			items = append(items, tmpl.ID)
+			deps[tmpl.ID] = tmpl.Needs
+		}
+	case TypeConvoy:
+		for _, leg := range f.Legs {
+			items = append(items, leg.ID)
+		}
+	case TypeAspect:
+		for _, aspect := range f.Aspects {
+			items = append(items, aspect.ID)
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported formula type for topological sort")
+	}
+	return items, deps, nil
+}
+
 // ReadySteps returns steps that have no unmet dependencies.
 // completed is a set of step IDs that have been completed.
 func (f *Formula) ReadySteps(completed map[string]bool) []string {
@@ -538,6 +642,7 @@ func resolveChain(formula *Formula, searchPaths []string, chain []string) (*Form
 		Agent:       formula.Agent,
 		Compose:     formula.Compose,
 		Vars:        make(map[string]Var),
+		Parallelism: make(map[string]int),
 	}
 	if merged.Type == "" {
 		merged.Type = TypeWorkflow
@@ -560,6 +665,11 @@ func resolveChain(formula *Formula, searchPaths []string, chain []string) (*Form
 				merged.Vars[name] = v
 			}
 		}
+		for group, limit := range parent.Parallelism {
+			if _, exists := merged.Parallelism[group]; !exists {
+				merged.Parallelism[group] = limit
+			}
+		}
 		// Inherit steps (parent steps come first).
 		merged.Steps = append(merged.Steps, parent.Steps...)
 
@@ -573,6 +683,9 @@ func resolveChain(formula *Formula, searchPaths []string, chain []string) (*Form
 	for name, v := range formula.Vars {
 		merged.Vars[name] = v
 	}
+	for group, limit := range formula.Parallelism {
+		merged.Parallelism[group] = limit
+	}
 	// Append child's own steps after parent steps.
 	merged.Steps = append(merged.Steps, formula.Steps...)
 	// Child description takes priority.
@@ -589,7 +702,13 @@ func resolveChain(formula *Formula, searchPaths []string, chain []string) (*Form
 			}
 			merged.Steps = expanded
 		}
-		// compose.aspects is recorded but not yet acted upon (future work).
+		if len(formula.Compose.Aspects) > 0 {
+			woven, err := applyAspects(merged.Steps, formula.Compose.Aspects, searchPaths)
+			if err != nil {
+				return nil, fmt.Errorf("compose aspects: %w", err)
+			}
+			merged.Steps = woven
+		}
 	}
 
 	if err := merged.Validate(); err != nil {
@@ -644,7 +763,15 @@ func applyExpandRule(steps []Step, rule *ExpandRule, searchPaths []string) ([]St
 		}
 	}
 	if targetIdx == -1 {
-		return nil, fmt.Errorf("target step %q not found in formula steps", rule.Target)
+		ids := make([]string, len(steps))
+		for i, st := range steps {
+			ids[i] = st.ID
+		}
+		msg := fmt.Sprintf("target step %q not found in formula steps", rule.Target)
+		if match := suggest.Closest(rule.Target, ids, 2); match != "" {
+			msg += fmt.Sprintf("; did you mean %q?", match)
+		}
+		return nil, errors.New(msg)
 	}
 
 	// Build expanded steps from the expansion template.
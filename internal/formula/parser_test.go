@@ -266,6 +266,52 @@ id = "step1"
 	}
 }
 
+func TestValidate_InvalidTypeSuggestsClosest(t *testing.T) {
+	data := []byte(`
+formula = "test"
+type = "workfow"
+version = 1
+[[steps]]
+id = "step1"
+`)
+
+	_, err := Parse(data)
+	if err == nil {
+		t.Fatal("expected error for misspelled type")
+	}
+	msg := err.Error()
+	for _, want := range []string{`did you mean "workflow"?`, "workflow, convoy, expansion, aspect"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error %q missing %q", msg, want)
+		}
+	}
+
+	// A far-off type lists the valid types without a guess.
+	_, err = Parse([]byte("formula = \"test\"\ntype = \"invalid\"\n[[steps]]\nid = \"s\"\n"))
+	if err == nil || strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("error for far-off type = %v, want no suggestion", err)
+	}
+}
+
+func TestValidate_Version(t *testing.T) {
+	parse := func(version string) error {
+		_, err := Parse([]byte("formula = \"test\"\nversion = " + version + "\n[[steps]]\nid = \"s\"\n"))
+		return err
+	}
+
+	// version is a per-formula revision; high revisions are normal.
+	for _, v := range []string{"0", "1", "16", "99"} {
+		if err := parse(v); err != nil {
+			t.Errorf("version = %s: unexpected error: %v", v, err)
+		}
+	}
+
+	err := parse("-1")
+	if err == nil || !strings.Contains(err.Error(), "invalid formula version -1") {
+		t.Errorf("version = -1: err = %v, want invalid formula version", err)
+	}
+}
+
 func TestValidate_DuplicateStepID(t *testing.T) {
 	data := []byte(`
 formula = "test"
@@ -588,7 +634,7 @@ func TestResolve_ShinyEnterprise(t *testing.T) {
 }
 
 // TestResolve_ShinySecure verifies that shiny-secure (extends shiny, aspects only)
-// resolves to the shiny steps without error.
+// weaves the security-audit advice around shiny's implement and submit steps.
 func TestResolve_ShinySecure(t *testing.T) {
 	data, err := GetEmbeddedFormulaContent("shiny-secure")
 	if err != nil {
@@ -605,8 +651,12 @@ func TestResolve_ShinySecure(t *testing.T) {
 		t.Fatalf("Resolve: %v", err)
 	}
 
-	// shiny-secure has no expand rules; it should produce the same 5 steps as shiny.
-	wantIDs := []string{"design", "implement", "review", "test", "submit"}
+	wantIDs := []string{
+		"design",
+		"implement-security-prescan", "implement", "implement-security-postscan",
+		"review", "test",
+		"submit-security-prescan", "submit", "submit-security-postscan",
+	}
 	if len(resolved.Steps) != len(wantIDs) {
 		t.Fatalf("got %d steps, want %d: %v", len(resolved.Steps), len(wantIDs), stepIDs(resolved))
 	}
@@ -615,6 +665,21 @@ func TestResolve_ShinySecure(t *testing.T) {
 			t.Errorf("step[%d] = %q, want %q", i, got, want)
 		}
 	}
+
+	wantNeeds := map[string][]string{
+		"implement-security-prescan":  {"design"},
+		"implement":                   {"implement-security-prescan"},
+		"implement-security-postscan": {"implement"},
+		"review":                      {"implement-security-postscan"},
+	}
+	for id, want := range wantNeeds {
+		if got := resolved.GetStep(id).Needs; strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("%s.Needs = %v, want %v", id, got, want)
+		}
+	}
+	if title := resolved.GetStep("implement-security-prescan").Title; title != "Security prescan for implement" {
+		t.Errorf("prescan title = %q, want placeholder expanded", title)
+	}
 }
 
 // TestResolve_CycleDetection verifies that circular extends chains are rejected.
@@ -655,6 +720,68 @@ extends = ["cycle-a"]
 	}
 }
 
+// TestResolve_ComposeExpandRejected verifies that compose.expand rules naming
+// a missing target step or a non-expansion formula fail at resolve time.
+func TestResolve_ComposeExpandRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		with    string
+		wantErr []string
+	}{
+		{"unknown target", "implemnt", "rule-of-five", []string{`target step "implemnt" not found`, `did you mean "implement"?`}},
+		{"with is not an expansion", "implement", "shiny", []string{`formula "shiny" is type "workflow", want "expansion"`}},
+		{"with does not exist", "implement", "no-such-expansion", []string{`expansion formula "no-such-expansion"`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Parse([]byte(`formula = "expand-test"
+type = "workflow"
+version = 1
+extends = ["shiny"]
+
+[[compose.expand]]
+target = "` + tt.target + `"
+with = "` + tt.with + `"
+`))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			_, err = Resolve(f, nil)
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error = %v, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+// TestParse_ComposeExpandMissingFields verifies that compose.expand rules
+// must name both a target and an expansion formula.
+func TestParse_ComposeExpandMissingFields(t *testing.T) {
+	for name, rule := range map[string]string{
+		"missing target": `with = "rule-of-five"`,
+		"missing with":   `target = "implement"`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(`formula = "expand-test"
+type = "workflow"
+version = 1
+extends = ["shiny"]
+
+[[compose.expand]]
+` + rule + "\n"))
+			if err == nil || !strings.Contains(err.Error(), "missing required") {
+				t.Errorf("Parse error = %v, want missing required field", err)
+			}
+		})
+	}
+}
+
 // TestResolve_NoExtends verifies formulas without extends pass through unchanged.
 func TestResolve_NoExtends(t *testing.T) {
 	data, err := GetEmbeddedFormulaContent("shiny")
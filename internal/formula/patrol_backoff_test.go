@@ -3,10 +3,12 @@ package formula
 import (
 	"strings"
 	"testing"
+
+	"github.com/BurntSushi/toml"
 )
 
-// TestPatrolFormulasHaveBackoffLogic verifies that patrol formulas include
-// await-signal backoff logic in their loop-or-exit steps.
+// TestPatrolFormulasHaveBackoffLogic verifies that patrol formulas declare
+// await backoff on their loop-or-exit steps.
 //
 // This is a regression test for a bug where the witness patrol formula's
 // await-signal logic was accidentally removed by subsequent commits,
@@ -16,54 +18,113 @@ import (
 // See: gt-0hzeo (refinery stall bug — missing await-signal)
 func TestPatrolFormulasHaveBackoffLogic(t *testing.T) {
 	// Patrol formulas that must have backoff logic.
-	// The loopStepID is the step that contains the await-signal logic;
+	// The loopStepID is the step that declares [steps.idle];
 	// witness/deacon use "loop-or-exit", refinery uses "burn-or-loop".
 	type patrolFormula struct {
 		name       string
 		loopStepID string
-		awaitCmd   string // "await-signal" or "await-event"
+		await      string // AwaitSignal or AwaitEvent
 	}
 
 	patrolFormulas := []patrolFormula{
-		{"mol-witness-patrol.formula.toml", "loop-or-exit", "await-signal"},
-		{"mol-deacon-patrol.formula.toml", "loop-or-exit", "await-signal"},
-		{"mol-refinery-patrol.formula.toml", "burn-or-loop", "await-event"},
+		{"mol-witness-patrol.formula.toml", "loop-or-exit", AwaitSignal},
+		{"mol-deacon-patrol.formula.toml", "loop-or-exit", AwaitSignal},
+		{"mol-refinery-patrol.formula.toml", "burn-or-loop", AwaitEvent},
 	}
 
 	for _, pf := range patrolFormulas {
 		t.Run(pf.name, func(t *testing.T) {
-			// Read formula content directly from embedded FS
 			content, err := formulasFS.ReadFile("formulas/" + pf.name)
 			if err != nil {
 				t.Fatalf("reading %s: %v", pf.name, err)
 			}
 
-			contentStr := string(content)
+			f, err := Parse(content)
+			if err != nil {
+				t.Fatalf("parsing %s: %v", pf.name, err)
+			}
 
-			// Verify the formula contains the loop/decision step
-			doubleQuoted := `id = "` + pf.loopStepID + `"`
-			singleQuoted := `id = '` + pf.loopStepID + `'`
-			if !strings.Contains(contentStr, doubleQuoted) &&
-				!strings.Contains(contentStr, singleQuoted) {
+			step := f.GetStep(pf.loopStepID)
+			if step == nil {
 				t.Fatalf("%s: %s step not found", pf.name, pf.loopStepID)
 			}
 
-			// Verify the formula contains the required backoff patterns.
 			// Witness/deacon use await-signal; refinery uses await-event
 			// (file-based event channel system). Both provide backoff logic.
-			requiredPatterns := []string{
-				pf.awaitCmd,
-				"backoff",
-				"gt mol step " + pf.awaitCmd,
-			}
-
-			for _, pattern := range requiredPatterns {
-				if !strings.Contains(contentStr, pattern) {
-					t.Errorf("%s missing required pattern %q\n"+
-						"The %s step must include %s with backoff logic "+
-						"to prevent tight loops when the rig is idle.\n"+
-						"See PR #1052 for the original fix.",
-						pf.name, pattern, pf.loopStepID, pf.awaitCmd)
+			idle := step.Idle
+			if idle == nil {
+				t.Fatalf("%s: %s step has no [steps.idle]; it must await with backoff "+
+					"to prevent tight loops when the rig is idle. See PR #1052.",
+					pf.name, pf.loopStepID)
+			}
+			if idle.Await != pf.await {
+				t.Errorf("%s: idle await = %q, want %q", pf.name, idle.Await, pf.await)
+			}
+			if idle.Backoff == nil {
+				t.Fatalf("%s: %s idle spec has no backoff", pf.name, pf.loopStepID)
+			}
+			base, max, err := idle.Backoff.Durations()
+			if err != nil {
+				t.Fatalf("%s: %v", pf.name, err)
+			}
+			if base <= 0 || max < base {
+				t.Errorf("%s: backoff base=%s max=%s, want 0 < base <= max", pf.name, base, max)
+			}
+
+			// The description is what the agent actually runs; it must use the
+			// same await command the idle spec declares.
+			awaitCmd := "gt mol step await-" + idle.Await
+			if !strings.Contains(step.Description, awaitCmd) {
+				t.Errorf("%s: %s description does not run %q", pf.name, pf.loopStepID, awaitCmd)
+			}
+		})
+	}
+}
+
+// bdReservedStepKeys are step keys that bd decodes with its own schema when
+// cooking a formula (bd mol wisp/pour). A gt-only field under one of these
+// keys makes bd reject the formula, so patrol formulas must not use them.
+var bdReservedStepKeys = []string{
+	"loop",
+	"on_complete",
+	"children",
+	"expand",
+	"expand_vars",
+	"condition",
+	"waits_for",
+}
+
+// TestPatrolFormulasAvoidBdReservedStepKeys verifies that the embedded patrol
+// formulas stay cookable by bd.
+//
+// Regression test: [steps.loop] collided with bd's loop spec and made
+// `bd mol wisp mol-*-patrol` fail with "body is required".
+func TestPatrolFormulasAvoidBdReservedStepKeys(t *testing.T) {
+	patrolFormulas := []string{
+		"mol-witness-patrol.formula.toml",
+		"mol-deacon-patrol.formula.toml",
+		"mol-refinery-patrol.formula.toml",
+	}
+
+	for _, name := range patrolFormulas {
+		t.Run(name, func(t *testing.T) {
+			content, err := formulasFS.ReadFile("formulas/" + name)
+			if err != nil {
+				t.Fatalf("reading %s: %v", name, err)
+			}
+
+			var raw struct {
+				Steps []map[string]any `toml:"steps"`
+			}
+			if _, err := toml.Decode(string(content), &raw); err != nil {
+				t.Fatalf("decoding %s: %v", name, err)
+			}
+
+			for _, step := range raw.Steps {
+				for _, key := range bdReservedStepKeys {
+					if _, ok := step[key]; ok {
+						t.Errorf("%s: step %v uses bd-reserved key %q", name, step["id"], key)
+					}
 				}
 			}
 		})
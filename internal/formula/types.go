@@ -30,7 +30,7 @@ type Formula struct {
 	Name        string      `toml:"formula"`
 	Description string      `toml:"description"`
 	Type        FormulaType `toml:"type"`
-	Version     int         `toml:"version"`
+	Version     int         `toml:"version"`     // Content revision of this formula, bumped on edits; not a schema version
 	Pour        bool        `toml:"pour"`        // If true, steps are materialized as sub-wisps with checkpoint recovery. Default false (inline/root-only).
 	Agent       string      `toml:"agent"`       // Default agent for all legs (GH#2118)
 	ReviewOnly  bool        `toml:"review_only"` // If true, all legs are analysis-only — no code commits expected (gt-kvf)
@@ -43,8 +43,9 @@ type Formula struct {
 	Synthesis *Synthesis        `toml:"synthesis"`
 
 	// Workflow-specific
-	Steps []Step         `toml:"steps"`
-	Vars  map[string]Var `toml:"vars"`
+	Steps       []Step         `toml:"steps"`
+	Vars        map[string]Var `toml:"vars"`
+	Parallelism map[string]int `toml:"parallelism"` // parallel_group name -> max concurrent steps
 
 	// Composition-specific
 	Extends []string      `toml:"extends"` // Parent formula names to inherit steps from.
@@ -55,6 +56,7 @@ type Formula struct {
 
 	// Aspect-specific (similar to convoy but for analysis)
 	Aspects []Aspect `toml:"aspects"`
+	Advice  []Advice `toml:"advice"` // Steps woven into formulas that compose this aspect
 }
 
 // ComposeRules defines how a formula can be composed with others.
@@ -62,8 +64,8 @@ type ComposeRules struct {
 	// Expand replaces a single target step with an expansion formula's template steps.
 	Expand []*ExpandRule `toml:"expand"`
 
-	// Aspects lists aspect formula names to apply to this formula.
-	// (Reserved for future implementation.)
+	// Aspects lists aspect formula names whose advice is woven into this
+	// formula's steps, in order.
 	Aspects []string `toml:"aspects"`
 }
 
@@ -127,6 +129,17 @@ type Step struct {
 	Parallel    bool     `toml:"parallel"`    // If true, this step can run concurrently with other parallel steps that share the same needs
 	Interactive bool     `toml:"interactive"` // If true, this step requires user dialog and runs in the current session instead of being dispatched to a polecat
 	Acceptance  string   `toml:"acceptance"`  // Exit criteria for this step (used by Ralph loop mode)
+
+	// ParallelGroup caps concurrency with other steps in the same group; the
+	// limit comes from the formula's [parallelism] section.
+	ParallelGroup string `toml:"parallel_group"`
+
+	// Idle declares how a patrol step waits between cycles ([steps.idle]).
+	// Not "loop": bd decodes that key as its own loop-expansion spec.
+	Idle *Idle `toml:"idle"`
+
+	// Gate makes the step conditional on an earlier step's outcome.
+	Gate *Gate `toml:"gate"`
 }
 
 // Template represents a template step in an expansion formula.
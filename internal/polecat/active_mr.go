@@ -90,7 +90,7 @@ func assessStaleActiveMR(reader IssueReader, in ActiveMRInput, result ActiveMRAs
 
 func sourceIssueForActiveMR(hint string, mr *beads.Issue) string {
 	if mr != nil {
-		if fields := beads.ParseMRFields(mr); fields != nil {
+		if fields := mr.MRFields(); fields != nil {
 			if source := normalizeSourceIssue(fields.SourceIssue); source != "" {
 				return source
 			}
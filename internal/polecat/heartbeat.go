@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -12,6 +13,12 @@ import (
 // Configurable via operational.polecat.heartbeat_stale_threshold in settings/config.json.
 const SessionHeartbeatStaleThreshold = 3 * time.Minute
 
+// sessionHeartbeatDebounce is how recent an existing "working" heartbeat must be
+// for TouchSessionHeartbeat to skip rewriting it. Bursts of gt commands (prime,
+// hook, bd show) each touch the heartbeat; with a 3-minute stale threshold,
+// sub-10s precision buys nothing but I/O.
+const sessionHeartbeatDebounce = 10 * time.Second
+
 // HeartbeatState represents the agent-reported state in a heartbeat v2 (gt-3vr5).
 // Agents report their own state; the witness makes exactly one inference:
 // "is the heartbeat fresh?" Everything else is agent-reported.
@@ -66,9 +73,16 @@ func heartbeatFile(townRoot, sessionName string) string {
 
 // TouchSessionHeartbeat writes or updates the heartbeat file for a polecat session.
 // Writes state="working" by default (heartbeat v2, gt-3vr5).
+// The write is skipped when the existing heartbeat is already "working" and
+// younger than sessionHeartbeatDebounce.
 // This is best-effort: errors are silently ignored because heartbeat signals
 // are non-critical and should not interrupt gt commands.
 func TouchSessionHeartbeat(townRoot, sessionName string) {
+	if hb := ReadSessionHeartbeat(townRoot, sessionName); hb != nil && hb.State == HeartbeatWorking {
+		if age := time.Since(hb.Timestamp); age >= 0 && age < sessionHeartbeatDebounce {
+			return
+		}
+	}
 	TouchSessionHeartbeatWithState(townRoot, sessionName, HeartbeatWorking, "", "")
 }
 
@@ -131,3 +145,25 @@ func IsSessionHeartbeatStale(townRoot, sessionName string) (stale bool, exists b
 func RemoveSessionHeartbeat(townRoot, sessionName string) {
 	_ = os.Remove(heartbeatFile(townRoot, sessionName))
 }
+
+// ListSessionHeartbeats returns the session names that have a heartbeat file.
+// Returns nil (no error) when the heartbeats directory doesn't exist.
+func ListSessionHeartbeats(townRoot string) ([]string, error) {
+	entries, err := os.ReadDir(heartbeatsDir(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var sessions []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		sessions = append(sessions, strings.TrimSuffix(name, ".json"))
+	}
+	return sessions, nil
+}
@@ -97,6 +97,49 @@ func TestSessionHeartbeat_IsV2(t *testing.T) {
 	}
 }
 
+func TestTouchSessionHeartbeat_Debounced(t *testing.T) {
+	townRoot := t.TempDir()
+	path := filepath.Join(townRoot, ".runtime", "heartbeats", "gt-test-debounce.json")
+
+	TouchSessionHeartbeat(townRoot, "gt-test-debounce")
+
+	// Backdate the mtime so a rewrite would be detectable regardless of
+	// filesystem timestamp granularity.
+	past := time.Now().Add(-time.Minute)
+	if err := os.Chtimes(path, past, past); err != nil {
+		t.Fatal(err)
+	}
+	before, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	TouchSessionHeartbeat(townRoot, "gt-test-debounce")
+
+	after, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !after.ModTime().Equal(before.ModTime()) {
+		t.Errorf("heartbeat rewritten within debounce window: mtime %v -> %v", before.ModTime(), after.ModTime())
+	}
+}
+
+func TestTouchSessionHeartbeat_RewritesNonWorkingState(t *testing.T) {
+	townRoot := t.TempDir()
+
+	TouchSessionHeartbeatWithState(townRoot, "gt-test-restate", HeartbeatStuck, "blocked", "")
+	TouchSessionHeartbeat(townRoot, "gt-test-restate")
+
+	hb := ReadSessionHeartbeat(townRoot, "gt-test-restate")
+	if hb == nil {
+		t.Fatal("expected non-nil heartbeat")
+	}
+	if hb.State != HeartbeatWorking {
+		t.Errorf("state = %q, want %q (debounce must not mask a state change)", hb.State, HeartbeatWorking)
+	}
+}
+
 func TestIsSessionHeartbeatStale_NoFile(t *testing.T) {
 	townRoot := t.TempDir()
 
@@ -2069,6 +2069,12 @@ func (m *Manager) ReconcilePoolWith(namesWithDirs, namesWithSessions []string) {
 	m.cleanupOrphanPolecatState()
 }
 
+// IsSessionProcessDead reports whether a session's agent has confirmably exited,
+// using the same heartbeat-then-PID logic as polecat stale detection.
+func IsSessionProcessDead(t *tmux.Tmux, sessionName, townRoot string) bool {
+	return isSessionProcessDead(t, sessionName, townRoot)
+}
+
 // isSessionProcessDead checks if a polecat session's agent has exited.
 //
 // Uses heartbeat-based liveness detection (gt-qjtq): checks whether the session's
@@ -2442,11 +2448,11 @@ func (m *Manager) mqNotRequiredSource(issueID string) bool {
 	if err != nil || issue == nil {
 		return false
 	}
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	if attachment == nil {
 		return false
 	}
-	return attachment.NoMerge || attachment.ReviewOnly || strings.EqualFold(strings.TrimSpace(attachment.MergeStrategy), "local")
+	return attachment.NoMerge || attachment.ReviewOnly || strings.EqualFold(strings.TrimSpace(attachment.MergeStrategy), beads.MergeStrategyLocal)
 }
 
 func hasSubmittableWorkForWorkstate(worktreePath string, targetRefs []string) bool {
@@ -2464,7 +2470,7 @@ func (m *Manager) reuseTargetRefs(fields *beads.AgentFields, branch string) ([]s
 	lookupFailed := false
 	if fields.ActiveMR != "" {
 		if issue, err := m.beads.Show(fields.ActiveMR); err == nil {
-			if mrFields := beads.ParseMRFields(issue); mrFields != nil && mrFields.Target != "" {
+			if mrFields := issue.MRFields(); mrFields != nil && mrFields.Target != "" {
 				refs = append(refs, mrFields.Target)
 			}
 		} else if !errors.Is(err, beads.ErrNotFound) {
@@ -2473,7 +2479,7 @@ func (m *Manager) reuseTargetRefs(fields *beads.AgentFields, branch string) ([]s
 	}
 	if branch != "" {
 		if issue, err := m.beads.FindMRForBranchAny(branch); err == nil {
-			if mrFields := beads.ParseMRFields(issue); mrFields != nil && mrFields.Target != "" {
+			if mrFields := issue.MRFields(); mrFields != nil && mrFields.Target != "" {
 				refs = append(refs, mrFields.Target)
 			}
 		} else if !errors.Is(err, beads.ErrNotFound) {
@@ -2498,7 +2504,7 @@ func (m *Manager) reuseTargetRefs(fields *beads.AgentFields, branch string) ([]s
 }
 
 func attachmentTargetRefs(bd *beads.Beads, issue *beads.Issue) []string {
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	if attachment == nil {
 		return nil
 	}
@@ -2509,7 +2515,7 @@ func attachmentTargetRefs(bd *beads.Beads, issue *beads.Issue) []string {
 	}
 	if attachment.ConvoyID != "" && bd != nil {
 		if convoy, err := bd.Show(attachment.ConvoyID); err == nil {
-			if fields := beads.ParseConvoyFields(convoy); fields != nil && fields.BaseBranch != "" {
+			if fields := convoy.ConvoyFields(); fields != nil && fields.BaseBranch != "" {
 				refs = append(refs, fields.BaseBranch)
 			}
 		}
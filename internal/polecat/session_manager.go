@@ -451,6 +451,7 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 		AgentName:        polecat,
 		TownRoot:         townRoot,
 		RuntimeConfigDir: opts.RuntimeConfigDir,
+		Account:          opts.Account,
 		Agent:            opts.Agent,
 		SessionName:      sessionID,
 	})
@@ -725,70 +725,21 @@ func AutoClose(db *sql.DB, dbName string, staleAge time.Duration, dryRun bool) (
 	staleCutoff := time.Now().UTC().Add(-staleAge)
 	result := &AutoCloseResult{Database: dbName, DryRun: dryRun}
 
-	// Convoys are excluded from staleness auto-close (hq-jnap): their lifecycle
-	// is driven by tracked-bead status (`gt convoy check` / refinery post-merge),
-	// and the 'tracks' relation is non-blocking so the dependency exclusions
-	// below do NOT protect a convoy with open tracked issues. Stale-closing a
-	// convoy while its tracked beads are open orphans them from dispatch
-	// tracking and causes duplicate dispatches (hq-qouv/hq-shb1 incident).
-	whereClause := fmt.Sprintf(`
-		i.status IN ('open', 'in_progress')
-		AND i.updated_at < ?
-		AND i.priority > 1
-		AND i.issue_type NOT IN ('epic', 'convoy')
-		AND i.id NOT IN (
-			SELECT DISTINCT l.issue_id FROM `+"`%s`"+`.labels l
-			WHERE l.label IN ('gt:standing-orders', 'gt:keep', 'gt:role', 'gt:rig')
-		)
-		AND i.id NOT IN (
-			SELECT DISTINCT d.issue_id FROM `+"`%s`"+`.dependencies d
-			INNER JOIN `+"`%s`"+`.issues dep ON d.depends_on_issue_id = dep.id
-			WHERE dep.status IN ('open', 'in_progress')
-		)
-		AND i.id NOT IN (
-			SELECT DISTINCT d.depends_on_issue_id FROM `+"`%s`"+`.dependencies d
-			INNER JOIN `+"`%s`"+`.issues blocker ON d.issue_id = blocker.id
-			WHERE d.depends_on_issue_id IS NOT NULL
-			AND blocker.status IN ('open', 'in_progress')
-		)`, dbName, dbName, dbName, dbName, dbName)
-
 	// Two-step SELECT-then-UPDATE to avoid self-referencing subquery in UPDATE,
 	// which is not valid MySQL (Error 1093) and fragile in Dolt (dolthub/dolt#10600).
-	selectQuery := fmt.Sprintf("SELECT i.id, i.title, i.updated_at FROM issues i WHERE %s", whereClause)
-	rows, err := db.QueryContext(ctx, selectQuery, staleCutoff)
+	selectQuery := fmt.Sprintf("SELECT i.id, i.title, i.updated_at FROM issues i WHERE %s", staleIssueWhere(dbName))
+	entries, err := selectStaleIssues(ctx, db, dbName, selectQuery, staleCutoff)
 	if err != nil {
 		if isTableNotFound(err) {
 			return result, nil // issues/dependencies not on this server
 		}
 		return nil, fmt.Errorf("select stale: %w", err)
 	}
-	type candidate struct {
-		id        string
-		title     string
-		updatedAt time.Time
-	}
-	var candidates []candidate
-	for rows.Next() {
-		var c candidate
-		if err := rows.Scan(&c.id, &c.title, &c.updatedAt); err != nil {
-			rows.Close()
-			return nil, fmt.Errorf("scan stale id: %w", err)
-		}
-		candidates = append(candidates, c)
-	}
-	rows.Close()
 
-	// Build per-issue closure log entries.
-	now := time.Now().UTC()
-	ids := make([]string, len(candidates))
-	for i, c := range candidates {
-		ids[i] = c.id
-		result.ClosedEntries = append(result.ClosedEntries, ClosedEntry{
-			ID:       c.id,
-			Title:    c.title,
-			AgeDays:  int(now.Sub(c.updatedAt).Hours() / 24),
-			Database: dbName,
-		})
+	result.ClosedEntries = entries
+	ids := make([]string, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID
 	}
 
 	if dryRun {
@@ -846,6 +797,145 @@ func AutoClose(db *sql.DB, dbName string, staleAge time.Duration, dryRun bool) (
 	return result, nil
 }
 
+// StaleLabel marks issues LabelStale found stale, so a human can review them
+// instead of the reaper closing them.
+const StaleLabel = "gt:stale"
+
+// staleIssueWhere returns the WHERE clause (alias i, one time arg) selecting
+// issues eligible for stale handling: open with no updates past the cutoff,
+// excluding P0/P1 priority, epics, convoys, protected labels, and issues with
+// active dependencies.
+func staleIssueWhere(dbName string) string {
+	// Convoys are excluded from staleness auto-close (hq-jnap): their lifecycle
+	// is driven by tracked-bead status (`gt convoy check` / refinery post-merge),
+	// and the 'tracks' relation is non-blocking so the dependency exclusions
+	// below do NOT protect a convoy with open tracked issues. Stale-closing a
+	// convoy while its tracked beads are open orphans them from dispatch
+	// tracking and causes duplicate dispatches (hq-qouv/hq-shb1 incident).
+	return fmt.Sprintf(`
+		i.status IN ('open', 'in_progress')
+		AND i.updated_at < ?
+		AND i.priority > 1
+		AND i.issue_type NOT IN ('epic', 'convoy')
+		AND i.id NOT IN (
+			SELECT DISTINCT l.issue_id FROM `+"`%s`"+`.labels l
+			WHERE l.label IN ('gt:standing-orders', 'gt:keep', 'gt:role', 'gt:rig')
+		)
+		AND i.id NOT IN (
+			SELECT DISTINCT d.issue_id FROM `+"`%s`"+`.dependencies d
+			INNER JOIN `+"`%s`"+`.issues dep ON d.depends_on_issue_id = dep.id
+			WHERE dep.status IN ('open', 'in_progress')
+		)
+		AND i.id NOT IN (
+			SELECT DISTINCT d.depends_on_issue_id FROM `+"`%s`"+`.dependencies d
+			INNER JOIN `+"`%s`"+`.issues blocker ON d.issue_id = blocker.id
+			WHERE d.depends_on_issue_id IS NOT NULL
+			AND blocker.status IN ('open', 'in_progress')
+		)`, dbName, dbName, dbName, dbName, dbName)
+}
+
+// selectStaleIssues runs a SELECT of (id, title, updated_at) and returns one
+// entry per row with its staleness in days.
+func selectStaleIssues(ctx context.Context, db *sql.DB, dbName, query string, args ...interface{}) ([]ClosedEntry, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	now := time.Now().UTC()
+	var entries []ClosedEntry
+	for rows.Next() {
+		var e ClosedEntry
+		var updatedAt time.Time
+		if err := rows.Scan(&e.ID, &e.Title, &updatedAt); err != nil {
+			return nil, fmt.Errorf("scan stale id: %w", err)
+		}
+		e.AgeDays = int(now.Sub(updatedAt).Hours() / 24)
+		e.Database = dbName
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+// LabelStaleResult holds the results of a stale-labeling pass.
+type LabelStaleResult struct {
+	Database       string        `json:"database"`
+	Labeled        int           `json:"labeled"`
+	LabeledEntries []ClosedEntry `json:"labeled_entries,omitempty"`
+	DryRun         bool          `json:"dry_run,omitempty"`
+	Anomalies      []Anomaly     `json:"anomalies,omitempty"`
+}
+
+// LabelStale adds the StaleLabel to issues AutoClose would close, leaving
+// them open for review. Issues that already carry the label are skipped, so
+// repeated passes label each stale issue once.
+func LabelStale(db *sql.DB, dbName string, staleAge time.Duration, dryRun bool) (*LabelStaleResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultQueryTimeout)
+	defer cancel()
+
+	staleCutoff := time.Now().UTC().Add(-staleAge)
+	result := &LabelStaleResult{Database: dbName, DryRun: dryRun}
+
+	selectQuery := fmt.Sprintf(
+		"SELECT i.id, i.title, i.updated_at FROM issues i WHERE %s AND i.id NOT IN (SELECT s.issue_id FROM `%s`.labels s WHERE s.label = ?)",
+		staleIssueWhere(dbName), dbName)
+	entries, err := selectStaleIssues(ctx, db, dbName, selectQuery, staleCutoff, StaleLabel)
+	if err != nil {
+		if isTableNotFound(err) {
+			return result, nil // issues/labels not on this server
+		}
+		return nil, fmt.Errorf("select stale: %w", err)
+	}
+	result.LabeledEntries = entries
+
+	if dryRun || len(entries) == 0 {
+		result.Labeled = len(entries)
+		return result, nil
+	}
+
+	if _, err := db.ExecContext(ctx, "SET @@autocommit = 0"); err != nil {
+		return nil, fmt.Errorf("disable autocommit: %w", err)
+	}
+	defer func() {
+		_, _ = db.ExecContext(context.Background(), "SET @@autocommit = 1")
+	}()
+
+	values := make([]string, len(entries))
+	args := make([]interface{}, 0, 2*len(entries))
+	for i, e := range entries {
+		values[i] = "(?, ?)"
+		args = append(args, e.ID, StaleLabel)
+	}
+	insertQuery := fmt.Sprintf("INSERT IGNORE INTO `%s`.labels (issue_id, label) VALUES %s",
+		dbName, strings.Join(values, ","))
+	if _, err := db.ExecContext(ctx, insertQuery, args...); err != nil {
+		return nil, fmt.Errorf("label stale: %w", err)
+	}
+
+	result.Labeled = len(entries)
+
+	// Flush SQL transaction to working set before DOLT_COMMIT.
+	if _, err := db.ExecContext(ctx, "COMMIT"); err != nil {
+		result.Anomalies = append(result.Anomalies, Anomaly{
+			Type:    "sql_commit_failed",
+			Message: fmt.Sprintf("sql commit after stale labeling failed: %v", err),
+		})
+		return result, nil
+	}
+	commitMsg := fmt.Sprintf("reaper: label %d stale issues in %s", len(entries), dbName)
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("CALL DOLT_COMMIT('-Am', '%s')", commitMsg)); err != nil { //nolint:gosec // G201: commitMsg from safe values
+		if !isNothingToCommit(err) {
+			result.Anomalies = append(result.Anomalies, Anomaly{
+				Type:    "dolt_commit_failed",
+				Message: fmt.Sprintf("dolt commit after stale labeling failed: %v", err),
+			})
+		}
+	}
+
+	return result, nil
+}
+
 // batchDeleteRows deletes rows from a primary table and its auxiliary tables in batches.
 func batchDeleteRows(ctx context.Context, db *sql.DB, idQuery string, cutoffArg time.Time, primaryTable string, auxTables []string) (int, error) {
 	totalDeleted := 0
@@ -463,6 +463,54 @@ func TestClosedMoleculeStepReapBehavior(t *testing.T) {
 	}
 }
 
+func TestLabelStaleLabelsOnlyOldIssuesOnce(t *testing.T) {
+	now := time.Now().UTC()
+	state := &fakeReaperState{
+		issues: map[string]*fakeIssue{
+			"old":   {id: "old", title: "Old issue", status: "open", updatedAt: now.Add(-60 * 24 * time.Hour)},
+			"fresh": {id: "fresh", title: "Fresh issue", status: "open", updatedAt: now.Add(-1 * time.Hour)},
+		},
+		labels: map[string][]string{},
+		ops:    map[int][]string{},
+	}
+	db := openFakeReaperDB(t, state)
+	t.Cleanup(func() { _ = db.Close() })
+
+	staleAge := 30 * 24 * time.Hour
+	dryRun, err := LabelStale(db, "testdb", staleAge, true)
+	if err != nil {
+		t.Fatalf("dry-run LabelStale: %v", err)
+	}
+	if dryRun.Labeled != 1 || len(state.labels) != 0 {
+		t.Fatalf("dry run: Labeled = %d, labels = %v; want 1 and no labels written", dryRun.Labeled, state.labels)
+	}
+
+	result, err := LabelStale(db, "testdb", staleAge, false)
+	if err != nil {
+		t.Fatalf("LabelStale: %v", err)
+	}
+	if result.Labeled != 1 || len(result.LabeledEntries) != 1 || result.LabeledEntries[0].ID != "old" {
+		t.Fatalf("result = %+v, want only %q labeled", result, "old")
+	}
+	if got := state.labels["old"]; !reflect.DeepEqual(got, []string{StaleLabel}) {
+		t.Errorf("old labels = %v, want [%s]", got, StaleLabel)
+	}
+	if got := state.labels["fresh"]; len(got) != 0 {
+		t.Errorf("fresh labels = %v, want none", got)
+	}
+	if state.issues["old"].status != "open" {
+		t.Errorf("old status = %q, labeling must not close the issue", state.issues["old"].status)
+	}
+
+	again, err := LabelStale(db, "testdb", staleAge, false)
+	if err != nil {
+		t.Fatalf("second LabelStale: %v", err)
+	}
+	if again.Labeled != 0 {
+		t.Errorf("second pass Labeled = %d, want 0 (already labeled)", again.Labeled)
+	}
+}
+
 var fakeReaperDriverID uint64
 
 func openFakeReaperDB(t *testing.T, state *fakeReaperState) *sql.DB {
@@ -483,6 +531,13 @@ type fakeWisp struct {
 	createdAt time.Time
 }
 
+type fakeIssue struct {
+	id        string
+	title     string
+	status    string
+	updatedAt time.Time
+}
+
 type fakeDep struct {
 	issueID           string
 	dependsOnID       string
@@ -493,6 +548,8 @@ type fakeDep struct {
 type fakeReaperState struct {
 	mu       sync.Mutex
 	wisps    map[string]*fakeWisp
+	issues   map[string]*fakeIssue
+	labels   map[string][]string
 	deps     []fakeDep
 	nextConn int
 	ops      map[int][]string
@@ -609,6 +666,32 @@ func (s *fakeReaperState) hasOpenParentLocked(id string) bool {
 	return false
 }
 
+// staleIssuesLocked returns open issues last updated before cutoff, minus
+// those already carrying skipLabel (when non-empty).
+func (s *fakeReaperState) staleIssuesLocked(cutoff time.Time, skipLabel string) []*fakeIssue {
+	var stale []*fakeIssue
+	for _, issue := range s.issues {
+		if issue.status != "open" || !issue.updatedAt.Before(cutoff) {
+			continue
+		}
+		if skipLabel != "" && s.hasLabelLocked(issue.id, skipLabel) {
+			continue
+		}
+		stale = append(stale, issue)
+	}
+	sort.Slice(stale, func(i, j int) bool { return stale[i].id < stale[j].id })
+	return stale
+}
+
+func (s *fakeReaperState) hasLabelLocked(id, label string) bool {
+	for _, l := range s.labels[id] {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *fakeReaperState) openCountLocked() int {
 	count := 0
 	for _, w := range s.wisps {
@@ -682,6 +765,17 @@ func (c *fakeReaperConn) QueryContext(_ context.Context, query string, args []dr
 			return nil, err
 		}
 		return fakeIDRows(c.state.moleculeStepCandidatesLocked()), nil
+	case strings.HasPrefix(normalized, "SELECT i.id, i.title, i.updated_at FROM issues i WHERE"):
+		skipLabel := ""
+		if len(args) > 1 {
+			skipLabel, _ = args[1].Value.(string)
+		}
+		stale := c.state.staleIssuesLocked(namedTime(args), skipLabel)
+		rows := make([][]driver.Value, len(stale))
+		for i, issue := range stale {
+			rows[i] = []driver.Value{issue.id, issue.title, issue.updatedAt}
+		}
+		return &fakeReaperRows{cols: []string{"id", "title", "updated_at"}, rows: rows}, nil
 	default:
 		return nil, fmt.Errorf("unexpected query: %s", normalized)
 	}
@@ -704,6 +798,17 @@ func (c *fakeReaperConn) ExecContext(_ context.Context, query string, args []dri
 			}
 		}
 		return fakeReaperResult(affected), nil
+	case strings.HasPrefix(normalized, "INSERT IGNORE INTO `testdb`.labels (issue_id, label)"):
+		affected := int64(0)
+		for i := 0; i+1 < len(args); i += 2 {
+			id, _ := args[i].Value.(string)
+			label, _ := args[i+1].Value.(string)
+			if !c.state.hasLabelLocked(id, label) {
+				c.state.labels[id] = append(c.state.labels[id], label)
+				affected++
+			}
+		}
+		return fakeReaperResult(affected), nil
 	case normalized == "SET @@autocommit = 0" || normalized == "SET @@autocommit = 1" || normalized == "ROLLBACK" || normalized == "COMMIT" || strings.HasPrefix(normalized, "CALL DOLT_COMMIT"):
 		return fakeReaperResult(0), nil
 	default:
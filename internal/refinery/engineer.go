@@ -928,7 +928,7 @@ func (e *Engineer) recheckMRStillMergeable(mr *MRInfo, target string) ProcessRes
 			return e.rejectMRBeforeMerge(mr, "MR is owned-direct")
 		}
 
-		fields := beads.ParseMRFields(mrIssue)
+		fields := mrIssue.MRFields()
 		if fields == nil {
 			return e.rejectMRBeforeMerge(mr, "MR has missing merge-request fields")
 		}
@@ -1014,13 +1014,13 @@ func (e *Engineer) recheckMRSourceStillMergeable(mr *MRInfo, sourceIssue string)
 	if unchecked := beads.HasUncheckedCriteria(issue); unchecked > 0 {
 		return e.rejectMRBeforeMerge(mr, fmt.Sprintf("source_issue %s has %d unchecked acceptance criteria", sourceIssue, unchecked))
 	}
-	if af := beads.ParseAttachmentFields(issue); af != nil {
+	if af := issue.Attachment(); af != nil {
 		switch {
 		case af.NoMerge:
 			return e.rejectMRBeforeMerge(mr, fmt.Sprintf("source_issue %s has no_merge=true", sourceIssue))
 		case af.ReviewOnly:
 			return e.rejectMRBeforeMerge(mr, fmt.Sprintf("source_issue %s has review_only=true", sourceIssue))
-		case strings.EqualFold(strings.TrimSpace(af.MergeStrategy), "local"):
+		case strings.EqualFold(strings.TrimSpace(af.MergeStrategy), beads.MergeStrategyLocal):
 			return e.rejectMRBeforeMerge(mr, fmt.Sprintf("source_issue %s has merge_strategy=local", sourceIssue))
 		}
 	}
@@ -1885,7 +1885,7 @@ func (e *Engineer) recordConflictTaskOnMR(mr *MRInfo, taskID string, retryCount
 	if err != nil {
 		return err
 	}
-	mrFields := beads.ParseMRFields(mrBead)
+	mrFields := mrBead.MRFields()
 	if mrFields == nil {
 		mrFields = &beads.MRFields{}
 	}
@@ -2105,7 +2105,7 @@ func (e *Engineer) ListReadyMRs() ([]*MRInfo, error) {
 			continue
 		}
 
-		fields := beads.ParseMRFields(issue)
+		fields := issue.MRFields()
 		if fields == nil {
 			continue // Skip issues without MR fields
 		}
@@ -2166,7 +2166,7 @@ func (e *Engineer) ListBlockedMRs() ([]*MRInfo, error) {
 
 		blockedBy := e.firstOpenBlocker(issue)
 
-		fields := beads.ParseMRFields(issue)
+		fields := issue.MRFields()
 		if fields == nil {
 			continue
 		}
@@ -2206,7 +2206,7 @@ func (e *Engineer) ListAllOpenMRs() ([]*MRInfo, error) {
 			continue
 		}
 
-		fields := beads.ParseMRFields(issue)
+		fields := issue.MRFields()
 		if fields == nil {
 			continue
 		}
@@ -2245,7 +2245,7 @@ func (e *Engineer) ListQueueAnomalies(now time.Time) ([]*MRAnomaly, error) {
 	// Filter by rig — wisps are shared across all rigs (GH#2718).
 	filtered := make([]*beads.Issue, 0, len(issues))
 	for _, issue := range issues {
-		fields := beads.ParseMRFields(issue)
+		fields := issue.MRFields()
 		if fields != nil && fields.Rig != "" && !strings.EqualFold(fields.Rig, e.rig.Name) {
 			continue
 		}
@@ -2277,7 +2277,7 @@ func detectQueueAnomalies(
 		if issue == nil || issue.Status != "open" {
 			continue
 		}
-		fields := beads.ParseMRFields(issue)
+		fields := issue.MRFields()
 		if fields == nil || fields.Branch == "" {
 			continue
 		}
@@ -435,9 +435,7 @@ func (m *Manager) Queue() ([]QueueItem, error) {
 		return nil, fmt.Errorf("querying merge queue from beads: %w", err)
 	}
 
-	// Score and sort issues by priority score (highest first)
-	now := time.Now()
-	scored := make([]scoredIssue, 0, len(issues))
+	queued := make([]*beads.Issue, 0, len(issues))
 	for _, issue := range issues {
 		// Defensive filter: bd status filters can drift; queue must only include open MRs.
 		if issue == nil || issue.Status != "open" {
@@ -445,24 +443,18 @@ func (m *Manager) Queue() ([]QueueItem, error) {
 		}
 
 		// Filter by rig — wisps are shared across all rigs (GH#2718).
-		fields := beads.ParseMRFields(issue)
+		fields := issue.MRFields()
 		if fields != nil && fields.Rig != "" && !strings.EqualFold(fields.Rig, m.rig.Name) {
 			continue
 		}
-
-		score := m.calculateIssueScore(issue, now)
-		scored = append(scored, scoredIssue{issue: issue, score: score})
+		queued = append(queued, issue)
 	}
 
-	sort.Slice(scored, func(i, j int) bool {
-		return compareScoredIssues(scored[i], scored[j])
-	})
-
-	// Convert scored issues to queue items
+	// Convert sorted issues to queue items
 	var items []QueueItem
 	pos := 1
-	for _, s := range scored {
-		mr := m.issueToMR(s.issue)
+	for _, issue := range ScoreAndSort(queued, time.Now()) {
+		mr := m.issueToMR(issue)
 		if mr != nil {
 			items = append(items, QueueItem{
 				Position: pos,
@@ -476,6 +468,30 @@ func (m *Manager) Queue() ([]QueueItem, error) {
 	return items, nil
 }
 
+// ScoreAndSort returns MR issues in the order the refinery processes them:
+// highest priority score first, ties broken by ascending ID so the order is
+// deterministic across calls. The input slice is not modified. Callers that
+// list MRs themselves (e.g. gt mq next) should use this to match Queue's order.
+func ScoreAndSort(issues []*beads.Issue, now time.Time) []*beads.Issue {
+	scored := make([]scoredIssue, 0, len(issues))
+	for _, issue := range issues {
+		if issue == nil {
+			continue
+		}
+		scored = append(scored, scoredIssue{issue: issue, score: ScoreIssue(issue, now)})
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return compareScoredIssues(scored[i], scored[j])
+	})
+
+	sorted := make([]*beads.Issue, len(scored))
+	for i, s := range scored {
+		sorted[i] = s.issue
+	}
+	return sorted
+}
+
 func compareScoredIssues(a, b scoredIssue) bool {
 	if a.score != b.score {
 		return a.score > b.score
@@ -486,10 +502,10 @@ func compareScoredIssues(a, b scoredIssue) bool {
 	return a.issue.ID < b.issue.ID
 }
 
-// calculateIssueScore computes the priority score for an MR issue.
-// Higher scores mean higher priority (process first).
-func (m *Manager) calculateIssueScore(issue *beads.Issue, now time.Time) float64 {
-	fields := beads.ParseMRFields(issue)
+// ScoreIssue computes the priority score for an MR issue from its priority,
+// age and MR fields. Higher scores mean higher priority (process first).
+func ScoreIssue(issue *beads.Issue, now time.Time) float64 {
+	fields := issue.MRFields()
 
 	// Parse MR creation time
 	mrCreatedAt := parseTime(issue.CreatedAt)
@@ -528,7 +544,7 @@ func (m *Manager) issueToMR(issue *beads.Issue) *MergeRequest {
 	// Get configured default branch for this rig
 	defaultBranch := m.rig.DefaultBranch()
 
-	fields := beads.ParseMRFields(issue)
+	fields := issue.MRFields()
 	if fields == nil {
 		// No MR fields in description, construct from title/ID
 		return &MergeRequest{
@@ -547,19 +563,20 @@ func (m *Manager) issueToMR(issue *beads.Issue) *MergeRequest {
 	}
 
 	return &MergeRequest{
-		ID:           issue.ID,
-		Branch:       fields.Branch,
-		Worker:       fields.Worker,
-		AgentBead:    fields.AgentBead,
-		IssueID:      fields.SourceIssue,
-		TargetBranch: target,
-		CommitSHA:    fields.CommitSHA,
-		PRURL:        fields.PRURL,
-		PRNumber:     fields.PRNumber,
-		MergeCommit:  fields.MergeCommit,
-		Status:       mrStatusFromIssue(issue),
-		CloseReason:  CloseReason(fields.CloseReason),
-		CreatedAt:    parseTime(issue.CreatedAt),
+		ID:             issue.ID,
+		Branch:         fields.Branch,
+		Worker:         fields.Worker,
+		AgentBead:      fields.AgentBead,
+		IssueID:        fields.SourceIssue,
+		TargetBranch:   target,
+		CommitSHA:      fields.CommitSHA,
+		PRURL:          fields.PRURL,
+		PRNumber:       fields.PRNumber,
+		MergeCommit:    fields.MergeCommit,
+		ConflictTaskID: fields.ConflictTaskID,
+		Status:         mrStatusFromIssue(issue),
+		CloseReason:    CloseReason(fields.CloseReason),
+		CreatedAt:      parseTime(issue.CreatedAt),
 	}
 }
 
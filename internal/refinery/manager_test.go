@@ -2,6 +2,7 @@ package refinery
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -464,6 +465,98 @@ func TestManager_Queue_FiltersClosedMergeRequests(t *testing.T) {
 	}
 }
 
+func TestScoreAndSort_DeterministicOrder(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-6 * time.Hour).Format(time.RFC3339)
+	fresh := now.Add(-5 * time.Minute).Format(time.RFC3339)
+
+	issues := []*beads.Issue{
+		{ID: "gt-d", Priority: 2, CreatedAt: fresh},
+		{ID: "gt-b", Priority: 0, CreatedAt: fresh},
+		{ID: "gt-c", Priority: 2, CreatedAt: fresh},
+		{ID: "gt-a", Priority: 2, CreatedAt: old},
+		{ID: "gt-e", Priority: 4, CreatedAt: fresh},
+		nil,
+	}
+	// Highest priority (P0) first; among the P2s the older MR wins, then the
+	// equal-score pair falls back to ID order; P4 last.
+	want := []string{"gt-b", "gt-a", "gt-c", "gt-d", "gt-e"}
+
+	ids := func(sorted []*beads.Issue) []string {
+		out := make([]string, len(sorted))
+		for i, issue := range sorted {
+			out[i] = issue.ID
+		}
+		return out
+	}
+
+	if got := ids(ScoreAndSort(issues, now)); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("ScoreAndSort() = %v, want %v", got, want)
+	}
+
+	// Any input permutation yields the same order.
+	reversed := make([]*beads.Issue, len(issues))
+	for i, issue := range issues {
+		reversed[len(issues)-1-i] = issue
+	}
+	if got := ids(ScoreAndSort(reversed, now)); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("ScoreAndSort(reversed) = %v, want %v", got, want)
+	}
+
+	if issues[0].ID != "gt-d" {
+		t.Fatal("ScoreAndSort modified its input slice")
+	}
+}
+
+func TestManager_Queue_MatchesScoreAndSort(t *testing.T) {
+	mgr, rigPath := setupTestManager(t)
+	testutil.RequireDoltContainer(t)
+	port, _ := strconv.Atoi(testutil.DoltContainerPort())
+	b := beads.NewIsolatedWithPort(rigPath, port)
+	if err := b.Init("gt"); err != nil {
+		t.Skipf("bd init unavailable in test environment: %v", err)
+	}
+
+	var created []*beads.Issue
+	for _, p := range []int{2, 0, 2, 3, 1} {
+		issue, err := b.Create(beads.CreateOptions{
+			Title:    fmt.Sprintf("MR P%d", p),
+			Labels:   []string{"gt:merge-request"},
+			Priority: p,
+		})
+		if err != nil {
+			t.Fatalf("create merge-request issue: %v", err)
+		}
+		created = append(created, issue)
+	}
+
+	queue, err := mgr.Queue()
+	if err != nil {
+		t.Fatalf("Queue() error: %v", err)
+	}
+	listed := make([]*beads.Issue, 0, len(created))
+	for _, issue := range created {
+		shown, err := b.Show(issue.ID)
+		if err != nil {
+			t.Fatalf("show %s: %v", issue.ID, err)
+		}
+		listed = append(listed, shown)
+	}
+	want := ScoreAndSort(listed, time.Now())
+
+	if len(queue) != len(want) {
+		t.Fatalf("Queue() returned %d items, want %d", len(queue), len(want))
+	}
+	for i, item := range queue {
+		if item.Position != i+1 {
+			t.Errorf("queue[%d].Position = %d, want %d", i, item.Position, i+1)
+		}
+		if item.MR.ID != want[i].ID {
+			t.Errorf("queue[%d] = %s, want %s (ScoreAndSort order)", i, item.MR.ID, want[i].ID)
+		}
+	}
+}
+
 func TestManager_FindMR_NoBeads(t *testing.T) {
 	mgr, _ := setupTestManager(t)
 
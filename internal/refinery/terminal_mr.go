@@ -44,7 +44,7 @@ func closeTerminalMR(b *beads.Beads, mrID string, opts terminalMRCloseOptions) (
 		return result, nil
 	}
 
-	fields := beads.ParseMRFields(issue)
+	fields := issue.MRFields()
 	if fields == nil {
 		fields = &beads.MRFields{}
 	}
@@ -57,6 +57,10 @@ func closeTerminalMR(b *beads.Beads, mrID string, opts terminalMRCloseOptions) (
 	status := beads.IssueStatus(strings.TrimSpace(issue.Status))
 	switch {
 	case status == beads.StatusOpen:
+		mr := &MergeRequest{Status: MROpen, CloseReason: CloseReason(normalizedMRCloseReason(fields.CloseReason))}
+		if err := mr.Close(CloseReason(normalizedMRCloseReason(opts.Reason))); err != nil {
+			return result, fmt.Errorf("close MR %s: %w", mrID, err)
+		}
 		if opts.MergeCommit != "" {
 			fields.MergeCommit = opts.MergeCommit
 		}
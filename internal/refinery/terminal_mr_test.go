@@ -1,6 +1,10 @@
 package refinery
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -47,3 +51,47 @@ func TestValidateTerminalMRCloseSnapshotAllowsMatchingSnapshot(t *testing.T) {
 		t.Fatalf("validateTerminalMRCloseSnapshot: %v", err)
 	}
 }
+
+func TestCloseTerminalMRRefusesConflictingCloseReason(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses Unix shell script mocks")
+	}
+
+	// The MR bead is still open but already records a merge, as after a
+	// PostMerge retry. Closing it as rejected must fail without touching it.
+	binDir := t.TempDir()
+	logPath := filepath.Join(binDir, "bd.log")
+	script := `#!/bin/sh
+printf '%s\n' "$*" >> "` + logPath + `"
+case "$*" in
+  *show*)
+    printf '%s\n' '[{"id":"gt-mr-done","title":"MR","issue_type":"task","labels":["gt:merge-request"],"status":"open","description":"branch: polecat/rust/gt-test\nsource_issue: gt-test\nclose_reason: merged"}]'
+    ;;
+esac
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write mock bd: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	workDir := t.TempDir()
+	b := beads.NewWithBeadsDir(workDir, filepath.Join(workDir, ".beads"))
+	result, err := closeTerminalMR(b, "gt-mr-done", terminalMRCloseOptions{Reason: "rejected: stale branch"})
+	if !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("closeTerminalMR error = %v, want ErrInvalidTransition", err)
+	}
+	if result.Closed {
+		t.Error("refused close reported Closed = true")
+	}
+
+	logBytes, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("read bd log: %v", err)
+	}
+	for _, line := range strings.Split(string(logBytes), "\n") {
+		if strings.Contains(line, "update") || strings.Contains(line, "close") {
+			t.Errorf("refused close still mutated the MR bead: %q", line)
+		}
+	}
+}
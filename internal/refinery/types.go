@@ -7,6 +7,7 @@ package refinery
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -43,6 +44,9 @@ type MergeRequest struct {
 	// MergeCommit is the SHA that was pushed to the target branch after merge.
 	MergeCommit string `json:"merge_commit,omitempty"`
 
+	// ConflictTaskID links the conflict-resolution task blocking this MR, if any.
+	ConflictTaskID string `json:"conflict_task_id,omitempty"`
+
 	// CreatedAt is when the MR was queued.
 	CreatedAt time.Time `json:"created_at"`
 
@@ -206,6 +210,90 @@ func ValidateTransition(from, to MRStatus) error {
 	return fmt.Errorf("%w: %s → %s is not allowed", ErrInvalidTransition, from, to)
 }
 
+// MR lifecycle states used by validateMRStatusTransition. Unlike MRStatus
+// (the raw beads status), these distinguish how an MR ended: a closed bead
+// is either merged or closed without merging.
+const (
+	mrLifecycleOpen   = "open"
+	mrLifecycleMerged = "merged"
+	mrLifecycleClosed = "closed"
+	mrLifecycleFailed = "failed"
+)
+
+// validMRLifecycleTransitions defines the allowed MR lifecycle transitions.
+// Merged and closed are terminal: a merged MR must never be reopened or
+// re-closed as rejected, even when a post-merge step is retried.
+var validMRLifecycleTransitions = map[string][]string{
+	mrLifecycleOpen:   {mrLifecycleMerged, mrLifecycleClosed, mrLifecycleFailed},
+	mrLifecycleFailed: {mrLifecycleOpen, mrLifecycleClosed},
+}
+
+// validateMRStatusTransition checks an MR lifecycle transition.
+//
+// Valid transitions:
+//   - open → merged (merge landed)
+//   - open → closed (rejected, superseded, conflict)
+//   - open → failed (merge attempt failed)
+//   - failed → open (retry)
+//   - failed → closed (give up)
+//
+// Merged and closed are terminal.
+func validateMRStatusTransition(current, target string) error {
+	current = strings.ToLower(strings.TrimSpace(current))
+	target = strings.ToLower(strings.TrimSpace(target))
+
+	if err := ensureKnownMRLifecycleStatus(current); err != nil {
+		return err
+	}
+	if err := ensureKnownMRLifecycleStatus(target); err != nil {
+		return err
+	}
+	if current == target {
+		return nil
+	}
+	allowed, ok := validMRLifecycleTransitions[current]
+	if !ok {
+		return fmt.Errorf("%w: merge request is %s (terminal), cannot move to %s", ErrInvalidTransition, current, target)
+	}
+	for _, to := range allowed {
+		if to == target {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: merge request %s → %s is not allowed", ErrInvalidTransition, current, target)
+}
+
+func ensureKnownMRLifecycleStatus(status string) error {
+	switch status {
+	case mrLifecycleOpen, mrLifecycleMerged, mrLifecycleClosed, mrLifecycleFailed:
+		return nil
+	}
+	return fmt.Errorf("%w: unknown merge request status %q", ErrInvalidTransition, status)
+}
+
+// lifecycleStatus derives the MR lifecycle state. An MR that already has
+// close_reason=merged recorded is merged even while its bead is still open:
+// the merge landed and only the bead close is pending (e.g. a PostMerge
+// retry after a partial failure).
+func (mr *MergeRequest) lifecycleStatus() string {
+	switch {
+	case mr.CloseReason == CloseReasonMerged:
+		return mrLifecycleMerged
+	case mr.Status == MRClosed:
+		return mrLifecycleClosed
+	default:
+		return mrLifecycleOpen
+	}
+}
+
+// mrCloseTarget returns the lifecycle state a close with reason moves to.
+func mrCloseTarget(reason CloseReason) string {
+	if reason == CloseReasonMerged {
+		return mrLifecycleMerged
+	}
+	return mrLifecycleClosed
+}
+
 // SetStatus updates the MR status after validating the transition.
 // Returns an error if the transition is not allowed.
 func (mr *MergeRequest) SetStatus(newStatus MRStatus) error {
@@ -224,6 +312,11 @@ func (mr *MergeRequest) Close(reason CloseReason) error {
 	if mr.Status == MRClosed {
 		return fmt.Errorf("%w: MR is already closed", ErrClosedImmutable)
 	}
+	// A recorded merge is final even while the bead is still open: it can
+	// only close as merged.
+	if err := validateMRStatusTransition(mr.lifecycleStatus(), mrCloseTarget(reason)); err != nil {
+		return err
+	}
 	if err := ValidateTransition(mr.Status, MRClosed); err != nil {
 		return err
 	}
@@ -239,6 +332,9 @@ func (mr *MergeRequest) Reopen() error {
 		return fmt.Errorf("%w: can only reopen from in_progress, current status is %s",
 			ErrInvalidTransition, mr.Status)
 	}
+	if err := validateMRStatusTransition(mr.lifecycleStatus(), mrLifecycleOpen); err != nil {
+		return err
+	}
 	mr.Status = MROpen
 	mr.CloseReason = "" // Clear any previous close reason
 	return nil
@@ -255,6 +351,11 @@ func (mr *MergeRequest) Claim() error {
 	return nil
 }
 
+// HasConflict returns true if the MR is waiting on a conflict-resolution task.
+func (mr *MergeRequest) HasConflict() bool {
+	return mr.ConflictTaskID != ""
+}
+
 // IsClosed returns true if the MR is in a closed state.
 func (mr *MergeRequest) IsClosed() bool {
 	return mr.Status == MRClosed
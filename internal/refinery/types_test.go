@@ -94,6 +94,48 @@ func TestValidateTransition(t *testing.T) {
 	}
 }
 
+func TestValidateMRStatusTransition(t *testing.T) {
+	cases := []struct {
+		name    string
+		current string
+		target  string
+		wantErr bool
+	}{
+		{name: "open to merged", current: "open", target: "merged", wantErr: false},
+		{name: "open to closed", current: "open", target: "closed", wantErr: false},
+		{name: "open to failed", current: "open", target: "failed", wantErr: false},
+		{name: "failed to open (retry)", current: "failed", target: "open", wantErr: false},
+		{name: "failed to closed", current: "failed", target: "closed", wantErr: false},
+		{name: "same open", current: "open", target: "open", wantErr: false},
+		{name: "same merged", current: "merged", target: "merged", wantErr: false},
+		{name: "whitespace and case", current: " Open ", target: "MERGED", wantErr: false},
+		{name: "merged to open", current: "merged", target: "open", wantErr: true},
+		{name: "merged to closed", current: "merged", target: "closed", wantErr: true},
+		{name: "merged to failed", current: "merged", target: "failed", wantErr: true},
+		{name: "closed to open", current: "closed", target: "open", wantErr: true},
+		{name: "closed to merged", current: "closed", target: "merged", wantErr: true},
+		{name: "failed to merged", current: "failed", target: "merged", wantErr: true},
+		{name: "unknown current", current: "in_progress", target: "closed", wantErr: true},
+		{name: "unknown target", current: "open", target: "archived", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateMRStatusTransition(tc.current, tc.target)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for transition %q -> %q", tc.current, tc.target)
+				}
+				if !errors.Is(err, ErrInvalidTransition) {
+					t.Errorf("error = %v, want ErrInvalidTransition", err)
+				}
+			} else if err != nil {
+				t.Fatalf("expected transition %q -> %q to pass, got %v", tc.current, tc.target, err)
+			}
+		})
+	}
+}
+
 func TestMergeRequest_Claim(t *testing.T) {
 	t.Run("claim from open succeeds", func(t *testing.T) {
 		mr := &MergeRequest{Status: MROpen}
@@ -152,6 +194,19 @@ func TestMergeRequest_Close(t *testing.T) {
 		}
 	})
 
+	t.Run("recorded merge closes only as merged", func(t *testing.T) {
+		mr := &MergeRequest{Status: MROpen, CloseReason: CloseReasonMerged}
+		if err := mr.Close(CloseReasonRejected); !errors.Is(err, ErrInvalidTransition) {
+			t.Errorf("Close(rejected) error = %v, want %v", err, ErrInvalidTransition)
+		}
+		if mr.Status != MROpen {
+			t.Errorf("status = %s after refused close, want %s", mr.Status, MROpen)
+		}
+		if err := mr.Close(CloseReasonMerged); err != nil {
+			t.Errorf("Close(merged) unexpected error: %v", err)
+		}
+	})
+
 	t.Run("close from closed fails", func(t *testing.T) {
 		mr := &MergeRequest{Status: MRClosed, CloseReason: CloseReasonMerged}
 		err := mr.Close(CloseReasonRejected)
@@ -195,8 +250,18 @@ func TestMergeRequest_Reopen(t *testing.T) {
 		}
 	})
 
-	t.Run("reopen clears close reason", func(t *testing.T) {
+	t.Run("reopen with merge recorded fails", func(t *testing.T) {
 		mr := &MergeRequest{Status: MRInProgress, CloseReason: CloseReasonMerged}
+		if err := mr.Reopen(); !errors.Is(err, ErrInvalidTransition) {
+			t.Errorf("Reopen() error = %v, want %v", err, ErrInvalidTransition)
+		}
+		if mr.Status != MRInProgress || mr.CloseReason != CloseReasonMerged {
+			t.Errorf("refused Reopen() changed MR to %s/%s", mr.Status, mr.CloseReason)
+		}
+	})
+
+	t.Run("reopen clears close reason", func(t *testing.T) {
+		mr := &MergeRequest{Status: MRInProgress, CloseReason: CloseReasonRejected}
 		err := mr.Reopen()
 		if err != nil {
 			t.Errorf("Reopen() unexpected error: %v", err)
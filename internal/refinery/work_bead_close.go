@@ -136,13 +136,13 @@ func cleanWorkBeadID(id string) string {
 }
 
 func refineryMergedWorkBeadCloseBlockReason(issue *beads.Issue) string {
-	if fields := beads.ParseAttachmentFields(issue); fields != nil {
+	if fields := issue.Attachment(); fields != nil {
 		switch {
 		case fields.NoMerge:
 			return "no_merge"
 		case fields.ReviewOnly:
 			return "review_only"
-		case strings.EqualFold(strings.TrimSpace(fields.MergeStrategy), "local"):
+		case strings.EqualFold(strings.TrimSpace(fields.MergeStrategy), beads.MergeStrategyLocal):
 			return "merge_strategy:local"
 		}
 	}
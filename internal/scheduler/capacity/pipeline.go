@@ -1,6 +1,9 @@
 package capacity
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 // PendingBead represents a bead that is scheduled and ready for dispatch evaluation.
 type PendingBead struct {
@@ -29,17 +32,52 @@ type SlingContextFields struct {
 	Convoy           string `json:"convoy,omitempty"`
 	BaseBranch       string `json:"base_branch,omitempty"`
 	ResumeBranch     string `json:"resume_branch,omitempty"`
+	TargetBranch     string `json:"target_branch,omitempty"`
 	NoMerge          bool   `json:"no_merge,omitempty"`
 	ReviewOnly       bool   `json:"review_only,omitempty"`
 	Account          string `json:"account,omitempty"`
 	Agent            string `json:"agent,omitempty"`
 	HookRawBead      bool   `json:"hook_raw_bead,omitempty"`
+	NoBoot           bool   `json:"no_boot,omitempty"`
 	Owned            bool   `json:"owned,omitempty"`
 	Mode             string `json:"mode,omitempty"`
 	DispatchFailures int    `json:"dispatch_failures,omitempty"`
 	LastFailure      string `json:"last_failure,omitempty"`
 }
 
+// Validate checks that the fields are complete and coherent enough to
+// dispatch. It is called before a sling context is created so that a bead
+// with contradictory options is rejected at schedule time rather than
+// failing confusingly when the scheduler slings it.
+//
+// Convoy is not required when Owned is set: the auto-convoy is attached to
+// the context after it is created.
+func (f *SlingContextFields) Validate() error {
+	if f.WorkBeadID == "" {
+		return fmt.Errorf("work_bead_id is required")
+	}
+	if f.TargetRig == "" {
+		return fmt.Errorf("target_rig is required")
+	}
+	if f.NoMerge && f.Merge != "" {
+		return fmt.Errorf("no_merge conflicts with merge strategy %q", f.Merge)
+	}
+	if f.Mode != "" && f.Mode != "ralph" {
+		return fmt.Errorf("unknown mode %q", f.Mode)
+	}
+	return nil
+}
+
+// ValidateOwnedConvoy rejects owned without a convoy. Owned only marks the
+// auto-convoy as caller-managed, which --no-convoy suppresses. Both direct and
+// scheduled sling call it, alongside Validate for the latter.
+func ValidateOwnedConvoy(owned, noConvoy bool) error {
+	if owned && noConvoy {
+		return fmt.Errorf("--owned requires a convoy and cannot be combined with --no-convoy")
+	}
+	return nil
+}
+
 // LabelSlingContext is the label used to identify sling context beads.
 const LabelSlingContext = "gt:sling-context"
 
@@ -222,12 +260,14 @@ type DispatchParams struct {
 	Merge        string
 	BaseBranch   string
 	ResumeBranch string
+	TargetBranch string
 	Account      string
 	Agent        string
 	Mode         string
 	NoMerge      bool
 	ReviewOnly   bool
 	HookRawBead  bool
+	NoBoot       bool
 }
 
 // ReconstructFromContext builds DispatchParams from sling context fields.
@@ -240,12 +280,14 @@ func ReconstructFromContext(ctx *SlingContextFields) DispatchParams {
 		Merge:        ctx.Merge,
 		BaseBranch:   ctx.BaseBranch,
 		ResumeBranch: ctx.ResumeBranch,
+		TargetBranch: ctx.TargetBranch,
 		Account:      ctx.Account,
 		Agent:        ctx.Agent,
 		Mode:         ctx.Mode,
 		NoMerge:      ctx.NoMerge,
 		ReviewOnly:   ctx.ReviewOnly,
 		HookRawBead:  ctx.HookRawBead,
+		NoBoot:       ctx.NoBoot,
 	}
 	if ctx.Vars != "" {
 		p.Vars = splitVars(ctx.Vars)
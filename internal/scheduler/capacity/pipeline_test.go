@@ -164,19 +164,21 @@ func TestNoRetryPolicy(t *testing.T) {
 
 func TestReconstructFromContext(t *testing.T) {
 	ctx := &SlingContextFields{
-		WorkBeadID:  "bead-123",
-		TargetRig:   "prod-rig",
-		Formula:     "mol-polecat-work",
-		Args:        "do stuff",
-		Vars:        "x=1\ny=2",
-		Merge:       "mr",
-		BaseBranch:  "main",
-		Account:     "acme",
-		Agent:       "codex",
-		Mode:        "ralph",
-		NoMerge:     true,
-		ReviewOnly:  true,
-		HookRawBead: true,
+		WorkBeadID:   "bead-123",
+		TargetRig:    "prod-rig",
+		Formula:      "mol-polecat-work",
+		Args:         "do stuff",
+		Vars:         "x=1\ny=2",
+		Merge:        "mr",
+		BaseBranch:   "main",
+		TargetBranch: "release/v2",
+		Account:      "acme",
+		Agent:        "codex",
+		Mode:         "ralph",
+		NoMerge:      true,
+		ReviewOnly:   true,
+		HookRawBead:  true,
+		NoBoot:       true,
 	}
 
 	params := ReconstructFromContext(ctx)
@@ -202,6 +204,9 @@ func TestReconstructFromContext(t *testing.T) {
 	if params.BaseBranch != "main" {
 		t.Errorf("BaseBranch: got %q, want %q", params.BaseBranch, "main")
 	}
+	if params.TargetBranch != "release/v2" {
+		t.Errorf("TargetBranch: got %q, want %q", params.TargetBranch, "release/v2")
+	}
 	if params.Account != "acme" {
 		t.Errorf("Account: got %q, want %q", params.Account, "acme")
 	}
@@ -220,6 +225,9 @@ func TestReconstructFromContext(t *testing.T) {
 	if !params.HookRawBead {
 		t.Error("HookRawBead: expected true")
 	}
+	if !params.NoBoot {
+		t.Error("NoBoot: expected true")
+	}
 }
 
 func TestReconstructFromContext_EmptyVars(t *testing.T) {
@@ -358,3 +366,56 @@ func TestSplitVars(t *testing.T) {
 		})
 	}
 }
+
+func TestSlingContextFieldsValidate(t *testing.T) {
+	valid := func() *SlingContextFields {
+		return &SlingContextFields{Version: 1, WorkBeadID: "gt-abc", TargetRig: "gastown"}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(f *SlingContextFields)
+		wantErr string
+	}{
+		{"minimal", func(f *SlingContextFields) {}, ""},
+		{"merge strategy", func(f *SlingContextFields) { f.Merge = "direct" }, ""},
+		{"no merge", func(f *SlingContextFields) { f.NoMerge = true }, ""},
+		{"owned before convoy attached", func(f *SlingContextFields) { f.Owned = true }, ""},
+		{"ralph mode", func(f *SlingContextFields) { f.Mode = "ralph" }, ""},
+		{"missing work bead", func(f *SlingContextFields) { f.WorkBeadID = "" }, "work_bead_id is required"},
+		{"missing target rig", func(f *SlingContextFields) { f.TargetRig = "" }, "target_rig is required"},
+		{"no merge with merge strategy", func(f *SlingContextFields) {
+			f.NoMerge = true
+			f.Merge = "mr"
+		}, `no_merge conflicts with merge strategy "mr"`},
+		{"unknown mode", func(f *SlingContextFields) { f.Mode = "loop" }, `unknown mode "loop"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := valid()
+			tt.mutate(f)
+			err := f.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateOwnedConvoy(t *testing.T) {
+	if err := ValidateOwnedConvoy(true, false); err != nil {
+		t.Errorf("owned with convoy: %v", err)
+	}
+	if err := ValidateOwnedConvoy(false, true); err != nil {
+		t.Errorf("no convoy, not owned: %v", err)
+	}
+	if err := ValidateOwnedConvoy(true, true); err == nil || !strings.Contains(err.Error(), "--no-convoy") {
+		t.Errorf("owned with --no-convoy = %v, want rejection", err)
+	}
+}
@@ -2,6 +2,7 @@
 package session
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -20,6 +21,42 @@ const (
 	RoleDog      Role = "dog"
 )
 
+// ErrNotASession is returned by ParseSessionName for bead IDs that can appear
+// alongside session names but never name a session (convoys, molecules,
+// merge requests). Callers should skip these rather than act on them.
+var ErrNotASession = errors.New("not a session name")
+
+// nonSessionKind reports which kind of bead ID name looks like, or "" if it
+// doesn't match a known non-session shape:
+//   - hq-cv-<id> → convoy
+//   - mol-<name>, <prefix>-mol-<id> → molecule
+//   - <prefix>-mr-<id> → merge request
+//
+// Only convoys are rejected up front; the molecule and merge-request shapes
+// are checked after session parsing fails, since a rig prefixed "mol" or a
+// polecat named mr-* produces real session names with the same shape.
+func nonSessionKind(name string) string {
+	if isConvoyID(name) {
+		return "convoy"
+	}
+	if strings.HasPrefix(name, "mol-") {
+		return "molecule"
+	}
+	_, rest, _ := strings.Cut(name, "-")
+	switch {
+	case strings.HasPrefix(rest, "mol-"):
+		return "molecule"
+	case strings.HasPrefix(rest, "mr-"):
+		return "merge-request"
+	}
+	return ""
+}
+
+// isConvoyID reports whether name is in the town's hq-cv- convoy namespace.
+func isConvoyID(name string) bool {
+	return strings.HasPrefix(name, HQPrefix+"cv-")
+}
+
 // AgentIdentity represents a parsed Gas Town agent identity.
 type AgentIdentity struct {
 	Role   Role   // mayor, deacon, witness, refinery, crew, polecat, dog
@@ -94,6 +131,11 @@ func ParseAddress(address string) (*AgentIdentity, error) {
 //   - <prefix>-crew-<name> → Role: crew (e.g., gt-crew-max for gastown)
 //   - <prefix>-<name> → Role: polecat (e.g., gt-furiosa for gastown)
 //
+// Convoy IDs (hq-cv-abc) return an error wrapping ErrNotASession. So do
+// molecule and merge-request IDs (mol-xyz, ab-mol-xyz, ab-mr-123) whose
+// prefix is not a registered rig; with a registered prefix they parse as
+// the sessions they spell (e.g. gt-mr-smith is polecat mr-smith).
+//
 // The prefix is the rig's beads prefix (e.g., "gt" for gastown, "dolt" for beads).
 // The rig name is resolved from the default PrefixRegistry. If the prefix is
 // not in the registry, the prefix itself is used as the rig name.
@@ -108,6 +150,10 @@ func ParseSessionNameWithRegistry(session string, registry *PrefixRegistry) (*Ag
 		registry = NewPrefixRegistry()
 	}
 
+	if isConvoyID(session) {
+		return nil, fmt.Errorf("%w: %q is a convoy ID", ErrNotASession, session)
+	}
+
 	// Check for town-level roles (hq- prefix).
 	// Note: "hq" may also be a registered rig prefix (e.g., knjn uses "hq").
 	// Known town-level roles are matched first; unknown suffixes fall through
@@ -141,6 +187,9 @@ func ParseSessionNameWithRegistry(session string, registry *PrefixRegistry) (*Ag
 	// Use registry to identify the prefix boundary
 	prefix, rest, _ := registry.matchPrefix(session)
 	if prefix == "" || rest == "" {
+		if kind := nonSessionKind(session); kind != "" {
+			return nil, fmt.Errorf("%w: %q is a %s ID", ErrNotASession, session, kind)
+		}
 		return nil, fmt.Errorf("invalid session name %q: cannot determine prefix", session)
 	}
 
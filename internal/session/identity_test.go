@@ -1,6 +1,8 @@
 package session
 
 import (
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -395,6 +397,80 @@ func TestParseSessionName_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestParseSessionName_NonSessionIDs(t *testing.T) {
+	reg := testRegistry()
+	old := DefaultRegistry()
+	SetDefaultRegistry(reg)
+	defer func() { SetDefaultRegistry(old) }()
+
+	tests := []struct {
+		id   string
+		kind string
+	}{
+		{"hq-cv-abc", "convoy"},
+		{"hq-cv-x7k2m", "convoy"},
+		{"mol-polecat-work", "molecule"},
+		{"gp-mol-abc123", "molecule"},
+		{"gp-mr-001", "merge-request"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			identity, err := ParseSessionName(tt.id)
+			if !errors.Is(err, ErrNotASession) {
+				t.Fatalf("ParseSessionName(%q) error = %v, want ErrNotASession", tt.id, err)
+			}
+			if identity != nil {
+				t.Errorf("ParseSessionName(%q) identity = %+v, want nil", tt.id, identity)
+			}
+			if !strings.Contains(err.Error(), tt.kind) {
+				t.Errorf("error %q should name the ID kind %q", err, tt.kind)
+			}
+		})
+	}
+}
+
+func TestParseSessionName_SessionsNotMisclassified(t *testing.T) {
+	// Names that merely contain the marker letters must still parse.
+	for _, sess := range []string{"gt-mrsmith", "gt-molly", "hq-cvs", "gt-crew-mr-x"} {
+		if _, err := ParseSessionNameWithRegistry(sess, testRegistry()); errors.Is(err, ErrNotASession) {
+			t.Errorf("ParseSessionName(%q) = ErrNotASession, want a session", sess)
+		}
+	}
+}
+
+func TestParseSessionName_CollidingNamesAreSessions(t *testing.T) {
+	// A rig prefixed "mol" and polecats named mol-*/mr-* share the shape of
+	// molecule and merge-request IDs, but the registry parse claims them.
+	reg := testRegistry()
+	reg.Register("mol", "molasses")
+
+	tests := []struct {
+		session  string
+		wantRole Role
+		wantRig  string
+		wantName string
+	}{
+		{"mol-witness", RoleWitness, "molasses", ""},
+		{"mol-crew-max", RoleCrew, "molasses", "max"},
+		{"mol-polecat-work", RolePolecat, "molasses", "polecat-work"},
+		{"gt-mr-smith", RolePolecat, "gastown", "mr-smith"},
+		{"gt-mol-ly", RolePolecat, "gastown", "mol-ly"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.session, func(t *testing.T) {
+			identity, err := ParseSessionNameWithRegistry(tt.session, reg)
+			if err != nil {
+				t.Fatalf("ParseSessionName(%q) error = %v, want a session", tt.session, err)
+			}
+			if identity.Role != tt.wantRole || identity.Rig != tt.wantRig || identity.Name != tt.wantName {
+				t.Errorf("ParseSessionName(%q) = %+v, want role=%s rig=%s name=%s",
+					tt.session, identity, tt.wantRole, tt.wantRig, tt.wantName)
+			}
+		})
+	}
+}
+
 func TestParseAddress(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -148,6 +148,29 @@ func InitRegistry(townRoot string) error {
 	return errors.Join(errs...)
 }
 
+// ReloadRegistry re-reads rigs.json and settings/agents.json for a running
+// process and swaps the result into the default registries, so a rig added
+// after startup resolves without a restart. The new prefix registry is built
+// completely before the swap; readers see either the old or the new one,
+// never a partial state. On a rigs.json error the previous registry is kept.
+// Unlike InitRegistry, the tmux socket is left untouched.
+func ReloadRegistry(townRoot string) error {
+	var errs []error
+
+	r, err := BuildPrefixRegistryFromTown(townRoot)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("prefix registry: %w", err))
+	} else {
+		SetDefaultRegistry(r)
+	}
+
+	if err := config.ReloadAgentRegistry(config.DefaultAgentRegistryPath(townRoot)); err != nil {
+		errs = append(errs, fmt.Errorf("agent registry: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
 // sanitizeRe matches non-alphanumeric, non-hyphen characters.
 var sanitizeRe = regexp.MustCompile(`[^a-z0-9-]+`)
 
@@ -191,6 +214,15 @@ func sanitizeTownName(name string) string {
 	return name
 }
 
+// sanitizeTownNameChecked is sanitizeTownName plus a flag reporting whether
+// the result differs from the input. A lossy result means distinct town names
+// may collapse to the same value (e.g. "" and "!!!!" both become "default"),
+// so callers can warn instead of colliding silently.
+func sanitizeTownNameChecked(input string) (string, bool) {
+	name := sanitizeTownName(input)
+	return name, name != input
+}
+
 // PrefixFor returns the beads prefix for a rig, using the default registry.
 // Returns DefaultPrefix if the rig is unknown.
 func PrefixFor(rigName string) string {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/steveyegge/gastown/internal/config"
@@ -120,3 +121,93 @@ func TestInitRegistryNoAgentsJSON(t *testing.T) {
 		t.Fatalf("InitRegistry with no agents.json: %v", err)
 	}
 }
+
+func TestReloadRegistryPicksUpNewRig(t *testing.T) {
+	// NOTE: cannot use t.Parallel() — mutates global registries.
+	old := DefaultRegistry()
+	defer SetDefaultRegistry(old)
+	config.ResetRegistryForTesting()
+	t.Cleanup(config.ResetRegistryForTesting)
+
+	townRoot := t.TempDir()
+	mayorDir := filepath.Join(townRoot, "mayor")
+	if err := os.MkdirAll(mayorDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeRigs := func(body string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(mayorDir, "rigs.json"), []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeRigs(`{"rigs":{"gastown":{"beads":{"prefix":"gt"}}}}`)
+	if err := InitRegistry(townRoot); err != nil {
+		t.Fatalf("InitRegistry: %v", err)
+	}
+	if got := PrefixFor("newrig"); got != DefaultPrefix {
+		t.Fatalf("PrefixFor(newrig) before reload = %q, want %q", got, DefaultPrefix)
+	}
+
+	// Readers hammer the default registry while the reload swaps it.
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					_ = PrefixFor("gastown")
+					_ = IsKnownSession("nr-worker")
+				}
+			}
+		}()
+	}
+
+	writeRigs(`{"rigs":{"gastown":{"beads":{"prefix":"gt"}},"newrig":{"beads":{"prefix":"nr"}}}}`)
+	err := ReloadRegistry(townRoot)
+	close(done)
+	wg.Wait()
+	if err != nil {
+		t.Fatalf("ReloadRegistry: %v", err)
+	}
+
+	if got := PrefixFor("newrig"); got != "nr" {
+		t.Errorf("PrefixFor(newrig) after reload = %q, want %q", got, "nr")
+	}
+	if got := PrefixFor("gastown"); got != "gt" {
+		t.Errorf("PrefixFor(gastown) after reload = %q, want %q", got, "gt")
+	}
+	if !IsKnownSession("nr-worker") {
+		t.Error("expected nr-worker to be known after reload")
+	}
+}
+
+func TestReloadRegistryKeepsOldRegistryOnError(t *testing.T) {
+	old := DefaultRegistry()
+	defer SetDefaultRegistry(old)
+
+	r := NewPrefixRegistry()
+	r.Register("xy", "xrig")
+	SetDefaultRegistry(r)
+
+	townRoot := t.TempDir()
+	mayorDir := filepath.Join(townRoot, "mayor")
+	if err := os.MkdirAll(mayorDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(mayorDir, "rigs.json"), []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ReloadRegistry(townRoot); err == nil {
+		t.Fatal("expected error for malformed rigs.json")
+	}
+	if got := DefaultRegistry(); got != r {
+		t.Fatal("ReloadRegistry replaced the registry despite a load error")
+	}
+}
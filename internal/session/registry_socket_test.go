@@ -192,6 +192,37 @@ func TestSanitizeTownName(t *testing.T) {
 	}
 }
 
+func TestSanitizeTownNameChecked(t *testing.T) {
+	tests := []struct {
+		input     string
+		want      string
+		wantLossy bool
+	}{
+		{"mytown", "mytown", false},
+		{"my-town-123", "my-town-123", false},
+		{"default", "default", false},
+		{"MyTown", "mytown", true},
+		{"my town", "my-town", true},
+		{"-mytown-", "mytown", true},
+		{"café", "caf", true},
+		{"", "default", true},
+		{"!!!!", "default", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, lossy := sanitizeTownNameChecked(tt.input)
+			if got != tt.want || lossy != tt.wantLossy {
+				t.Errorf("sanitizeTownNameChecked(%q) = (%q, %v), want (%q, %v)",
+					tt.input, got, lossy, tt.want, tt.wantLossy)
+			}
+			if plain := sanitizeTownName(tt.input); plain != got {
+				t.Errorf("sanitizeTownName(%q) = %q, differs from checked %q", tt.input, plain, got)
+			}
+		})
+	}
+}
+
 func TestTownSocketName(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 	"github.com/steveyegge/gastown/internal/ui"
 )
 
@@ -51,6 +52,29 @@ var (
 	ArrowPrefix = Info.Render("→")
 )
 
+// SetColorEnabled explicitly turns styling on or off for all styles,
+// overriding NO_COLOR and TTY detection. The pre-rendered prefixes are
+// re-rendered so they match the new setting.
+func SetColorEnabled(enabled bool) {
+	if enabled {
+		lipgloss.SetColorProfile(termenv.TrueColor)
+	} else {
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+
+	SuccessPrefix = Success.Render(ui.IconPass)
+	WarningPrefix = Warning.Render(ui.IconWarn)
+	ErrorPrefix = Error.Render(ui.IconFail)
+	ArrowPrefix = Info.Render("→")
+}
+
+// ColorEnabled reports whether styles currently emit ANSI escape codes. The
+// initial setting comes from the color profile internal/ui picks at init
+// (NO_COLOR, CLICOLOR, non-TTY stdout).
+func ColorEnabled() bool {
+	return lipgloss.ColorProfile() != termenv.Ascii
+}
+
 // PrintWarning prints a warning message to stderr with consistent formatting.
 // The format and args work like fmt.Printf.
 // Writes to stderr so warnings never contaminate structured (JSON) output on stdout.
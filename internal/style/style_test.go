@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"strings"
 	"testing"
+
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 func TestStyleVariables(t *testing.T) {
@@ -196,3 +199,51 @@ func ExamplePrintWarning() {
 	PrintWarning("This is a warning message")
 	PrintWarning("Warning with value: %d", 42)
 }
+
+func TestSetColorEnabled(t *testing.T) {
+	orig := ColorEnabled()
+	t.Cleanup(func() { SetColorEnabled(orig) })
+
+	SetColorEnabled(false)
+	if ColorEnabled() {
+		t.Error("ColorEnabled() should be false after SetColorEnabled(false)")
+	}
+	for name, got := range map[string]string{
+		"Bold":          Bold.Render("text"),
+		"Dim":           Dim.Render("text"),
+		"Success":       Success.Render("text"),
+		"SuccessPrefix": SuccessPrefix,
+	} {
+		if strings.Contains(got, "\x1b[") {
+			t.Errorf("%s should not contain escape codes when color is disabled: %q", name, got)
+		}
+	}
+	if Bold.Render("text") != "text" {
+		t.Errorf("Bold.Render() = %q, want plain %q", Bold.Render("text"), "text")
+	}
+
+	SetColorEnabled(true)
+	if !ColorEnabled() {
+		t.Error("ColorEnabled() should be true after SetColorEnabled(true)")
+	}
+	if !strings.Contains(Bold.Render("text"), "\x1b[") {
+		t.Errorf("Bold.Render() should contain escape codes when color is enabled: %q", Bold.Render("text"))
+	}
+	if !strings.Contains(SuccessPrefix, "\x1b[") {
+		t.Errorf("SuccessPrefix should be re-rendered with color: %q", SuccessPrefix)
+	}
+}
+
+func TestColorDisabledByNoColor(t *testing.T) {
+	orig := ColorEnabled()
+	t.Cleanup(func() { SetColorEnabled(orig) })
+
+	t.Setenv("NO_COLOR", "1")
+	SetColorEnabled(ui.ShouldUseColor())
+	if ColorEnabled() {
+		t.Error("NO_COLOR should disable styling")
+	}
+	if got := Warning.Render("warn"); got != "warn" {
+		t.Errorf("Warning.Render() = %q, want plain text under NO_COLOR", got)
+	}
+}
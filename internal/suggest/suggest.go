@@ -179,6 +179,20 @@ func commonChars(a, b string) int {
 	return common
 }
 
+// Closest returns the candidate with the smallest edit distance to target
+// (case-insensitive), or "" if none is within maxDistance. Ties keep the
+// earlier candidate.
+func Closest(target string, candidates []string, maxDistance int) string {
+	target = strings.ToLower(target)
+	best, bestDist := "", maxDistance+1
+	for _, c := range candidates {
+		if d := levenshteinDistance(target, strings.ToLower(c)); d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	return best
+}
+
 // levenshteinDistance calculates the edit distance between two strings.
 func levenshteinDistance(a, b string) int {
 	if len(a) == 0 {
@@ -99,6 +99,29 @@ func TestLevenshteinDistance(t *testing.T) {
 	}
 }
 
+func TestClosest(t *testing.T) {
+	candidates := []string{"workflow", "convoy", "expansion", "aspect"}
+	tests := []struct {
+		target string
+		max    int
+		want   string
+	}{
+		{"workfow", 2, "workflow"},
+		{"Convoi", 2, "convoy"},
+		{"aspects", 2, "aspect"},
+		{"invalid", 2, ""},
+		{"workfow", 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			if got := Closest(tt.target, candidates, tt.max); got != tt.want {
+				t.Errorf("Closest(%q, max=%d) = %q, want %q", tt.target, tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestFormatSuggestion(t *testing.T) {
 	msg := FormatSuggestion("Polecat", "Tosat", []string{"Toast", "Ghost"}, "Create with: gt polecat add Tosat")
 
@@ -91,6 +91,7 @@ type recorderInstruments struct {
 	polecatTotal          metric.Int64Counter
 	polecatRemoveTotal    metric.Int64Counter
 	slingTotal            metric.Int64Counter
+	slingDispatchTotal    metric.Int64Counter
 	mailTotal             metric.Int64Counter
 	nudgeTotal            metric.Int64Counter
 	doneTotal             metric.Int64Counter
@@ -183,6 +184,9 @@ func initInstruments() {
 		inst.slingTotal, _ = m.Int64Counter("gastown.sling.dispatches.total",
 			metric.WithDescription("Total sling work dispatches"),
 		)
+		inst.slingDispatchTotal, _ = m.Int64Counter("gastown.sling.executions.total",
+			metric.WithDescription("Total per-bead rig dispatches (batch sling and queue dispatch)"),
+		)
 		inst.mailTotal, _ = m.Int64Counter("gastown.mail.operations.total",
 			metric.WithDescription("Total mail/bd SDK operations"),
 		)
@@ -567,6 +571,27 @@ func RecordSling(ctx context.Context, bead, target string, err error) {
 	)
 }
 
+// RecordSlingDispatch records one per-bead rig dispatch from executeSling
+// (batch sling and queue dispatch), including whether it auto-created a
+// convoy (metrics + log event).
+func RecordSlingDispatch(ctx context.Context, beadID, rig string, convoyCreated bool, err error) {
+	initInstruments()
+	status := statusStr(err)
+	inst.slingDispatchTotal.Add(ctx, 1,
+		metric.WithAttributes(
+			attribute.String("status", status),
+			attribute.Bool("convoy_created", convoyCreated),
+		),
+	)
+	emit(ctx, "sling.dispatch", severity(err),
+		otellog.String("bead_id", beadID),
+		otellog.String("rig", rig),
+		otellog.Bool("convoy_created", convoyCreated),
+		otellog.String("status", status),
+		errKV(err),
+	)
+}
+
 // RecordMail records a mail/bd SDK operation (metrics + log event).
 func RecordMail(ctx context.Context, operation string, err error) {
 	initInstruments()
@@ -204,6 +204,14 @@ func TestRecordSling(t *testing.T) {
 	RecordSling(ctx, "bead-def", "nux", errors.New("sling error"))
 }
 
+func TestRecordSlingDispatch(t *testing.T) {
+	resetInstruments(t)
+	ctx := context.Background()
+
+	RecordSlingDispatch(ctx, "bead-abc", "gastown", true, nil)
+	RecordSlingDispatch(ctx, "bead-def", "gastown", false, errors.New("dispatch error"))
+}
+
 func TestRecordMail(t *testing.T) {
 	resetInstruments(t)
 	ctx := context.Background()
@@ -4,6 +4,7 @@ package tmux
 
 import (
 	"os/exec"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -15,6 +16,31 @@ func killProcessGroup(pgid int) {
 	_ = syscall.Kill(-pgid, syscall.SIGKILL)
 }
 
+// sigkillProcessGroup sends SIGKILL to every process in the group, with no
+// SIGTERM first. Used when a group member has already ignored SIGTERM.
+func sigkillProcessGroup(pgid int) {
+	_ = syscall.Kill(-pgid, syscall.SIGKILL)
+}
+
+// processAlive reports whether pid names a running process. Existence comes
+// from kill(pid, 0), so a failing ps never makes a live process look dead and
+// skip its SIGKILL. ps is consulted only to spot zombies, which count as
+// exited: they hold no resources and only wait for their parent to reap them.
+func processAlive(pid string) bool {
+	n, err := strconv.Atoi(strings.TrimSpace(pid))
+	if err != nil || n <= 0 {
+		return false
+	}
+	if err := syscall.Kill(n, 0); err == syscall.ESRCH {
+		return false
+	}
+	out, err := exec.Command("ps", "-o", "stat=", "-p", pid).Output()
+	if err != nil {
+		return true
+	}
+	return !strings.HasPrefix(strings.TrimSpace(string(out)), "Z")
+}
+
 // getParentPID returns the parent process ID (PPID) for a given PID.
 // Returns empty string if the process doesn't exist or PPID can't be determined.
 func getParentPID(pid string) string {
@@ -0,0 +1,55 @@
+//go:build !windows
+
+package tmux
+
+import (
+	"fmt"
+	"os/exec"
+	"syscall"
+	"testing"
+)
+
+func TestProcessAlive(t *testing.T) {
+	cmd := exec.Command("sleep", "300")
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("start sleep: %v", err)
+	}
+	pid := fmt.Sprint(cmd.Process.Pid)
+	reaped := false
+	defer func() {
+		if !reaped {
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+		}
+	}()
+
+	if !processAlive(pid) {
+		t.Errorf("processAlive(%s) = false for a running process", pid)
+	}
+
+	// Without ps the process must still count as alive, or terminateProcesses
+	// would skip its SIGKILL.
+	t.Run("ps unavailable", func(t *testing.T) {
+		t.Setenv("PATH", t.TempDir())
+		if !processAlive(pid) {
+			t.Errorf("processAlive(%s) = false when ps is unavailable", pid)
+		}
+	})
+
+	// Killed but not yet reaped: a zombie counts as exited.
+	if err := cmd.Process.Signal(syscall.SIGKILL); err != nil {
+		t.Fatalf("kill sleep: %v", err)
+	}
+	if alive := waitForProcessesExit([]string{pid}, processKillGracePeriod); len(alive) != 0 {
+		t.Errorf("zombie %s still reported alive", pid)
+	}
+
+	_ = cmd.Wait()
+	reaped = true
+	if processAlive(pid) {
+		t.Errorf("processAlive(%s) = true after the process was reaped", pid)
+	}
+	if processAlive("not-a-pid") {
+		t.Error(`processAlive("not-a-pid") = true`)
+	}
+}
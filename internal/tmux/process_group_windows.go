@@ -18,6 +18,22 @@ func killProcessGroup(pgid int) {
 	_ = proc.Kill()
 }
 
+// sigkillProcessGroup force-kills the process. Windows has no POSIX process
+// groups, so this is the same as killProcessGroup.
+func sigkillProcessGroup(pgid int) {
+	killProcessGroup(pgid)
+}
+
+// processAlive reports whether pid names a running process.
+func processAlive(pid string) bool {
+	n, err := strconv.Atoi(strings.TrimSpace(pid))
+	if err != nil || n <= 0 {
+		return false
+	}
+	exists, err := processExists(n)
+	return err == nil && exists
+}
+
 // getParentPID returns the parent process ID (PPID) for a given PID.
 // On Windows, this is not used for PGID verification, so we return empty string.
 func getParentPID(pid string) string {
@@ -1,6 +1,7 @@
 package tmux
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -39,6 +40,37 @@ func TestNewTmuxWithSocket(t *testing.T) {
 	}
 }
 
+func TestNewTmuxWithSocket_ValidNames(t *testing.T) {
+	for _, name := range []string{"default", "custom", "gt-test-1234", "gt-a1b2c3", "my_town.v2"} {
+		tmx := NewTmuxWithSocket(name)
+		if tmx.socketErr != nil {
+			t.Errorf("NewTmuxWithSocket(%q) socketErr = %v, want nil", name, tmx.socketErr)
+		}
+		if tmx.socketName != name {
+			t.Errorf("NewTmuxWithSocket(%q) socketName = %q", name, tmx.socketName)
+		}
+	}
+}
+
+func TestNewTmuxWithSocket_RejectsBadNames(t *testing.T) {
+	for _, name := range []string{"", ".", "..", "../escape", "nested/sock", `win\sock`, "/tmp/abs", "has space", "tab\tname", "nl\n"} {
+		if err := ValidateSocketName(name); !errors.Is(err, ErrInvalidSocket) {
+			t.Errorf("ValidateSocketName(%q) = %v, want ErrInvalidSocket", name, err)
+		}
+		tmx := NewTmuxWithSocket(name)
+		// Operations fail before tmux is ever invoked.
+		if _, err := tmx.run("list-sessions"); !errors.Is(err, ErrInvalidSocket) {
+			t.Errorf("NewTmuxWithSocket(%q).run() error = %v, want ErrInvalidSocket", name, err)
+		}
+		if _, err := tmx.HasSession("any"); !errors.Is(err, ErrInvalidSocket) {
+			t.Errorf("NewTmuxWithSocket(%q).HasSession() error = %v, want ErrInvalidSocket", name, err)
+		}
+		if err := tmx.createNewSession("any", "", nil); !errors.Is(err, ErrInvalidSocket) {
+			t.Errorf("NewTmuxWithSocket(%q).createNewSession() error = %v, want ErrInvalidSocket", name, err)
+		}
+	}
+}
+
 func TestBuildCommandNoSocket(t *testing.T) {
 	orig := defaultSocket
 	defer func() { defaultSocket = orig }()
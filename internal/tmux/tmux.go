@@ -16,6 +16,7 @@ import (
 	"strings"
 	"sync"
 	"time"
+	"unicode"
 
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
@@ -45,7 +46,9 @@ var (
 	ErrSessionNotFound    = errors.New("session not found")
 	ErrSessionRunning     = errors.New("session already running with healthy agent")
 	ErrInvalidSessionName = errors.New("invalid session name")
+	ErrInvalidSocket      = errors.New("invalid tmux socket name")
 	ErrIdleTimeout        = errors.New("agent not idle before timeout")
+	ErrProcessesSurvived  = errors.New("processes survived SIGKILL")
 )
 
 // validateSessionName checks that a session name contains only safe characters.
@@ -182,6 +185,7 @@ func BuildCommandContext(ctx context.Context, args ...string) *exec.Cmd {
 // Tmux wraps tmux operations.
 type Tmux struct {
 	socketName string // tmux socket name (-L flag), empty = default socket
+	socketErr  error  // non-nil when created with an invalid socket name; every operation fails with it
 }
 
 // noTownSocket is a sentinel socket name used when no town socket is configured.
@@ -213,10 +217,36 @@ func NewTmux() *Tmux {
 // This creates/connects to an isolated tmux server, separate from the user's
 // default server. Primarily used in tests to prevent session name collisions
 // and keystroke leaks (e.g. Escape from NudgeSession hitting the user's prefix table).
+//
+// The name must pass ValidateSocketName. An invalid name does not fall back
+// to another server: every operation on the returned Tmux fails with an
+// error wrapping ErrInvalidSocket. Use "default" for tmux's default server.
 func NewTmuxWithSocket(socket string) *Tmux {
+	if err := ValidateSocketName(socket); err != nil {
+		return &Tmux{socketName: socket, socketErr: err}
+	}
 	return &Tmux{socketName: socket}
 }
 
+// ValidateSocketName checks that name is usable with tmux -L: non-empty, and a
+// plain file name that cannot resolve outside the tmux socket directory.
+func ValidateSocketName(name string) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("%w: empty name", ErrInvalidSocket)
+	case name == "." || name == "..":
+		return fmt.Errorf("%w %q", ErrInvalidSocket, name)
+	case strings.ContainsAny(name, `/\`):
+		return fmt.Errorf("%w %q: contains a path separator", ErrInvalidSocket, name)
+	}
+	for _, r := range name {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return fmt.Errorf("%w %q: contains whitespace or control characters", ErrInvalidSocket, name)
+		}
+	}
+	return nil
+}
+
 // run executes a tmux command and returns stdout.
 // All commands include -u flag for UTF-8 support regardless of locale settings.
 // See: https://github.com/steveyegge/gastown/issues/1219
@@ -236,6 +266,9 @@ func (t *Tmux) commandContext(ctx context.Context, args ...string) *exec.Cmd {
 }
 
 func (t *Tmux) runContext(ctx context.Context, args ...string) (string, error) {
+	if t.socketErr != nil {
+		return "", t.socketErr
+	}
 	cmd := t.commandContext(ctx, args...)
 	if _, ok := ctx.Deadline(); ok {
 		cmd.WaitDelay = 100 * time.Millisecond
@@ -278,6 +311,9 @@ func (t *Tmux) wrapError(err error, stderr string, args []string) error {
 }
 
 func (t *Tmux) createNewSession(name, workDir string, env map[string]string) error {
+	if t.socketErr != nil {
+		return t.socketErr
+	}
 	if err := t.ensureNewSessionSocketSafe(); err != nil {
 		return err
 	}
@@ -617,6 +653,14 @@ func (t *Tmux) KillSession(name string) (retErr error) {
 // and caused Claude processes to become orphans when they couldn't shut down in time.
 const processKillGracePeriod = 2 * time.Second
 
+// processKillVerifyTimeout bounds how long KillSessionWithProcesses waits for
+// SIGKILLed processes to disappear before reporting them as survivors.
+const processKillVerifyTimeout = 1 * time.Second
+
+// processExitPollInterval is how often liveness is rechecked while waiting for
+// signalled processes to exit.
+const processExitPollInterval = 100 * time.Millisecond
+
 // KillSessionWithProcesses explicitly kills all processes in a session before terminating it.
 // This prevents orphan processes that survive tmux kill-session due to SIGHUP being ignored.
 //
@@ -624,10 +668,15 @@ const processKillGracePeriod = 2 * time.Second
 // 1. Get the pane's main process PID and its process group ID (PGID)
 // 2. Kill the entire process group (catches reparented processes that stayed in the group)
 // 3. Find all descendant processes from one process snapshot (catches any stragglers)
-// 4. Send SIGTERM/SIGKILL to descendants
-// 5. Kill the pane process itself
+// 4. Send SIGTERM to descendants, then SIGKILL any still alive after the grace period
+// 5. Kill the pane process the same way, SIGKILLing its whole group if it leads one
 // 6. Kill the tmux session
 //
+// A process that ignores SIGTERM cannot stall the kill: every wait is bounded.
+// If anything is still alive processKillVerifyTimeout after SIGKILL, the tmux
+// session is killed anyway and an error wrapping ErrProcessesSurvived is
+// returned naming the surviving PIDs.
+//
 // The process group kill is critical because:
 // - Descendant snapshots only find processes still connected by PPID
 // - Processes that reparent to init (PID 1) are missed by PPID traversal
@@ -675,23 +724,32 @@ func (t *Tmux) KillSessionWithProcesses(name string) error {
 			descendants = append(descendants, reparented...)
 		}
 
-		// Send SIGTERM to all descendants (deepest first to avoid orphaning)
-		for _, dpid := range descendants {
-			_ = exec.Command("kill", "-TERM", dpid).Run()
-		}
+		// Send SIGTERM to all descendants (deepest first to avoid orphaning),
+		// then SIGKILL whatever is still alive once the grace period is up.
+		survivors := terminateProcesses(descendants)
 
-		// Wait for graceful shutdown (2s gives processes time to clean up)
-		time.Sleep(processKillGracePeriod)
-
-		// Send SIGKILL to any remaining descendants
-		for _, dpid := range descendants {
-			_ = exec.Command("kill", "-KILL", dpid).Run()
+		// Kill the pane process itself (may have called setsid() and detached).
+		// tmux starts each pane in its own session, so when the pane leads its
+		// process group the whole group belongs to this pane and can be
+		// SIGKILLed without hitting unrelated processes.
+		if left := terminateProcesses([]string{pid}); len(left) > 0 {
+			if pgid == pid {
+				if n, convErr := strconv.Atoi(pgid); convErr == nil {
+					sigkillProcessGroup(n)
+				}
+			}
+			survivors = append(survivors, left...)
 		}
 
-		// Kill the pane process itself (may have called setsid() and detached)
-		_ = exec.Command("kill", "-TERM", pid).Run()
-		time.Sleep(processKillGracePeriod)
-		_ = exec.Command("kill", "-KILL", pid).Run()
+		if len(survivors) > 0 {
+			survivors = waitForProcessesExit(survivors, processKillVerifyTimeout)
+		}
+		if len(survivors) > 0 {
+			// Still tear down the session so it no longer shows up, but report
+			// the processes we could not kill.
+			_ = t.KillSession(name)
+			return fmt.Errorf("killing session %s: %w: pids %s", name, ErrProcessesSurvived, strings.Join(survivors, ", "))
+		}
 	}
 
 	// Kill the tmux session
@@ -704,6 +762,43 @@ func (t *Tmux) KillSessionWithProcesses(name string) error {
 	return err
 }
 
+// terminateProcesses sends SIGTERM to pids, waits up to processKillGracePeriod
+// for them to exit, and sends SIGKILL to any that are still alive. It returns
+// the PIDs that were SIGKILLed; callers that need certainty should pass them
+// to waitForProcessesExit.
+func terminateProcesses(pids []string) []string {
+	if len(pids) == 0 {
+		return nil
+	}
+	for _, p := range pids {
+		_ = exec.Command("kill", "-TERM", p).Run()
+	}
+	remaining := waitForProcessesExit(pids, processKillGracePeriod)
+	for _, p := range remaining {
+		_ = exec.Command("kill", "-KILL", p).Run()
+	}
+	return remaining
+}
+
+// waitForProcessesExit polls until every PID in pids has exited or timeout
+// elapses, and returns the PIDs still alive at that point (in input order).
+func waitForProcessesExit(pids []string, timeout time.Duration) []string {
+	deadline := time.Now().Add(timeout)
+	for {
+		var alive []string
+		for _, p := range pids {
+			if processAlive(p) {
+				alive = append(alive, p)
+			}
+		}
+		if len(alive) == 0 || !time.Now().Before(deadline) {
+			return alive
+		}
+		pids = alive
+		time.Sleep(processExitPollInterval)
+	}
+}
+
 // KillSessionWithProcessesExcluding is like KillSessionWithProcesses but excludes
 // specified PIDs from being killed. This is essential for self-kill scenarios where
 // the calling process (e.g., gt done) is running inside the session it's terminating.
@@ -1055,6 +1055,75 @@ func TestKillSessionWithProcesses_KillsProcessGroup(t *testing.T) {
 	}
 }
 
+func TestKillSessionWithProcesses_EscalatesPastIgnoredSIGTERM(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("SIGTERM traps are POSIX-only")
+	}
+	tm := newTestTmux(t)
+	sessionName := "gt-test-killterm-" + t.Name()
+
+	_ = tm.KillSession(sessionName)
+
+	// Both the pane shell and its child ignore SIGTERM (an ignored signal
+	// stays ignored across exec), so only SIGKILL can stop them.
+	cmd := `sh -c 'trap "" TERM; sleep 300 & wait'`
+	if err := tm.NewSessionWithCommand(sessionName, "", cmd); err != nil {
+		t.Fatalf("NewSessionWithCommand: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	time.Sleep(200 * time.Millisecond)
+	pid, err := tm.GetPanePID(sessionName)
+	if err != nil || pid == "" {
+		t.Fatalf("GetPanePID: %q, %v", pid, err)
+	}
+	pids := append([]string{pid}, getAllDescendants(pid)...)
+
+	start := time.Now()
+	if err := tm.KillSessionWithProcesses(sessionName); err != nil {
+		t.Fatalf("KillSessionWithProcesses: %v", err)
+	}
+	// Two bounded grace periods plus verification, with slack for slow CI.
+	if elapsed, limit := time.Since(start), 2*processKillGracePeriod+processKillVerifyTimeout+3*time.Second; elapsed > limit {
+		t.Errorf("KillSessionWithProcesses took %v, want under %v", elapsed, limit)
+	}
+
+	if alive := waitForProcessesExit(pids, processKillVerifyTimeout); len(alive) > 0 {
+		t.Errorf("processes %v survived KillSessionWithProcesses", alive)
+	}
+	if has, _ := tm.HasSession(sessionName); has {
+		t.Error("expected session to not exist after KillSessionWithProcesses")
+	}
+}
+
+func TestWaitForProcessesExit(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses POSIX sleep")
+	}
+	cmd := exec.Command("sleep", "300")
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("start sleep: %v", err)
+	}
+	pid := fmt.Sprint(cmd.Process.Pid)
+	done := make(chan struct{})
+	go func() { _ = cmd.Wait(); close(done) }()
+	defer func() { _ = cmd.Process.Kill(); <-done }()
+
+	start := time.Now()
+	if alive := waitForProcessesExit([]string{pid}, 300*time.Millisecond); len(alive) != 1 || alive[0] != pid {
+		t.Fatalf("waitForProcessesExit on running process = %v, want [%s]", alive, pid)
+	}
+	if elapsed := time.Since(start); elapsed < 300*time.Millisecond {
+		t.Errorf("returned after %v, want to wait out the timeout", elapsed)
+	}
+
+	_ = cmd.Process.Kill()
+	<-done
+	if alive := waitForProcessesExit([]string{pid}, time.Second); len(alive) != 0 {
+		t.Errorf("waitForProcessesExit after kill = %v, want none", alive)
+	}
+}
+
 func TestSessionSet(t *testing.T) {
 	tm := newTestTmux(t)
 	sessionName := "gt-test-sessionset-" + t.Name()
@@ -0,0 +1,67 @@
+package wisp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StatusKey is the wisp config key holding a rig's operational status.
+const StatusKey = "status"
+
+// Status is a rig's operational status in the wisp layer.
+type Status string
+
+// Rig statuses accepted by SetStatus.
+const (
+	StatusActive Status = "active"
+	StatusParked Status = "parked"
+	StatusDocked Status = "docked"
+)
+
+// validStatuses lists the accepted statuses in display order.
+var validStatuses = []Status{StatusActive, StatusParked, StatusDocked}
+
+// IsValid reports whether s is one of the known statuses.
+func (s Status) IsValid() bool {
+	for _, v := range validStatuses {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseStatus validates a user-supplied status. Case and surrounding
+// whitespace are ignored; anything else unknown is an error, so a typo like
+// "paked" cannot silently leave a rig operational.
+func ParseStatus(value string) (Status, error) {
+	s := Status(strings.ToLower(strings.TrimSpace(value)))
+	if !s.IsValid() {
+		return "", invalidStatusError(value)
+	}
+	return s, nil
+}
+
+func invalidStatusError(value string) error {
+	names := make([]string, len(validStatuses))
+	for i, v := range validStatuses {
+		names[i] = string(v)
+	}
+	return fmt.Errorf("invalid rig status %q (valid: %s)", value, strings.Join(names, ", "))
+}
+
+// SetStatus stores the rig's status, rejecting unknown values.
+func (c *Config) SetStatus(s Status) error {
+	if !s.IsValid() {
+		return invalidStatusError(string(s))
+	}
+	return c.Set(StatusKey, string(s))
+}
+
+// Status returns the rig's stored status, lower-cased and trimmed, or "" if
+// unset. Unknown stored values (e.g. written by hand or by an older gt) are
+// returned as-is rather than rejected; callers compare against the Status
+// constants, so an unknown value never matches parked or docked.
+func (c *Config) Status() Status {
+	return Status(strings.ToLower(strings.TrimSpace(c.GetString(StatusKey))))
+}
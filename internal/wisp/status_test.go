@@ -0,0 +1,65 @@
+package wisp
+
+import "testing"
+
+func TestConfig_SetStatus(t *testing.T) {
+	cfg := NewConfig(t.TempDir(), "testrig")
+
+	if got := cfg.Status(); got != "" {
+		t.Errorf("Status() before set = %q, want empty", got)
+	}
+	for _, s := range []Status{StatusActive, StatusParked, StatusDocked} {
+		if err := cfg.SetStatus(s); err != nil {
+			t.Fatalf("SetStatus(%q): %v", s, err)
+		}
+		if got := cfg.Status(); got != s {
+			t.Errorf("Status() = %q, want %q", got, s)
+		}
+	}
+}
+
+func TestConfig_SetStatusRejectsInvalid(t *testing.T) {
+	cfg := NewConfig(t.TempDir(), "testrig")
+	if err := cfg.SetStatus(StatusParked); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []Status{"paked", "", "PARKED"} {
+		if err := cfg.SetStatus(s); err == nil {
+			t.Errorf("SetStatus(%q) = nil, want error", s)
+		}
+	}
+	if got := cfg.Status(); got != StatusParked {
+		t.Errorf("rejected SetStatus changed status to %q", got)
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	for in, want := range map[string]Status{"parked": StatusParked, " Docked ": StatusDocked, "ACTIVE": StatusActive} {
+		got, err := ParseStatus(in)
+		if err != nil || got != want {
+			t.Errorf("ParseStatus(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseStatus("paked"); err == nil {
+		t.Error("ParseStatus(paked) = nil error, want rejection")
+	}
+}
+
+func TestConfig_StatusReadsLegacyValuesTolerantly(t *testing.T) {
+	cfg := NewConfig(t.TempDir(), "testrig")
+
+	// Values written before validation existed are read, not rejected.
+	if err := cfg.Set(StatusKey, "Parked"); err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Status(); got != StatusParked {
+		t.Errorf("Status() for legacy %q = %q, want %q", "Parked", got, StatusParked)
+	}
+	if err := cfg.Set(StatusKey, "paked"); err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Status(); got != "paked" || got.IsValid() {
+		t.Errorf("Status() for unknown legacy value = %q, want raw invalid value", got)
+	}
+}
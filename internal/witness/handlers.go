@@ -1097,7 +1097,7 @@ func witnessRecoveryTargetRefs(bd *beads.Beads, fields *beads.AgentFields, branc
 	lookupFailed := false
 	if fields.ActiveMR != "" {
 		if issue, err := bd.Show(fields.ActiveMR); err == nil {
-			if mrFields := beads.ParseMRFields(issue); mrFields != nil && mrFields.Target != "" {
+			if mrFields := issue.MRFields(); mrFields != nil && mrFields.Target != "" {
 				refs = append(refs, mrFields.Target)
 			}
 		} else if !errors.Is(err, beads.ErrNotFound) {
@@ -1106,7 +1106,7 @@ func witnessRecoveryTargetRefs(bd *beads.Beads, fields *beads.AgentFields, branc
 	}
 	if branch != "" {
 		if issue, err := bd.FindMRForBranchAny(branch); err == nil {
-			if mrFields := beads.ParseMRFields(issue); mrFields != nil && mrFields.Target != "" {
+			if mrFields := issue.MRFields(); mrFields != nil && mrFields.Target != "" {
 				refs = append(refs, mrFields.Target)
 			}
 		} else if !errors.Is(err, beads.ErrNotFound) {
@@ -1131,7 +1131,7 @@ func witnessRecoveryTargetRefs(bd *beads.Beads, fields *beads.AgentFields, branc
 }
 
 func witnessAttachmentTargetRefs(bd *beads.Beads, issue *beads.Issue) []string {
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	if attachment == nil {
 		return nil
 	}
@@ -1142,7 +1142,7 @@ func witnessAttachmentTargetRefs(bd *beads.Beads, issue *beads.Issue) []string {
 	}
 	if attachment.ConvoyID != "" && bd != nil {
 		if convoy, err := bd.Show(attachment.ConvoyID); err == nil {
-			if fields := beads.ParseConvoyFields(convoy); fields != nil && fields.BaseBranch != "" {
+			if fields := convoy.ConvoyFields(); fields != nil && fields.BaseBranch != "" {
 				refs = append(refs, fields.BaseBranch)
 			}
 		}
@@ -1212,11 +1212,11 @@ func witnessMQNotRequiredSource(bd *beads.Beads, issueID string) bool {
 	if err != nil || issue == nil {
 		return false
 	}
-	attachment := beads.ParseAttachmentFields(issue)
+	attachment := issue.Attachment()
 	if attachment == nil {
 		return false
 	}
-	return attachment.NoMerge || attachment.ReviewOnly || strings.EqualFold(strings.TrimSpace(attachment.MergeStrategy), "local")
+	return attachment.NoMerge || attachment.ReviewOnly || strings.EqualFold(strings.TrimSpace(attachment.MergeStrategy), beads.MergeStrategyLocal)
 }
 
 func witnessHasSubmittableWork(worktreePath string, targetRefs []string) bool {